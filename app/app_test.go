@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer"
+	ibc "github.com/cosmos/ibc-go/v6/modules/core"
 	"github.com/iqlusioninc/liquidity-staking-module/x/genutil"
 )
 
@@ -171,23 +175,27 @@ func TestRunMigrations(t *testing.T) {
 			_, err = app.mm.RunMigrations(
 				app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}), app.configurator,
 				module.VersionMap{
-					"bank":         1,
-					"auth":         auth.AppModule{}.ConsensusVersion(),
-					"authz":        authzmodule.AppModule{}.ConsensusVersion(),
-					"staking":      staking.AppModule{}.ConsensusVersion(),
-					"mint":         mint.AppModule{}.ConsensusVersion(),
-					"distribution": distribution.AppModule{}.ConsensusVersion(),
-					"slashing":     slashing.AppModule{}.ConsensusVersion(),
-					"gov":          gov.AppModule{}.ConsensusVersion(),
-					"group":        group.AppModule{}.ConsensusVersion(),
-					"params":       params.AppModule{}.ConsensusVersion(),
-					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
-					"vesting":      vesting.AppModule{}.ConsensusVersion(),
-					"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
-					"evidence":     evidence.AppModule{}.ConsensusVersion(),
-					"crisis":       crisis.AppModule{}.ConsensusVersion(),
-					"genutil":      genutil.AppModule{}.ConsensusVersion(),
-					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"bank":               1,
+					"auth":               auth.AppModule{}.ConsensusVersion(),
+					"authz":              authzmodule.AppModule{}.ConsensusVersion(),
+					"staking":            staking.AppModule{}.ConsensusVersion(),
+					"mint":               mint.AppModule{}.ConsensusVersion(),
+					"distribution":       distribution.AppModule{}.ConsensusVersion(),
+					"slashing":           slashing.AppModule{}.ConsensusVersion(),
+					"gov":                gov.AppModule{}.ConsensusVersion(),
+					"group":              group.AppModule{}.ConsensusVersion(),
+					"params":             params.AppModule{}.ConsensusVersion(),
+					"upgrade":            upgrade.AppModule{}.ConsensusVersion(),
+					"vesting":            vesting.AppModule{}.ConsensusVersion(),
+					"feegrant":           feegrantmodule.AppModule{}.ConsensusVersion(),
+					"evidence":           evidence.AppModule{}.ConsensusVersion(),
+					"crisis":             crisis.AppModule{}.ConsensusVersion(),
+					"genutil":            genutil.AppModule{}.ConsensusVersion(),
+					"capability":         capability.AppModule{}.ConsensusVersion(),
+					"ibc":                ibc.AppModule{}.ConsensusVersion(),
+					"transfer":           transfer.AppModule{}.ConsensusVersion(),
+					"interchainaccounts": ica.AppModule{}.ConsensusVersion(),
+					"lsmbasket":          lsmbasket.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -224,22 +232,26 @@ func TestInitGenesisOnMigration(t *testing.T) {
 	// the VersionMap to simulate upgrading with a new module.
 	_, err := app.mm.RunMigrations(ctx, app.configurator,
 		module.VersionMap{
-			"bank":         bank.AppModule{}.ConsensusVersion(),
-			"auth":         auth.AppModule{}.ConsensusVersion(),
-			"authz":        authzmodule.AppModule{}.ConsensusVersion(),
-			"staking":      staking.AppModule{}.ConsensusVersion(),
-			"mint":         mint.AppModule{}.ConsensusVersion(),
-			"distribution": distribution.AppModule{}.ConsensusVersion(),
-			"slashing":     slashing.AppModule{}.ConsensusVersion(),
-			"gov":          gov.AppModule{}.ConsensusVersion(),
-			"params":       params.AppModule{}.ConsensusVersion(),
-			"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
-			"vesting":      vesting.AppModule{}.ConsensusVersion(),
-			"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
-			"evidence":     evidence.AppModule{}.ConsensusVersion(),
-			"crisis":       crisis.AppModule{}.ConsensusVersion(),
-			"genutil":      genutil.AppModule{}.ConsensusVersion(),
-			"capability":   capability.AppModule{}.ConsensusVersion(),
+			"bank":               bank.AppModule{}.ConsensusVersion(),
+			"auth":               auth.AppModule{}.ConsensusVersion(),
+			"authz":              authzmodule.AppModule{}.ConsensusVersion(),
+			"staking":            staking.AppModule{}.ConsensusVersion(),
+			"mint":               mint.AppModule{}.ConsensusVersion(),
+			"distribution":       distribution.AppModule{}.ConsensusVersion(),
+			"slashing":           slashing.AppModule{}.ConsensusVersion(),
+			"gov":                gov.AppModule{}.ConsensusVersion(),
+			"params":             params.AppModule{}.ConsensusVersion(),
+			"upgrade":            upgrade.AppModule{}.ConsensusVersion(),
+			"vesting":            vesting.AppModule{}.ConsensusVersion(),
+			"feegrant":           feegrantmodule.AppModule{}.ConsensusVersion(),
+			"evidence":           evidence.AppModule{}.ConsensusVersion(),
+			"crisis":             crisis.AppModule{}.ConsensusVersion(),
+			"genutil":            genutil.AppModule{}.ConsensusVersion(),
+			"capability":         capability.AppModule{}.ConsensusVersion(),
+			"ibc":                ibc.AppModule{}.ConsensusVersion(),
+			"transfer":           transfer.AppModule{}.ConsensusVersion(),
+			"interchainaccounts": ica.AppModule{}.ConsensusVersion(),
+			"lsmbasket":          lsmbasket.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -0,0 +1,246 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// ibcTestingApp adapts SimApp to the ibc-go testing package, whose genesis
+// builder writes the validator set in the cosmos-sdk staking format
+type ibcTestingApp struct {
+	*SimApp
+}
+
+// InitChain converts the cosmos-sdk staking genesis into the liquid staking format
+func (app ibcTestingApp) InitChain(req abci.RequestInitChain) abci.ResponseInitChain {
+	var genesisState GenesisState
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
+
+	var sdkGenesis sdkstaking.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &sdkGenesis)
+
+	stakingGenesis := stakingtypes.DefaultGenesisState()
+	stakingGenesis.Params.BondDenom = sdkGenesis.Params.BondDenom
+	for _, val := range sdkGenesis.Validators {
+		stakingGenesis.Validators = append(stakingGenesis.Validators, stakingtypes.Validator{
+			OperatorAddress:          val.OperatorAddress,
+			ConsensusPubkey:          val.ConsensusPubkey,
+			Jailed:                   val.Jailed,
+			Status:                   val.Status,
+			Tokens:                   val.Tokens,
+			DelegatorShares:          val.DelegatorShares,
+			UnbondingTime:            val.UnbondingTime,
+			Commission:               stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			TotalValidatorBondShares: sdk.ZeroDec(),
+			TotalLiquidShares:        sdk.ZeroDec(),
+		})
+	}
+	for _, del := range sdkGenesis.Delegations {
+		stakingGenesis.Delegations = append(stakingGenesis.Delegations, stakingtypes.Delegation{
+			DelegatorAddress: del.DelegatorAddress,
+			ValidatorAddress: del.ValidatorAddress,
+			Shares:           del.Shares,
+		})
+	}
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
+
+	stateBytes, err := json.Marshal(genesisState)
+	if err != nil {
+		panic(err)
+	}
+	req.AppStateBytes = stateBytes
+
+	return app.SimApp.InitChain(req)
+}
+
+func setupIBCTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encCdc := MakeTestEncodingConfig()
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 5, encCdc, EmptyAppOptions{})
+
+	genesisState := NewDefaultGenesisState(encCdc.Codec)
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(sdkstaking.DefaultGenesisState())
+
+	return ibcTestingApp{app}, genesisState
+}
+
+// newIBCCoordinator creates a coordinator between an ibc-go simapp chain, which
+// acts as the interchain accounts controller, and a liquid staking chain
+func newIBCCoordinator(t *testing.T) (*ibctesting.Coordinator, *ibctesting.TestChain, *ibctesting.TestChain) {
+	coord := &ibctesting.Coordinator{
+		T:           t,
+		CurrentTime: time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingApp
+	chainA := ibctesting.NewTestChain(t, coord, ibctesting.GetChainID(1))
+
+	ibctesting.DefaultTestingAppInit = setupIBCTestingApp
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingApp })
+	chainB := ibctesting.NewTestChain(t, coord, ibctesting.GetChainID(2))
+
+	coord.Chains = map[string]*ibctesting.TestChain{
+		chainA.ChainID: chainA,
+		chainB.ChainID: chainB,
+	}
+	return coord, chainA, chainB
+}
+
+func liquidStakingApp(chain *ibctesting.TestChain) *SimApp {
+	return chain.App.(ibcTestingApp).SimApp
+}
+
+func TestInterchainAccountDelegation(t *testing.T) {
+	coord, chainA, chainB := newIBCCoordinator(t)
+	app := liquidStakingApp(chainB)
+
+	owner := chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+
+	path := ibctesting.NewPath(chainA, chainB)
+	coord.SetupConnections(path)
+
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	// register the interchain account and complete the channel handshake
+	_, err = chainA.SendMsgs(icacontrollertypes.NewMsgRegisterInterchainAccount(
+		path.EndpointA.ConnectionID, owner, path.EndpointA.ChannelConfig.Version,
+	))
+	require.NoError(t, err)
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(0)
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	icaAddrStr, found := app.ICAHostKeeper.GetInterchainAccountAddress(chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	require.True(t, found)
+	icaAddr := sdk.MustAccAddressFromBech32(icaAddrStr)
+	require.True(t, stakingkeeper.DelegatorIsLiquidStaker(icaAddr))
+
+	bondDenom := app.StakingKeeper.BondDenom(chainB.GetContext())
+	_, err = chainB.SendMsgs(banktypes.NewMsgSend(
+		chainB.SenderAccount.GetAddress(), icaAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)),
+	))
+	require.NoError(t, err)
+
+	valAddr := sdk.ValAddress(chainB.Vals.Validators[0].Address)
+	validator, found := app.StakingKeeper.GetLiquidValidator(chainB.GetContext(), valAddr)
+	require.True(t, found)
+	require.True(t, validator.TotalLiquidShares.IsZero())
+
+	// executes the msgs on the host chain through the interchain account
+	sendTx := func(msgs ...proto.Message) channeltypes.Acknowledgement {
+		data, err := icatypes.SerializeCosmosTx(chainB.Codec, msgs)
+		require.NoError(t, err)
+
+		res, err := chainA.SendMsgs(icacontrollertypes.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}))
+		require.NoError(t, err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		require.NoError(t, err)
+		require.NoError(t, path.EndpointB.UpdateClient())
+
+		res, err = path.EndpointB.RecvPacketWithResult(packet)
+		require.NoError(t, err)
+		ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+		require.NoError(t, err)
+
+		var acknowledgement channeltypes.Acknowledgement
+		require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+		return acknowledgement
+	}
+
+	// delegations of an interchain account count as liquid shares
+	delegateAmount := sdk.NewInt64Coin(bondDenom, 500_000)
+	ack := sendTx(stakingtypes.NewMsgDelegate(icaAddr, valAddr, delegateAmount))
+	require.True(t, ack.Success())
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(chainB.GetContext(), icaAddr, valAddr)
+	require.True(t, found)
+	validator, found = app.StakingKeeper.GetLiquidValidator(chainB.GetContext(), valAddr)
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, validator.TotalLiquidShares)
+
+	// interchain accounts cannot validator bond
+	ack = sendTx(stakingtypes.NewMsgValidatorBond(icaAddr, valAddr))
+	require.False(t, ack.Success())
+}
+
+func TestTransferShareTokens(t *testing.T) {
+	coord, chainA, chainB := newIBCCoordinator(t)
+	app := liquidStakingApp(chainB)
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+	path.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+	coord.Setup(path)
+
+	delAddr := chainB.SenderAccount.GetAddress()
+	valAddr := sdk.ValAddress(chainB.Vals.Validators[0].Address)
+	bondDenom := app.StakingKeeper.BondDenom(chainB.GetContext())
+
+	_, err := chainB.SendMsgs(stakingtypes.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1_000_000)))
+	require.NoError(t, err)
+	_, err = chainB.SendMsgs(&stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewInt64Coin(bondDenom, 1_000_000),
+		TokenizedShareOwner: delAddr.String(),
+	})
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(chainB.GetContext(), app.StakingKeeper.GetLastTokenizeShareRecordId(chainB.GetContext()))
+	require.NoError(t, err)
+	shareTokens := app.BankKeeper.GetBalance(chainB.GetContext(), delAddr, record.GetShareTokenDenom())
+	require.True(t, shareTokens.IsPositive())
+
+	// share tokens are sent to the counterparty chain as ics-20 vouchers
+	res, err := chainB.SendMsgs(ibctransfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, shareTokens,
+		delAddr.String(), chainA.SenderAccount.GetAddress().String(),
+		chainA.GetTimeoutHeight(), 0, "",
+	))
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	voucherDenom := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, shareTokens.Denom,
+	)).IBCDenom()
+	voucher := chainA.GetSimApp().BankKeeper.GetBalance(chainA.GetContext(), chainA.SenderAccount.GetAddress(), voucherDenom)
+	require.Equal(t, shareTokens.Amount, voucher.Amount)
+	require.True(t, app.BankKeeper.GetBalance(chainB.GetContext(), delAddr, shareTokens.Denom).IsZero())
+}
@@ -0,0 +1,49 @@
+package simapp
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	genesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// ICAHostAllowMessages are the messages interchain accounts may execute on the
+// host by default. They cover liquid staking providers managing delegations,
+// tokenizing and redeeming shares and the rewards of tokenize share records.
+// MsgValidatorBond is left out since liquid staking providers cannot validator bond.
+var ICAHostAllowMessages = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgTokenizeShares{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgRedeemTokensforShares{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgTransferTokenizeShareRecord{}),
+	sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawTokenizeShareRecordReward{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawAllTokenizeShareRecordReward{}),
+	sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+}
+
+// icaModuleBasic defaults the interchain accounts host to the ICAHostAllowMessages
+// allowlist instead of allowing all messages.
+type icaModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the interchain accounts genesis state with the host allowlist.
+func (icaModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := genesistypes.DefaultGenesis()
+	genesis.HostGenesisState.Params = icahosttypes.NewParams(true, ICAHostAllowMessages)
+	return cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,24 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
+
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+)
+
+// nftAppModule registers the nft message server wrapped by staking, so that sends
+// of tokenize share record NFTs move the ownership of their records.
+type nftAppModule struct {
+	nftmodule.AppModule
+	keeper        nftkeeper.Keeper
+	stakingKeeper stakingkeeper.Keeper
+}
+
+// RegisterServices registers the wrapped message server and the nft query server.
+func (am nftAppModule) RegisterServices(cfg module.Configurator) {
+	nft.RegisterMsgServer(cfg.MsgServer(), stakingkeeper.NewNFTMsgServerImpl(am.stakingKeeper, am.keeper))
+	nft.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
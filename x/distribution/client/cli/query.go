@@ -236,8 +236,10 @@ func GetCmdQueryDelegatorRewards() *cobra.Command {
 Example:
 $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --%s
 `,
 				version.AppName, bech32PrefixAccAddr, version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+				version.AppName, bech32PrefixAccAddr, FlagIncludeTokenized,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -271,9 +273,15 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 				return clientCtx.PrintProto(res)
 			}
 
+			includeTokenized, _ := cmd.Flags().GetBool(FlagIncludeTokenized)
+			startRecordId, _ := cmd.Flags().GetUint64(FlagStartRecordId)
 			res, err := queryClient.DelegationTotalRewards(
 				ctx,
-				&types.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegatorAddr.String()},
+				&types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress:       delegatorAddr.String(),
+					IncludeTokenized:       includeTokenized,
+					TokenizedStartRecordId: startRecordId,
+				},
 			)
 			if err != nil {
 				return err
@@ -283,6 +291,8 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 		},
 	}
 
+	cmd.Flags().Bool(FlagIncludeTokenized, false, "Include the rewards of owned tokenize share records")
+	cmd.Flags().Uint64(FlagStartRecordId, 0, "First owned record id to include, as returned in next_tokenized_record_id")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -21,6 +21,10 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagRecordIds        = "record-ids"
+	FlagStartRecordId    = "start-record-id"
+	FlagLimit            = "limit"
+	FlagIncludeTokenized = "include-tokenized"
 )
 
 const (
@@ -135,11 +139,13 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Withdraw all rewards for a single delegator.
 Note that if you use this command with --%[2]s=%[3]s or --%[2]s=%[4]s, the %[5]s flag will automatically be set to 0.
+With --%[6]s, the rewards of the tokenize share records owned by the delegator are withdrawn as well.
 
 Example:
 $ %[1]s tx distribution withdraw-all-rewards --from mykey
+$ %[1]s tx distribution withdraw-all-rewards --%[6]s --from mykey
 `,
-				version.AppName, flags.FlagBroadcastMode, flags.BroadcastSync, flags.BroadcastAsync, FlagMaxMessagesPerTx,
+				version.AppName, flags.FlagBroadcastMode, flags.BroadcastSync, flags.BroadcastAsync, FlagMaxMessagesPerTx, FlagIncludeTokenized,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -175,6 +181,24 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 				msgs = append(msgs, msg)
 			}
 
+			if includeTokenized, _ := cmd.Flags().GetBool(FlagIncludeTokenized); includeTokenized {
+				rewardsRes, err := queryClient.DelegationTotalRewards(cmd.Context(), &types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: delAddr.String(),
+					IncludeTokenized: true,
+				})
+				if err != nil {
+					return err
+				}
+
+				var recordIds []uint64
+				for _, reward := range rewardsRes.Rewards {
+					if reward.ModuleAddress != "" {
+						recordIds = append(recordIds, reward.RecordId)
+					}
+				}
+				msgs = append(msgs, newWithdrawTokenizeShareRecordRewardMsgs(delAddr, recordIds)...)
+			}
+
 			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
 			if !clientCtx.GenerateOnly && clientCtx.BroadcastMode != flags.BroadcastBlock && chunkSize > 0 {
 				return fmt.Errorf("cannot use broadcast mode %[1]s with %[2]s != 0",
@@ -186,11 +210,26 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 	}
 
 	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Bool(FlagIncludeTokenized, false, "Also withdraw the rewards of owned tokenize share records")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// newWithdrawTokenizeShareRecordRewardMsgs bundles the given records into as few
+// MsgWithdrawAllTokenizeShareRecordReward as the per message record limit allows
+func newWithdrawTokenizeShareRecordRewardMsgs(owner sdk.AccAddress, recordIds []uint64) []sdk.Msg {
+	var msgs []sdk.Msg
+	for i := 0; i < len(recordIds); i += types.MaxTokenizeShareRecordRewardWithdrawals {
+		end := i + types.MaxTokenizeShareRecordRewardWithdrawals
+		if end > len(recordIds) {
+			end = len(recordIds)
+		}
+		msgs = append(msgs, types.NewMsgWithdrawAllTokenizeShareRecordReward(owner, recordIds[i:end], 0, 0))
+	}
+	return msgs
+}
+
 func NewSetWithdrawAddrCmd() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
 
@@ -339,12 +378,15 @@ func NewWithdrawAllTokenizeShareRecordRewardCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(0),
 		Short: "Withdraw reward for all owning TokenizeShareRecord",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw reward for all owned TokenizeShareRecord
+			fmt.Sprintf(`Withdraw reward for all owned TokenizeShareRecord. At most %d records are
+processed per transaction; the transaction response carries the record id to resume from.
 
 Example:
-$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+$ %s tx distribution withdraw-all-tokenize-share-rewards --from mykey
+$ %s tx distribution withdraw-all-tokenize-share-rewards --start-record-id=120 --limit=50 --from mykey
+$ %s tx distribution withdraw-all-tokenize-share-rewards --record-ids=1,5,9 --from mykey
 `,
-				version.AppName,
+				types.MaxTokenizeShareRecordRewardWithdrawals, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -353,12 +395,36 @@ $ %s tx distribution withdraw-tokenize-share-rewards --from mykey
 				return err
 			}
 
-			msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(clientCtx.GetFromAddress())
+			recordIds, err := cmd.Flags().GetUintSlice(FlagRecordIds)
+			if err != nil {
+				return err
+			}
+			startRecordId, err := cmd.Flags().GetUint64(FlagStartRecordId)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			var ids []uint64
+			for _, id := range recordIds {
+				ids = append(ids, uint64(id))
+			}
+
+			msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(clientCtx.GetFromAddress(), ids, startRecordId, limit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().UintSlice(FlagRecordIds, []uint{}, "Only withdraw rewards of the given record ids")
+	cmd.Flags().Uint64(FlagStartRecordId, 0, "Record id to start withdrawing from")
+	cmd.Flags().Uint64(FlagLimit, 0, fmt.Sprintf("Maximum number of records to process (default %d)", types.MaxTokenizeShareRecordRewardWithdrawals))
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
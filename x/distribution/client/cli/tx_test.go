@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
 func Test_splitAndCall_NoMessages(t *testing.T) {
@@ -64,6 +65,25 @@ func Test_splitAndCall_Splitting(t *testing.T) {
 	assert.Equal(t, 3, callCount)
 }
 
+func Test_newWithdrawTokenizeShareRecordRewardMsgs(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	require.Empty(t, newWithdrawTokenizeShareRecordRewardMsgs(addr, nil))
+
+	recordIds := make([]uint64, types.MaxTokenizeShareRecordRewardWithdrawals+1)
+	for i := range recordIds {
+		recordIds[i] = uint64(i + 1)
+	}
+
+	msgs := newWithdrawTokenizeShareRecordRewardMsgs(addr, recordIds)
+	require.Len(t, msgs, 2)
+	for _, msg := range msgs {
+		require.NoError(t, msg.ValidateBasic())
+	}
+	require.Len(t, msgs[0].(*types.MsgWithdrawAllTokenizeShareRecordReward).RecordIds, types.MaxTokenizeShareRecordRewardWithdrawals)
+	require.Equal(t, []uint64{recordIds[len(recordIds)-1]}, msgs[1].(*types.MsgWithdrawAllTokenizeShareRecordReward).RecordIds)
+}
+
 func TestParseProposal(t *testing.T) {
 	encodingConfig := params.MakeTestEncodingConfig()
 
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/iqlusioninc/liquidity-staking-module/testutil/network"
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
 type GRPCQueryTestSuite struct {
@@ -56,9 +57,9 @@ func (s *GRPCQueryTestSuite) TestQueryParamsGRPC() {
 		{
 			"gRPC request params",
 			fmt.Sprintf("%s/cosmos/distribution/v1beta1/params", baseURL),
-			&types.QueryParamsResponse{},
-			&types.QueryParamsResponse{
-				Params: types.DefaultParams(),
+			&distrtypes.QueryParamsResponse{},
+			&distrtypes.QueryParamsResponse{
+				Params: distrtypes.DefaultParams(),
 			},
 		},
 	}
@@ -261,7 +262,7 @@ func (s *GRPCQueryTestSuite) TestQueryDelegatorRewardsGRPC() {
 			fmt.Sprintf("%s/cosmos/distribution/v1beta1/delegators/%s/rewards", baseURL, "wrongDelegatorAddress"),
 			map[string]string{},
 			true,
-			&types.QueryDelegationTotalRewardsResponse{},
+			&distrtypes.QueryDelegationTotalRewardsResponse{},
 			nil,
 		},
 		{
@@ -271,10 +272,10 @@ func (s *GRPCQueryTestSuite) TestQueryDelegatorRewardsGRPC() {
 				grpctypes.GRPCBlockHeightHeader: "2",
 			},
 			false,
-			&types.QueryDelegationTotalRewardsResponse{},
-			&types.QueryDelegationTotalRewardsResponse{
-				Rewards: []types.DelegationDelegatorReward{
-					types.NewDelegationDelegatorReward(val.ValAddress, rewards),
+			&distrtypes.QueryDelegationTotalRewardsResponse{},
+			&distrtypes.QueryDelegationTotalRewardsResponse{
+				Rewards: []distrtypes.DelegationDelegatorReward{
+					distrtypes.NewDelegationDelegatorReward(val.ValAddress, rewards),
 				},
 				Total: rewards,
 			},
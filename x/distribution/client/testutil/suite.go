@@ -78,7 +78,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"tokenize_share_reward_payout_interval":"0","tokenize_share_reward_payout_batch_size":"20","tokenize_share_reward_payout_gas_limit":"10000000"}`,
 		},
 		{
 			"text output",
@@ -86,6 +86,9 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			`base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
+tokenize_share_reward_payout_batch_size: "20"
+tokenize_share_reward_payout_gas_limit: "10000000"
+tokenize_share_reward_payout_interval: "0"
 withdraw_addr_enabled: true`,
 		},
 	}
@@ -349,7 +352,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegatorRewards() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"193.550000000000000000"}]}],"total":[{"denom":"stake","amount":"193.550000000000000000"}]}`, valAddr.String()),
+			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"193.550000000000000000"}],"record_id":"0","module_address":""}],"total":[{"denom":"stake","amount":"193.550000000000000000"}],"next_tokenized_record_id":"0"}`, valAddr.String()),
 		},
 		{
 			"json output (specific validator)",
@@ -369,8 +372,11 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegatorRewards() {
 				addr.String(),
 			},
 			false,
-			fmt.Sprintf(`rewards:
-- reward:
+			fmt.Sprintf(`next_tokenized_record_id: "0"
+rewards:
+- module_address: ""
+  record_id: "0"
+  reward:
   - amount: "193.550000000000000000"
     denom: stake
   validator_address: %s
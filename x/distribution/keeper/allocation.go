@@ -106,6 +106,21 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val sdkstaking.Valida
 	commission := tokens.MulDec(val.GetCommission())
 	shared := tokens.Sub(commission)
 
+	// delegations held by tokenize share record module accounts are charged the
+	// liquid commission rate instead, so they accrue rewards from a separate pool
+	liquidShared := shared
+	if liquidFraction := k.getLiquidFraction(ctx, val); liquidFraction.IsPositive() {
+		validator, found := k.stakingKeeper.GetLiquidValidator(ctx, val.GetOperator())
+		if found && !validator.Commission.GetLiquidRate().Equal(val.GetCommission()) {
+			liquidShared = tokens.Sub(tokens.MulDec(validator.Commission.GetLiquidRate()))
+
+			// note: necessary to truncate so we don't allow withdrawing more rewards than owed
+			commission = tokens.
+				Sub(shared.MulDecTruncate(sdk.OneDec().Sub(liquidFraction))).
+				Sub(liquidShared.MulDecTruncate(liquidFraction))
+		}
+	}
+
 	// update current commission
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -121,6 +136,7 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val sdkstaking.Valida
 	// update current rewards
 	currentRewards := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
 	currentRewards.Rewards = currentRewards.Rewards.Add(shared...)
+	currentRewards.LiquidRewards = currentRewards.LiquidRewards.Add(liquidShared...)
 	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), currentRewards)
 
 	// update outstanding rewards
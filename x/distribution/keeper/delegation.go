@@ -28,9 +28,10 @@ func (k Keeper) initializeDelegation(ctx sdk.Context, val sdk.ValAddress, del sd
 	k.SetDelegatorStartingInfo(ctx, val, del, types.NewDelegatorStartingInfo(previousPeriod, stake, uint64(ctx.BlockHeight())))
 }
 
-// calculate the rewards accrued by a delegation between two periods
+// calculate the rewards accrued by a delegation between two periods, from the
+// liquid reward pool for delegations held by tokenize share record module accounts
 func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, val sdkstaking.ValidatorI,
-	startingPeriod, endingPeriod uint64, stake sdk.Dec,
+	startingPeriod, endingPeriod uint64, stake sdk.Dec, liquid bool,
 ) (rewards sdk.DecCoins) {
 	// sanity check
 	if startingPeriod > endingPeriod {
@@ -46,6 +47,9 @@ func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, val sdkstakin
 	starting := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), startingPeriod)
 	ending := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), endingPeriod)
 	difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
+	if liquid {
+		difference = ending.CumulativeLiquidRewardRatio.Sub(starting.CumulativeLiquidRewardRatio)
+	}
 	if difference.IsAnyNegative() {
 		panic("negative rewards should not be possible")
 	}
@@ -67,6 +71,11 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val sdkstaking.Valid
 	startingPeriod := startingInfo.PreviousPeriod
 	stake := startingInfo.Stake
 
+	// delegations held by tokenize share record module accounts accrue rewards
+	// net of the liquid commission rate
+	_, err := k.stakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, del.GetDelegatorAddr())
+	liquid := err == nil
+
 	// Iterate through slashes and withdraw with calculated staking for
 	// distribution periods. These period offsets are dependent on *when* slashes
 	// happen - namely, in BeginBlock, after rewards are allocated...
@@ -83,7 +92,7 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val sdkstaking.Valid
 			func(height uint64, event types.ValidatorSlashEvent) (stop bool) {
 				endingPeriod := event.ValidatorPeriod
 				if endingPeriod > startingPeriod {
-					rewards = rewards.Add(k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)...)
+					rewards = rewards.Add(k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake, liquid)...)
 
 					// Note: It is necessary to truncate so we don't allow withdrawing
 					// more rewards than owed.
@@ -134,7 +143,7 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val sdkstaking.Valid
 	}
 
 	// calculate rewards for final period
-	rewards = rewards.Add(k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)...)
+	rewards = rewards.Add(k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake, liquid)...)
 	return rewards
 }
 
@@ -140,9 +140,11 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	})
 
 	// try withdrawing rewards before no reward is allocated
-	coins, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]))
+	coins, results, nextRecordId, err := app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]), nil, 0, 0)
 	require.Nil(t, err)
 	require.Equal(t, coins, sdk.Coins{})
+	require.Len(t, results, 1)
+	require.Equal(t, uint64(0), nextRecordId)
 
 	// assert tokenize share response
 	require.NoError(t, err)
@@ -160,9 +162,27 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	beforeBalance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[1]), sdk.DefaultBondDenom)
 
 	// withdraw rewards
-	coins, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	coins, results, _, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]), nil, 0, 0)
 	require.Nil(t, err)
 
+	// the events of the record's cache context are emitted once
+	withdrawEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeWithdrawRewards {
+			withdrawEvents++
+		}
+	}
+	require.Equal(t, 1, withdrawEvents)
+	require.Len(t, results, 1)
+	require.Equal(t, uint64(1), results[0].RecordId)
+	require.Equal(t, coins, results[0].Amount)
+	require.Empty(t, results[0].Error)
+
+	// records not owned by the sender cannot be listed explicitly
+	_, _, _, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[0]), []uint64{1}, 0, 0)
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
 	// check return value
 	require.Equal(t, coins.String(), "50000stake")
 	// check balance changes
@@ -190,6 +210,101 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	require.Equal(t, midBalance.Amount.Add(coins.AmountOf(sdk.DefaultBondDenom)), finalBalance.Amount)
 }
 
+func TestWithdrawTokenizeShareRecordRewardLiquidCommission(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission and 75% liquid commission
+	liquidRate := sdk.NewDecWithPrec(75, 2)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.OneDec(), sdk.NewDec(0))
+	tstaking.Commission.LiquidCommissionRate = &liquidRate
+	valPower := int64(100)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, valPower, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize half of the self delegation
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, valPower/2)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    sdk.AccAddress(valAddrs[0]).String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: sdk.AccAddress(valAddrs[1]).String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+	})
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	// end block and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+
+	// the self delegation is charged the regular commission on its half of the rewards
+	selfDel := app.StakingKeeper.Delegation(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, selfDel, endingPeriod)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial.QuoRaw(4))}}, rewards)
+
+	// the tokenized delegation is charged the liquid commission on its half of the rewards
+	recordDel := app.StakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, recordDel, endingPeriod)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial.QuoRaw(8))}}, rewards)
+
+	// both commissions are credited to the validator when the rewards are allocated
+	commission := app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial.QuoRaw(8).MulRaw(5))}}, commission)
+
+	// lowering the liquid commission rate below the regular rate does not change
+	// the rewards already earned
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	lowerLiquidRate := sdk.NewDecWithPrec(25, 2)
+	validator.Commission.LiquidCommissionRate = &lowerLiquidRate
+	app.StakingKeeper.SetValidator(ctx, validator)
+	val = app.StakingKeeper.Validator(ctx, valAddrs[0])
+	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, recordDel, endingPeriod)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial.QuoRaw(8))}}, rewards)
+
+	// rewards allocated afterwards are charged the lower rate
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, recordDel, endingPeriod)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial.QuoRaw(2))}}, rewards)
+	commission = app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}, commission)
+
+	// fund the distribution module account with the allocated rewards
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(2)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// withdrawing the record rewards leaves the commission unchanged
+	coins, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]), record.Id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}, coins)
+	require.Equal(t, commission, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission)
+
+	outstanding := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddrs[0])
+	require.Equal(t, sdk.NewDecFromInt(initial.MulRaw(3).QuoRaw(2)), outstanding.AmountOf(sdk.DefaultBondDenom))
+}
+
 func TestCalculateRewardsAfterSlash(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -821,3 +936,34 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestPruneTokenizeShareRecordFlushesBalance(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// add a record that holds no delegation but still holds a leftover balance
+	owner := sdk.AccAddress(valAddrs[1])
+	record := stakingtypes.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner.String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     valAddrs[0].String(),
+	}
+	require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	leftover := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, record.GetModuleAddress(), leftover))
+	beforeBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+
+	app.StakingKeeper.PruneTokenizeShareRecords(ctx)
+
+	// the record is removed and the leftover balance is flushed to the owner
+	_, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.Error(t, err)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+	require.Equal(t, beforeBalance.Add(leftover[0]), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+}
@@ -20,8 +20,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -189,7 +188,40 @@ func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelega
 		},
 	)
 
-	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
+	var nextRecordId uint64
+	if req.IncludeTokenized {
+		records, next := k.stakingKeeper.GetTokenizeShareRecordsByOwnerFrom(
+			ctx, delAdr, req.TokenizedStartRecordId, types.MaxTokenizeShareRecordRewardWithdrawals,
+		)
+		nextRecordId = next
+		for _, record := range records {
+			valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+			if err != nil {
+				return nil, err
+			}
+
+			recordReward := k.tokenizeShareRecordReward(ctx, record.GetModuleAddress(), valAddr)
+			delRewards = append(delRewards, types.NewTokenizeShareRecordDelegatorReward(valAddr, record.Id, record.GetModuleAddress(), recordReward))
+			total = total.Add(recordReward...)
+		}
+	}
+
+	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total, NextTokenizedRecordId: nextRecordId}, nil
+}
+
+// tokenizeShareRecordReward returns the rewards the owner of a tokenize share record would
+// receive on withdrawal: the pending delegation rewards plus the module account balance
+func (k Keeper) tokenizeShareRecordReward(ctx sdk.Context, moduleAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.DecCoins {
+	reward := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, moduleAddr)...)
+
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr)
+	if val != nil && del != nil {
+		endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+		reward = reward.Add(k.CalculateDelegationRewards(ctx, val, del, endingPeriod)...)
+	}
+
+	return reward
 }
 
 // DelegatorValidators queries the validators list of a delegator
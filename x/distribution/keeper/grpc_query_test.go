@@ -731,6 +731,40 @@ func (suite *KeeperTestSuite) TestGRPCTokenizeShareRecordReward() {
 		},
 		Total: sdk.DecCoins{sdk.NewInt64DecCoin("stake", 50000)},
 	}, rewards)
+
+	// tokenized rewards are only part of the delegation total rewards when requested
+	totalRewards, err := queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(valAddrs[0]).String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(totalRewards.Rewards, 1)
+	suite.Require().Zero(totalRewards.Rewards[0].RecordId)
+	suite.Require().Empty(totalRewards.Rewards[0].ModuleAddress)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	suite.Require().NoError(err)
+
+	totalRewardsWithTokenized, err := queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(valAddrs[0]).String(),
+		IncludeTokenized: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(totalRewardsWithTokenized.Rewards, 2)
+	suite.Require().Equal(totalRewards.Rewards[0], totalRewardsWithTokenized.Rewards[0])
+	suite.Require().Equal(types.NewTokenizeShareRecordDelegatorReward(
+		valAddrs[0], 1, record.GetModuleAddress(), sdk.DecCoins{sdk.NewInt64DecCoin("stake", 50000)},
+	), totalRewardsWithTokenized.Rewards[1])
+	suite.Require().Equal(totalRewards.Total.Add(sdk.NewInt64DecCoin("stake", 50000)), totalRewardsWithTokenized.Total)
+	suite.Require().Zero(totalRewardsWithTokenized.NextTokenizedRecordId)
+
+	// the owned records are reported from the requested record id
+	totalRewardsWithTokenized, err = queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress:       sdk.AccAddress(valAddrs[0]).String(),
+		IncludeTokenized:       true,
+		TokenizedStartRecordId: record.Id + 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(totalRewards.Rewards, totalRewardsWithTokenized.Rewards)
 }
 
 func TestDistributionTestSuite(t *testing.T) {
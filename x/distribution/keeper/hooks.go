@@ -129,3 +129,27 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
 	return nil
 }
+
+func (h Hooks) AfterTokenizeShareRecordsSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec, _ []uint64) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareRecordsTombstoned(_ sdk.Context, _ sdk.ValAddress, _ []uint64) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareRecordCreated(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeSharesRedeemed(_ sdk.Context, _ uint64, _ sdk.AccAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareRecordTransferred(_ sdk.Context, _ uint64, _, _ sdk.AccAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBondChanged(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
@@ -10,48 +10,66 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // Keeper of the distribution store
 type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
-	paramSpace    paramtypes.Subspace
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	authzKeeper   types.AuthzKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return Keeper{
 		storeKey:         key,
 		cdc:              cdc,
-		paramSpace:       paramSpace,
 		authKeeper:       ak,
 		bankKeeper:       bk,
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
+	}
+}
+
+// SetAuthzKeeper sets the authz keeper used to charge the rewards withdrawn through a
+// TokenizeShareRecordAuthorization to its spend limit
+func (k *Keeper) SetAuthzKeeper(ak types.AuthzKeeper) *Keeper {
+	if k.authzKeeper != nil {
+		panic("cannot set authz keeper twice")
 	}
+
+	k.authzKeeper = ak
+
+	return k
+}
+
+// GetAuthority returns the x/distribution module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // Logger returns a module-specific logger.
@@ -187,7 +205,7 @@ func (k Keeper) WithdrawSingleShareRecordReward(ctx sdk.Context, recordId uint64
 		return err
 	}
 
-	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	owner, err := k.stakingKeeper.GetTokenizeShareRecordOwner(ctx, recordId)
 	if err != nil {
 		return err
 	}
@@ -235,7 +253,11 @@ func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk
 		return nil, err
 	}
 
-	if record.Owner != ownerAddr.String() {
+	owner, err := k.stakingKeeper.GetTokenizeShareRecordOwner(ctx, recordId)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(ownerAddr) {
 		return nil, types.ErrNotTokenizeShareRecordOwner
 	}
 
@@ -280,56 +302,155 @@ func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk
 	return rewards, nil
 }
 
-// withdraw reward for all owning TokenizeShareRecord
-func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
-	totalRewards := sdk.Coins{}
+// GetTokenizeShareRecordReward returns the rewards the owner of a tokenize share
+// record would withdraw now. The validator period is incremented on a cached
+// context that is discarded, so no state is changed.
+func (k Keeper) GetTokenizeShareRecordReward(ctx sdk.Context, recordId uint64) (sdk.DecCoins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.tokenizeShareRecordReward(cacheCtx, record.GetModuleAddress(), valAddr), nil
+}
+
+// withdraw reward for a bounded batch of owning TokenizeShareRecords, either the given
+// record ids or up to limit records starting from startRecordId
+func (k Keeper) WithdrawAllTokenizeShareRecordReward(
+	ctx sdk.Context, ownerAddr sdk.AccAddress, recordIds []uint64, startRecordId, limit uint64,
+) (totalRewards sdk.Coins, results []types.TokenizeShareRecordRewardResult, nextRecordId uint64, err error) {
+	totalRewards = sdk.Coins{}
 
-	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if limit == 0 || limit > types.MaxTokenizeShareRecordRewardWithdrawals {
+		limit = types.MaxTokenizeShareRecordRewardWithdrawals
+	}
+
+	var records []stakingtypes.TokenizeShareRecord
+	if len(recordIds) > 0 {
+		if uint64(len(recordIds)) > limit {
+			return nil, nil, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many record ids: %d > %d", len(recordIds), limit)
+		}
+		for _, recordId := range recordIds {
+			record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			owner, err := k.stakingKeeper.GetTokenizeShareRecordOwner(ctx, recordId)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			if !owner.Equals(ownerAddr) {
+				return nil, nil, 0, errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "record %d", recordId)
+			}
+			records = append(records, record)
+		}
+	} else {
+		records, nextRecordId = k.stakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, ownerAddr, startRecordId, limit)
+	}
 
 	for _, record := range records {
-		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		rewards, err := k.withdrawTokenizeShareRecordRewardCached(ctx, ownerAddr, record)
+		result := types.TokenizeShareRecordRewardResult{RecordId: record.Id, Amount: rewards}
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordId, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		}
 		if err != nil {
-			return nil, err
+			k.Logger(ctx).Error("failed to withdraw tokenize share record reward", "record_id", record.Id, "err", err)
+			result.Error = err.Error()
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		} else if !rewards.Empty() {
+			totalRewards = totalRewards.Add(rewards...)
 		}
+		results = append(results, result)
 
-		val := k.stakingKeeper.Validator(ctx, valAddr)
-		if val == nil {
-			continue
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeWithdrawTokenizeShareReward, attrs...))
+	}
+
+	return totalRewards, results, nextRecordId, nil
+}
+
+// withdrawTokenizeShareRecordRewardCached withdraws the rewards of a single record in a
+// cache context, so that a failure leaves no partial state behind
+func (k Keeper) withdrawTokenizeShareRecordRewardCached(ctx sdk.Context, ownerAddr sdk.AccAddress, record stakingtypes.TokenizeShareRecord) (sdk.Coins, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	val := k.stakingKeeper.Validator(cacheCtx, valAddr)
+	del := k.stakingKeeper.Delegation(cacheCtx, record.GetModuleAddress(), valAddr)
+	if val != nil && del != nil {
+		// withdraw rewards into reward module account and send it to reward owner
+		if _, err := k.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddr); err != nil {
+			return sdk.Coins{}, err
 		}
+	}
 
-		del := k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr)
-		if del == nil {
-			continue
+	// apply changes when the module account has positive balance
+	balances := k.bankKeeper.GetAllBalances(cacheCtx, record.GetModuleAddress())
+	if !balances.Empty() {
+		if err := k.bankKeeper.SendCoins(cacheCtx, record.GetModuleAddress(), ownerAddr, balances); err != nil {
+			return sdk.Coins{}, err
 		}
+	}
 
-		// withdraw rewards into reward module account and send it to reward owner
-		cacheCtx, write := ctx.CacheContext()
-		_, err = k.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddr)
+	write()
+	return balances, nil
+}
+
+// ChargeTokenizeShareRecordAuthorization charges the rewards withdrawn by a message
+// executed through a TokenizeShareRecordAuthorization to the authorization's spend
+// limit. The authorization is the owner's grant for the message type that was flagged
+// as withdrawing when the message was accepted; it is deleted once its spend limit is
+// used up, and the withdrawal fails if the rewards exceed the spend limit.
+func (k Keeper) ChargeTokenizeShareRecordAuthorization(ctx sdk.Context, ownerAddr sdk.AccAddress, msgTypeURL string, withdrawn sdk.Coins) error {
+	if k.authzKeeper == nil {
+		return nil
+	}
+
+	var pageKey []byte
+	for {
+		res, err := k.authzKeeper.GranterGrants(sdk.WrapSDKContext(ctx), &authz.QueryGranterGrantsRequest{
+			Granter:    ownerAddr.String(),
+			Pagination: &query.PageRequest{Key: pageKey},
+		})
 		if err != nil {
-			k.Logger(ctx).Error(err.Error())
-			continue
+			return err
 		}
 
-		// apply changes when the module account has positive balance
-		balances := k.bankKeeper.GetAllBalances(cacheCtx, record.GetModuleAddress())
-		if !balances.Empty() {
-			err = k.bankKeeper.SendCoins(cacheCtx, record.GetModuleAddress(), ownerAddr, balances)
-			if err != nil {
-				k.Logger(ctx).Error(err.Error())
+		for _, grant := range res.Grants {
+			authorization, ok := grant.Authorization.GetCachedValue().(*types.TokenizeShareRecordAuthorization)
+			if !ok || !authorization.Withdrawing || authorization.MsgTypeUrl != msgTypeURL {
 				continue
 			}
-			write()
-			totalRewards = totalRewards.Add(balances...)
-		}
-	}
+			grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+			if err != nil {
+				return err
+			}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWithdrawTokenizeShareReward,
-			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
-		),
-	)
+			updated, exhausted, err := authorization.Charge(withdrawn)
+			if err != nil {
+				return err
+			}
+			if exhausted {
+				return k.authzKeeper.DeleteGrant(ctx, grantee, ownerAddr, msgTypeURL)
+			}
+			return k.authzKeeper.SaveGrant(ctx, grantee, ownerAddr, updated, grant.Expiration)
+		}
 
-	return totalRewards, nil
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil
+		}
+		pageKey = res.Pagination.NextKey
+	}
 }
@@ -8,8 +8,11 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
@@ -129,3 +132,47 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, app.BankKeeper.GetAllBalances(ctx, addr[0]))
 }
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := types.DefaultParams()
+	params.CommunityTax = sdk.NewDecWithPrec(5, 2)
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(sdk.AccAddress("invalid"), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// invalid params are rejected
+	invalid := params
+	invalid.CommunityTax = sdk.NewDec(2)
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, invalid))
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))[0])
+	ratio := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 1))}
+	rewards := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(100))}
+	app.DistrKeeper.SetValidatorHistoricalRewards(ctx, valAddr, 1, types.NewValidatorHistoricalRewards(ratio, 1))
+	app.DistrKeeper.SetValidatorCurrentRewards(ctx, valAddr, types.NewValidatorCurrentRewards(rewards, 2))
+
+	require.NoError(t, keeper.NewMigrator(app.DistrKeeper, app.GetSubspace(types.ModuleName)).Migrate2to3(ctx))
+
+	historical := app.DistrKeeper.GetValidatorHistoricalRewards(ctx, valAddr, 1)
+	require.Equal(t, ratio, historical.CumulativeLiquidRewardRatio)
+	require.Equal(t, uint32(1), historical.ReferenceCount)
+	current := app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr)
+	require.Equal(t, rewards, current.LiquidRewards)
+	require.Equal(t, uint64(2), current.Period)
+}
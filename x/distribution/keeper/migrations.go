@@ -1 +1,70 @@
 package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/exported"
+	v047 "github.com/iqlusioninc/liquidity-staking-module/x/distribution/migrations/v047"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// The migration starts the liquid reward pool of each validator from its regular
+// reward pool, as the liquid commission rate was not applied on allocation before.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	type historicalRewards struct {
+		val     sdk.ValAddress
+		period  uint64
+		rewards types.ValidatorHistoricalRewards
+	}
+	var historicals []historicalRewards
+	m.keeper.IterateValidatorHistoricalRewards(ctx, func(val sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool) {
+		rewards.CumulativeLiquidRewardRatio = rewards.CumulativeRewardRatio
+		historicals = append(historicals, historicalRewards{val, period, rewards})
+		return false
+	})
+	for _, historical := range historicals {
+		m.keeper.SetValidatorHistoricalRewards(ctx, historical.val, historical.period, historical.rewards)
+	}
+
+	type currentRewards struct {
+		val     sdk.ValAddress
+		rewards types.ValidatorCurrentRewards
+	}
+	var currents []currentRewards
+	m.keeper.IterateValidatorCurrentRewards(ctx, func(val sdk.ValAddress, rewards types.ValidatorCurrentRewards) (stop bool) {
+		rewards.LiquidRewards = rewards.Rewards
+		currents = append(currents, currentRewards{val, rewards})
+		return false
+	})
+	for _, current := range currents {
+		m.keeper.SetValidatorCurrentRewards(ctx, current.val, current.rewards)
+	}
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// The migration sets the tokenize share reward payout params to their defaults.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.legacySubspace.Set(ctx, types.ParamStoreKeyTokenizeShareRewardPayoutInterval, types.DefaultTokenizeShareRewardPayoutInterval)
+	m.legacySubspace.Set(ctx, types.ParamStoreKeyTokenizeShareRewardPayoutBatchSize, types.DefaultTokenizeShareRewardPayoutBatchSize)
+	m.legacySubspace.Set(ctx, types.ParamStoreKeyTokenizeShareRewardPayoutGasLimit, types.DefaultTokenizeShareRewardPayoutGasLimit)
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// The migration moves the params from the legacy x/params subspace to the
+// x/distribution store.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.legacySubspace)
+}
@@ -3,10 +3,12 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
@@ -134,6 +136,9 @@ func (k msgServer) WithdrawTokenizeShareRecordReward(goCtx context.Context, msg
 	if err != nil {
 		return nil, err
 	}
+	if err := k.ChargeTokenizeShareRecordAuthorization(ctx, ownerAddr, sdk.MsgTypeURL(msg), amount); err != nil {
+		return nil, err
+	}
 
 	defer func() {
 		for _, a := range amount {
@@ -166,10 +171,13 @@ func (k msgServer) WithdrawAllTokenizeShareRecordReward(goCtx context.Context, m
 	if err != nil {
 		return nil, err
 	}
-	amount, err := k.Keeper.WithdrawAllTokenizeShareRecordReward(ctx, ownerAddr)
+	amount, results, nextRecordId, err := k.Keeper.WithdrawAllTokenizeShareRecordReward(ctx, ownerAddr, msg.RecordIds, msg.StartRecordId, msg.Limit)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.ChargeTokenizeShareRecordAuthorization(ctx, ownerAddr, sdk.MsgTypeURL(msg), amount); err != nil {
+		return nil, err
+	}
 
 	defer func() {
 		for _, a := range amount {
@@ -191,7 +199,11 @@ func (k msgServer) WithdrawAllTokenizeShareRecordReward(goCtx context.Context, m
 		),
 	)
 
-	return &types.MsgWithdrawAllTokenizeShareRecordRewardResponse{}, nil
+	return &types.MsgWithdrawAllTokenizeShareRecordRewardResponse{
+		Amount:       amount,
+		Results:      results,
+		NextRecordId: nextRecordId,
+	}, nil
 }
 
 func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
@@ -215,3 +227,16 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCo
 
 	return &types.MsgFundCommunityPoolResponse{}, nil
 }
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PayoutTokenizeShareRecordRewards pays out the rewards of a bounded batch of
+// tokenize share records to their owners. A payout of all records starts every
+// payout interval blocks and proceeds in record id order from a persisted cursor,
+// at most batch size records and gas limit gas per block, until every record has
+// been visited.
+func (k Keeper) PayoutTokenizeShareRecordRewards(ctx sdk.Context) {
+	interval := k.GetTokenizeShareRewardPayoutInterval(ctx)
+	if interval == 0 {
+		return
+	}
+
+	// start a new payout on the interval unless the previous one is still in progress
+	cursor := k.GetTokenizeShareRewardPayoutCursor(ctx)
+	if cursor == 0 {
+		if ctx.BlockHeight()%int64(interval) != 0 {
+			return
+		}
+		cursor = 1
+	}
+
+	gasMeter := sdk.NewGasMeter(k.GetTokenizeShareRewardPayoutGasLimit(ctx))
+	payoutCtx := ctx.WithGasMeter(gasMeter)
+
+	records, nextRecordId := k.stakingKeeper.GetTokenizeShareRecordsFrom(ctx, cursor, k.GetTokenizeShareRewardPayoutBatchSize(ctx))
+	for i, record := range records {
+		if gasMeter.IsPastLimit() {
+			nextRecordId = record.Id
+			break
+		}
+
+		outOfGas, err := k.payoutTokenizeShareRecordReward(payoutCtx, record.Id)
+		if outOfGas && i > 0 {
+			// retry the record in the next block with a fresh gas budget
+			nextRecordId = record.Id
+			break
+		}
+		if outOfGas {
+			err = fmt.Errorf("out of gas with a gas limit of %d", gasMeter.Limit())
+		}
+		if err != nil {
+			k.Logger(ctx).Error("failed to pay out tokenize share record reward", "record_id", record.Id, "err", err)
+		}
+	}
+
+	k.SetTokenizeShareRewardPayoutCursor(ctx, nextRecordId)
+}
+
+// payoutTokenizeShareRecordReward withdraws the rewards of a record to its owner,
+// discarding all state changes if the withdrawal fails, panics or runs out of gas
+func (k Keeper) payoutTokenizeShareRecordReward(ctx sdk.Context, recordId uint64) (outOfGas bool, err error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	// a single record must not halt the begin blocker
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				outOfGas = true
+				return
+			}
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	if err := k.WithdrawSingleShareRecordReward(cacheCtx, recordId); err != nil {
+		return false, err
+	}
+
+	write()
+	return false, nil
+}
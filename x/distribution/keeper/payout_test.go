@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestPayoutTokenizeShareRecordRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize three records owned by the second address
+	owner := sdk.AccAddress(valAddrs[1])
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	for i := 0; i < 3; i++ {
+		_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+			DelegatorAddress:    sdk.AccAddress(valAddrs[0]).String(),
+			ValidatorAddress:    valAddrs[0].String(),
+			TokenizedShareOwner: owner.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)),
+		})
+		require.NoError(t, err)
+	}
+
+	// end block and allocate some rewards
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	recordRewards := func(ctx sdk.Context, recordId uint64) sdk.DecCoins {
+		record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+		require.NoError(t, err)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		del := app.StakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddrs[0])
+		endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+		return app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	}
+
+	// payouts are disabled by default
+	app.DistrKeeper.PayoutTokenizeShareRecordRewards(ctx)
+	require.Equal(t, uint64(0), app.DistrKeeper.GetTokenizeShareRewardPayoutCursor(ctx))
+	require.False(t, recordRewards(ctx, 1).IsZero())
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.TokenizeShareRewardPayoutInterval = 5
+	params.TokenizeShareRewardPayoutBatchSize = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// no payout starts outside of the interval
+	ctx = ctx.WithBlockHeight(11)
+	beforeBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+	app.DistrKeeper.PayoutTokenizeShareRecordRewards(ctx)
+	require.Equal(t, uint64(0), app.DistrKeeper.GetTokenizeShareRewardPayoutCursor(ctx))
+	require.Equal(t, beforeBalance, app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+
+	// the first batch is paid out at the start of the interval
+	ctx = ctx.WithBlockHeight(15)
+	app.DistrKeeper.PayoutTokenizeShareRecordRewards(ctx)
+	require.Equal(t, uint64(3), app.DistrKeeper.GetTokenizeShareRewardPayoutCursor(ctx))
+	require.True(t, recordRewards(ctx, 1).IsZero())
+	require.True(t, recordRewards(ctx, 2).IsZero())
+	require.False(t, recordRewards(ctx, 3).IsZero())
+	midBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+	require.True(t, midBalance.Amount.GT(beforeBalance.Amount))
+
+	// the payout continues in the next block until all records are visited
+	ctx = ctx.WithBlockHeight(16)
+	app.DistrKeeper.PayoutTokenizeShareRecordRewards(ctx)
+	require.Equal(t, uint64(0), app.DistrKeeper.GetTokenizeShareRewardPayoutCursor(ctx))
+	require.True(t, recordRewards(ctx, 3).IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount.GT(midBalance.Amount))
+
+	// a payout that runs out of gas on its first record skips it
+	params.TokenizeShareRewardPayoutGasLimit = 1
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(20)
+	app.DistrKeeper.PayoutTokenizeShareRecordRewards(ctx)
+	require.Equal(t, uint64(2), app.DistrKeeper.GetTokenizeShareRewardPayoutCursor(ctx))
+
+	// a payout that panics is logged and skipped without halting the block
+	params.TokenizeShareRewardPayoutGasLimit = types.DefaultTokenizeShareRewardPayoutGasLimit
+	app.DistrKeeper.SetParams(ctx, params)
+	app.DistrKeeper.DeleteValidatorCurrentRewards(ctx, valAddrs[0])
+	ctx = ctx.WithBlockHeight(21)
+	require.NotPanics(t, func() { app.DistrKeeper.PayoutTokenizeShareRecordRewards(ctx) })
+	require.Equal(t, uint64(0), app.DistrKeeper.GetTokenizeShareRewardPayoutCursor(ctx))
+}
@@ -82,6 +82,31 @@ func (k Keeper) SetPreviousProposerConsAddr(ctx sdk.Context, consAddr sdk.ConsAd
 	store.Set(types.ProposerKey, bz)
 }
 
+// get the id of the next tokenize share record to pay out, zero when no payout is in progress
+func (k Keeper) GetTokenizeShareRewardPayoutCursor(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TokenizeShareRewardPayoutCursorKey)
+	if bz == nil {
+		return 0
+	}
+
+	cursor := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &cursor)
+	return cursor.GetValue()
+}
+
+// set the id of the next tokenize share record to pay out
+func (k Keeper) SetTokenizeShareRewardPayoutCursor(ctx sdk.Context, recordId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if recordId == 0 {
+		store.Delete(types.TokenizeShareRewardPayoutCursorKey)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: recordId})
+	store.Set(types.TokenizeShareRewardPayoutCursorKey, bz)
+}
+
 // get the starting info associated with a delegator
 func (k Keeper) GetDelegatorStartingInfo(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress) (period types.DelegatorStartingInfo) {
 	store := ctx.KVStore(k.storeKey)
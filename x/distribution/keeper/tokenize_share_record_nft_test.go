@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	distrkeeper "github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestTokenizeShareRecordNFTOwnership(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenizing shares mints the record's NFT to the record owner
+	delegator, owner, newOwner := addr[0], addr[1], addr[2]
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	resp, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)),
+	})
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, owner, app.NFTKeeper.GetOwner(ctx, stakingtypes.TokenizeShareRecordNFTClassId, record.GetNFTId()))
+
+	// an nft send transfers the reward ownership
+	_, err = stakingkeeper.NewNFTMsgServerImpl(app.StakingKeeper, app.NFTKeeper).Send(sdk.WrapSDKContext(ctx), &nft.MsgSend{
+		ClassId:  stakingtypes.TokenizeShareRecordNFTClassId,
+		Id:       record.GetNFTId(),
+		Sender:   owner.String(),
+		Receiver: newOwner.String(),
+	})
+	require.NoError(t, err)
+
+	recordOwner, err := app.StakingKeeper.GetTokenizeShareRecordOwner(ctx, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, recordOwner)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	records := app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwner)
+	require.Len(t, records, 1)
+	require.Equal(t, newOwner.String(), records[0].Owner)
+
+	// allocate some rewards, which only the new owner can withdraw
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens.AmountOf(sdk.DefaultBondDenom).TruncateInt()))))
+
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, record.Id)
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, newOwner, record.Id)
+	require.NoError(t, err)
+	require.False(t, rewards.IsZero())
+
+	// redeeming all share tokens deletes the record and burns its NFT
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           resp.Amount,
+	})
+	require.NoError(t, err)
+	require.False(t, app.NFTKeeper.HasNFT(ctx, stakingtypes.TokenizeShareRecordNFTClassId, record.GetNFTId()))
+}
+
+func TestTokenizeShareRecordAuthorizationSpendLimit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	delegator, owner, grantee := addr[0], addr[1], addr[2]
+	_, err := stakingkeeper.NewMsgServerImpl(app.StakingKeeper).TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)),
+	})
+	require.NoError(t, err)
+
+	allocateRewards := func() {
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}}
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+		distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens.AmountOf(sdk.DefaultBondDenom).TruncateInt()))))
+	}
+
+	msg := types.NewMsgWithdrawTokenizeShareRecordReward(owner, 1)
+	spendLimit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 100)))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, grantee, owner, types.NewTokenizeShareRecordAuthorization(sdk.MsgTypeURL(msg), nil, spendLimit, nil), nil))
+
+	// the withdrawn rewards are charged to the spend limit
+	allocateRewards()
+	balanceBefore := app.BankKeeper.GetAllBalances(ctx, owner)
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+	require.NoError(t, err)
+	withdrawn := app.BankKeeper.GetAllBalances(ctx, owner).Sub(balanceBefore...)
+	require.False(t, withdrawn.IsZero())
+
+	authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, grantee, owner, sdk.MsgTypeURL(msg))
+	require.NotNil(t, authorization)
+	updated := authorization.(*types.TokenizeShareRecordAuthorization)
+	require.Equal(t, spendLimit.Sub(withdrawn...), updated.SpendLimit)
+	require.False(t, updated.Withdrawing)
+
+	// a withdrawal exceeding the remaining spend limit fails
+	oneToken := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, grantee, owner, types.NewTokenizeShareRecordAuthorization(sdk.MsgTypeURL(msg), nil, oneToken, nil), nil))
+	allocateRewards()
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = app.AuthzKeeper.DispatchActions(cacheCtx, grantee, []sdk.Msg{msg})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// withdrawals made by the owner are not charged to the authorization
+	_, err = distrkeeper.NewMsgServerImpl(app.DistrKeeper).WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	authorization, _ = app.AuthzKeeper.GetAuthorization(ctx, grantee, owner, sdk.MsgTypeURL(msg))
+	require.NotNil(t, authorization)
+	require.Equal(t, oneToken, authorization.(*types.TokenizeShareRecordAuthorization).SpendLimit)
+}
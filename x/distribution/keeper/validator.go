@@ -29,33 +29,43 @@ func (k Keeper) IncrementValidatorPeriod(ctx sdk.Context, val sdkstaking.Validat
 	// fetch current rewards
 	rewards := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
 
-	// calculate current ratio
-	var current sdk.DecCoins
+	// calculate current ratios
+	var current, currentLiquid sdk.DecCoins
 	if val.GetTokens().IsZero() {
 
 		// can't calculate ratio for zero-token validators
-		// ergo we instead add to the community pool
+		// ergo we instead add to the community pool the rewards owed to the
+		// regular and the liquid delegations
+		// note: necessary to truncate so we don't pay out more rewards than allocated
+		liquidFraction := k.getLiquidFraction(ctx, val)
+		owed := rewards.Rewards.MulDecTruncate(sdk.OneDec().Sub(liquidFraction)).
+			Add(rewards.LiquidRewards.MulDecTruncate(liquidFraction)...)
+
 		feePool := k.GetFeePool(ctx)
 		outstanding := k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
-		feePool.CommunityPool = feePool.CommunityPool.Add(rewards.Rewards...)
-		outstanding.Rewards = outstanding.GetRewards().Sub(rewards.Rewards)
+		feePool.CommunityPool = feePool.CommunityPool.Add(owed...)
+		outstanding.Rewards = outstanding.GetRewards().Sub(owed)
 		k.SetFeePool(ctx, feePool)
 		k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
 
 		current = sdk.DecCoins{}
+		currentLiquid = sdk.DecCoins{}
 	} else {
 		// note: necessary to truncate so we don't allow withdrawing more rewards than owed
 		current = rewards.Rewards.QuoDecTruncate(sdk.NewDecFromInt(val.GetTokens()))
+		currentLiquid = rewards.LiquidRewards.QuoDecTruncate(sdk.NewDecFromInt(val.GetTokens()))
 	}
 
 	// fetch historical rewards for last period
-	historical := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period-1).CumulativeRewardRatio
+	historical := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period-1)
 
 	// decrement reference count
 	k.decrementReferenceCount(ctx, val.GetOperator(), rewards.Period-1)
 
 	// set new historical rewards with reference count of 1
-	k.SetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period, types.NewValidatorHistoricalRewards(historical.Add(current...), 1))
+	newHistorical := types.NewValidatorHistoricalRewards(historical.CumulativeRewardRatio.Add(current...), 1)
+	newHistorical.CumulativeLiquidRewardRatio = historical.CumulativeLiquidRewardRatio.Add(currentLiquid...)
+	k.SetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period, newHistorical)
 
 	// set current rewards, incrementing period by 1
 	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), types.NewValidatorCurrentRewards(sdk.DecCoins{}, rewards.Period+1))
@@ -63,6 +73,17 @@ func (k Keeper) IncrementValidatorPeriod(ctx sdk.Context, val sdkstaking.Validat
 	return rewards.Period
 }
 
+// getLiquidFraction returns the fraction of the delegator shares of a validator
+// held by tokenize share record module accounts
+func (k Keeper) getLiquidFraction(ctx sdk.Context, val sdkstaking.ValidatorI) sdk.Dec {
+	shares := val.GetDelegatorShares()
+	if !shares.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	liquidShares := k.stakingKeeper.GetValidatorTokenizedShares(ctx, val.GetOperator())
+	return sdk.MinDec(liquidShares.Quo(shares), sdk.OneDec())
+}
+
 // increment the reference count for a historical rewards value
 func (k Keeper) incrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
 	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
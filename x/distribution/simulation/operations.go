@@ -270,8 +270,9 @@ func SimulateMsgWithdrawTokenizeShareRecordReward(ak types.AccountKeeper, bk typ
 		records := sk.GetAllTokenizeShareRecords(ctx)
 		if len(records) > 0 {
 			record := records[r.Intn(len(records))]
+			owner, _ := sk.GetTokenizeShareRecordOwner(ctx, record.Id)
 			for _, acc := range accs {
-				if acc.Address.String() == record.Owner {
+				if acc.Address.Equals(owner) {
 					rewardOwner = acc
 					break
 				}
@@ -283,7 +284,7 @@ func SimulateMsgWithdrawTokenizeShareRecordReward(ak types.AccountKeeper, bk typ
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "account private key is nil"), nil, nil
 		}
 
-		msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(rewardOwner.Address)
+		msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(rewardOwner.Address, nil, 0, 0)
 
 		account := ak.GetAccount(ctx, rewardOwner.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
@@ -0,0 +1,163 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// gasCostPerIteration is the gas consumed per record id or owner inspected on accept
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &TokenizeShareRecordAuthorization{}
+
+// NewTokenizeShareRecordAuthorization creates a new TokenizeShareRecordAuthorization object.
+// An empty recordIds applies the authorization to all records of the granter and an
+// empty spendLimit does not limit the withdrawn rewards.
+func NewTokenizeShareRecordAuthorization(
+	msgTypeURL string, recordIds []uint64, spendLimit sdk.Coins, allowedNewOwners []sdk.AccAddress,
+) *TokenizeShareRecordAuthorization {
+	owners := make([]string, len(allowedNewOwners))
+	for i, owner := range allowedNewOwners {
+		owners[i] = owner.String()
+	}
+
+	return &TokenizeShareRecordAuthorization{
+		MsgTypeUrl:       msgTypeURL,
+		RecordIds:        recordIds,
+		AllowedNewOwners: owners,
+		SpendLimit:       spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TokenizeShareRecordAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TokenizeShareRecordAuthorization) ValidateBasic() error {
+	isTransfer := a.MsgTypeUrl == sdk.MsgTypeURL(&stakingtypes.MsgTransferTokenizeShareRecord{})
+	switch {
+	case isTransfer:
+		if len(a.AllowedNewOwners) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowed new owners cannot be empty for record transfers")
+		}
+		if !a.SpendLimit.Empty() {
+			return sdkerrors.ErrInvalidRequest.Wrap("spend limit cannot be set for record transfers")
+		}
+	case a.MsgTypeUrl == sdk.MsgTypeURL(&MsgWithdrawTokenizeShareRecordReward{}),
+		a.MsgTypeUrl == sdk.MsgTypeURL(&MsgWithdrawAllTokenizeShareRecordReward{}):
+		if len(a.AllowedNewOwners) > 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowed new owners can only be set for record transfers")
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unsupported msg type url %s", a.MsgTypeUrl)
+	}
+
+	if !a.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit: %s", a.SpendLimit)
+	}
+	if a.Withdrawing {
+		return sdkerrors.ErrInvalidRequest.Wrap("withdrawing can only be set while a withdrawal is executed")
+	}
+
+	seen := make(map[uint64]bool, len(a.RecordIds))
+	for _, id := range a.RecordIds {
+		if seen[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate record id: %d", id)
+		}
+		seen[id] = true
+	}
+
+	for _, owner := range a.AllowedNewOwners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed new owner: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. The rewards of a withdrawal are only known
+// once it is executed, so an accepted withdrawal under a spend limit is flagged as
+// withdrawing and the distribution keeper charges the withdrawn rewards to the limit
+// through ChargeTokenizeShareRecordAuthorization.
+func (a TokenizeShareRecordAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	switch msg := msg.(type) {
+	case *MsgWithdrawTokenizeShareRecordReward:
+		if err := a.checkRecordId(ctx, msg.RecordId); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	case *MsgWithdrawAllTokenizeShareRecordReward:
+		if len(a.RecordIds) > 0 && len(msg.RecordIds) == 0 {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("record ids must be given when the authorization is scoped to records")
+		}
+		for _, id := range msg.RecordIds {
+			if err := a.checkRecordId(ctx, id); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+		}
+	case *stakingtypes.MsgTransferTokenizeShareRecord:
+		if err := a.checkRecordId(ctx, msg.TokenizeShareRecordId); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		if err := a.checkNewOwner(ctx, msg.NewOwner); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		return authz.AcceptResponse{Accept: true}, nil
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+
+	if a.SpendLimit.Empty() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	updated := a
+	updated.Withdrawing = true
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// Charge reduces the spend limit of an authorization flagged as withdrawing by the
+// withdrawn rewards and clears the flag. It returns an error if the rewards exceed
+// the spend limit, and whether the spend limit is used up.
+func (a TokenizeShareRecordAuthorization) Charge(withdrawn sdk.Coins) (updated *TokenizeShareRecordAuthorization, exhausted bool, err error) {
+	limitLeft, isNegative := a.SpendLimit.SafeSub(withdrawn...)
+	if isNegative {
+		return nil, false, sdkerrors.ErrUnauthorized.Wrapf("withdrawn rewards %s exceed the spend limit of %s", withdrawn, a.SpendLimit)
+	}
+
+	updated = &a
+	updated.SpendLimit = limitLeft
+	updated.Withdrawing = false
+	return updated, limitLeft.IsZero(), nil
+}
+
+// checkRecordId returns an error if the record is outside the scope of the authorization
+func (a TokenizeShareRecordAuthorization) checkRecordId(ctx sdk.Context, recordId uint64) error {
+	if len(a.RecordIds) == 0 {
+		return nil
+	}
+	for _, id := range a.RecordIds {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "tokenize share record authorization")
+		if id == recordId {
+			return nil
+		}
+	}
+	return sdkerrors.ErrUnauthorized.Wrapf("record %d is not authorized", recordId)
+}
+
+// checkNewOwner returns an error if records cannot be transferred to the owner
+func (a TokenizeShareRecordAuthorization) checkNewOwner(ctx sdk.Context, newOwner string) error {
+	for _, owner := range a.AllowedNewOwners {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "tokenize share record authorization")
+		if owner == newOwner {
+			return nil
+		}
+	}
+	return sdkerrors.ErrUnauthorized.Wrapf("cannot transfer records to %s", newOwner)
+}
@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: distribution/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TokenizeShareRecordAuthorization allows the grantee to withdraw the rewards of
+// the granter's tokenize share records, or to transfer them to a fixed set of owners.
+type TokenizeShareRecordAuthorization struct {
+	// msg_type_url is the type URL of the authorized message, one of
+	// Msg/WithdrawTokenizeShareRecordReward, Msg/WithdrawAllTokenizeShareRecordReward
+	// and the staking Msg/TransferTokenizeShareRecord.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// record_ids restricts the authorization to the given records. If it is empty,
+	// the authorization applies to all records of the granter.
+	RecordIds []uint64 `protobuf:"varint,2,rep,packed,name=record_ids,json=recordIds,proto3" json:"record_ids,omitempty"`
+	// allowed_new_owners are the only owners records can be transferred to.
+	AllowedNewOwners []string `protobuf:"bytes,4,rep,name=allowed_new_owners,json=allowedNewOwners,proto3" json:"allowed_new_owners,omitempty"`
+	// spend_limit optionally limits the rewards the grantee can withdraw, and is reduced
+	// by the rewards actually withdrawn. If it is empty, there is no limit.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// withdrawing is set while an accepted withdrawal is executed, until the
+	// distribution keeper charges the withdrawn rewards to the spend limit.
+	Withdrawing bool `protobuf:"varint,6,opt,name=withdrawing,proto3" json:"withdrawing,omitempty"`
+}
+
+func (m *TokenizeShareRecordAuthorization) Reset()         { *m = TokenizeShareRecordAuthorization{} }
+func (m *TokenizeShareRecordAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecordAuthorization) ProtoMessage()    {}
+func (*TokenizeShareRecordAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2de4bbb6938424, []int{0}
+}
+func (m *TokenizeShareRecordAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecordAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecordAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecordAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecordAuthorization.Merge(m, src)
+}
+func (m *TokenizeShareRecordAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecordAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecordAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecordAuthorization proto.InternalMessageInfo
+
+func (m *TokenizeShareRecordAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecordAuthorization) GetRecordIds() []uint64 {
+	if m != nil {
+		return m.RecordIds
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecordAuthorization) GetAllowedNewOwners() []string {
+	if m != nil {
+		return m.AllowedNewOwners
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecordAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecordAuthorization) GetWithdrawing() bool {
+	if m != nil {
+		return m.Withdrawing
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TokenizeShareRecordAuthorization)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordAuthorization")
+}
+
+func init() { proto.RegisterFile("distribution/v1beta1/authz.proto", fileDescriptor_3e2de4bbb6938424) }
+
+var fileDescriptor_3e2de4bbb6938424 = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0xc0, 0xe3, 0x26, 0x54, 0xcd, 0x05, 0xa4, 0x62, 0x75, 0x70, 0x2b, 0xe1, 0x9e, 0x3a, 0x79,
+	0xb1, 0x4d, 0x61, 0x63, 0x6b, 0x40, 0x48, 0x20, 0x04, 0x92, 0x5b, 0x16, 0x06, 0x2c, 0xdb, 0x77,
+	0xb2, 0x3f, 0xe5, 0x7c, 0xe7, 0xde, 0x77, 0xc6, 0x24, 0x4f, 0xc1, 0x73, 0x30, 0xf7, 0x21, 0x2a,
+	0xa6, 0x0a, 0x31, 0x30, 0x01, 0x4a, 0x5e, 0x04, 0xf9, 0x0f, 0x28, 0x99, 0xee, 0xbe, 0x3f, 0xbf,
+	0xef, 0x3f, 0xa1, 0x0c, 0xd0, 0x68, 0x48, 0x6b, 0x03, 0x4a, 0x86, 0x9f, 0xce, 0x53, 0x6e, 0x92,
+	0xf3, 0x30, 0xa9, 0x4d, 0xb1, 0x0a, 0x2a, 0xad, 0x8c, 0xb2, 0xcf, 0x04, 0x5c, 0xd7, 0xc0, 0xd0,
+	0x24, 0x0b, 0x90, 0x79, 0xb0, 0xed, 0x1f, 0x0c, 0xfe, 0x27, 0x47, 0xb9, 0xca, 0x55, 0xe7, 0x1e,
+	0xb6, 0xbf, 0x9e, 0x3c, 0x39, 0xce, 0x14, 0x96, 0x0a, 0xe3, 0xde, 0xd0, 0x0b, 0x83, 0xc9, 0xed,
+	0xa5, 0x30, 0x4d, 0x90, 0xff, 0xcf, 0x9a, 0x29, 0x90, 0xbd, 0xfd, 0xec, 0xc7, 0x1e, 0xa1, 0x57,
+	0x6a, 0xc1, 0x25, 0xac, 0xf8, 0x65, 0x91, 0x68, 0x1e, 0xf1, 0x4c, 0x69, 0x76, 0x51, 0x9b, 0x42,
+	0x69, 0x58, 0x25, 0x6d, 0x76, 0x9b, 0x92, 0xfb, 0x25, 0xe6, 0xb1, 0x59, 0x56, 0x3c, 0xae, 0xb5,
+	0x70, 0x2c, 0x6a, 0x79, 0xd3, 0x88, 0x94, 0x98, 0x5f, 0x2d, 0x2b, 0xfe, 0x5e, 0x0b, 0xfb, 0x11,
+	0x21, 0xba, 0x03, 0x63, 0x60, 0xe8, 0xec, 0xd1, 0xb1, 0x37, 0x89, 0xa6, 0xbd, 0xe6, 0x15, 0x43,
+	0xfb, 0x25, 0xb1, 0x13, 0x21, 0x54, 0xc3, 0x59, 0x2c, 0x79, 0x13, 0xab, 0x46, 0x72, 0x8d, 0xce,
+	0x84, 0x8e, 0xbd, 0xe9, 0xdc, 0xf9, 0x7e, 0xe3, 0x1f, 0x0d, 0x35, 0x5f, 0x30, 0xa6, 0x39, 0xe2,
+	0xa5, 0xd1, 0x20, 0xf3, 0xe8, 0x70, 0x60, 0xde, 0xf2, 0xe6, 0x5d, 0x47, 0xd8, 0x82, 0xcc, 0xb0,
+	0xe2, 0x92, 0xc5, 0x02, 0x4a, 0x30, 0xce, 0x3d, 0x3a, 0xf6, 0x66, 0x4f, 0x8e, 0x83, 0x81, 0x6e,
+	0x7b, 0xfc, 0x37, 0xa9, 0xe0, 0xb9, 0x02, 0x39, 0x7f, 0x7c, 0xfb, 0xeb, 0x74, 0xf4, 0xf5, 0xf7,
+	0xa9, 0x97, 0x83, 0x29, 0xea, 0x34, 0xc8, 0x54, 0x39, 0x8c, 0x67, 0x78, 0x7c, 0x64, 0x8b, 0xb0,
+	0xed, 0x0b, 0x3b, 0x00, 0x23, 0xd2, 0xc5, 0x7f, 0xd3, 0x86, 0xb7, 0x29, 0x99, 0x35, 0x60, 0x0a,
+	0xa6, 0x93, 0x06, 0x64, 0xee, 0xec, 0x53, 0xcb, 0x3b, 0x88, 0xb6, 0x55, 0xcf, 0x1e, 0x7e, 0xbb,
+	0xf1, 0x1f, 0xec, 0xcc, 0xea, 0xf5, 0xe4, 0x60, 0x7c, 0x38, 0x99, 0x7f, 0xbc, 0x5d, 0xbb, 0xd6,
+	0xdd, 0xda, 0xb5, 0xfe, 0xac, 0x5d, 0xeb, 0xcb, 0xc6, 0x1d, 0xdd, 0x6d, 0xdc, 0xd1, 0xcf, 0x8d,
+	0x3b, 0xfa, 0xf0, 0x62, 0xab, 0x14, 0xb8, 0x16, 0x35, 0x82, 0x92, 0x20, 0xb3, 0xb0, 0x5f, 0x3e,
+	0x98, 0xa5, 0x3f, 0x1c, 0x80, 0x5f, 0x2a, 0x56, 0x0b, 0x1e, 0x7e, 0x0e, 0x77, 0x2e, 0xa7, 0x2b,
+	0x36, 0xdd, 0xef, 0xb6, 0xf7, 0xf4, 0xef, 0x00, 0xc2, 0xbf, 0x16, 0x35, 0x56, 0x02, 0x00, 0x00,
+}
+
+func (m *TokenizeShareRecordAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecordAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecordAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Withdrawing {
+		i--
+		if m.Withdrawing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedNewOwners) > 0 {
+		for iNdEx := len(m.AllowedNewOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedNewOwners[iNdEx])
+			copy(dAtA[i:], m.AllowedNewOwners[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedNewOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecordIds) > 0 {
+		dAtA2 := make([]byte, len(m.RecordIds)*10)
+		var j1 int
+		for _, num := range m.RecordIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenizeShareRecordAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.RecordIds) > 0 {
+		l = 0
+		for _, e := range m.RecordIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.AllowedNewOwners) > 0 {
+		for _, s := range m.AllowedNewOwners {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Withdrawing {
+		n += 2
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenizeShareRecordAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecordAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecordAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RecordIds = append(m.RecordIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RecordIds) == 0 {
+					m.RecordIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RecordIds = append(m.RecordIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedNewOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedNewOwners = append(m.AllowedNewOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdrawing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestTokenizeShareRecordAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{}, false, log.NewNopLogger())
+	owner := sdk.AccAddress("_______owner________")
+	allowedOwner := sdk.AccAddress("___allowed_owner____")
+	otherOwner := sdk.AccAddress("____other_owner_____")
+
+	limit100 := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	withdrawURL := sdk.MsgTypeURL(&MsgWithdrawTokenizeShareRecordReward{})
+	withdrawAllURL := sdk.MsgTypeURL(&MsgWithdrawAllTokenizeShareRecordReward{})
+	transferURL := sdk.MsgTypeURL(&stakingtypes.MsgTransferTokenizeShareRecord{})
+
+	// ValidateBasic
+	require.NoError(t, NewTokenizeShareRecordAuthorization(withdrawURL, nil, nil, nil).ValidateBasic())
+	require.NoError(t, NewTokenizeShareRecordAuthorization(withdrawURL, nil, limit100, nil).ValidateBasic())
+	require.NoError(t, NewTokenizeShareRecordAuthorization(transferURL, []uint64{1}, nil, []sdk.AccAddress{allowedOwner}).ValidateBasic())
+	require.Error(t, NewTokenizeShareRecordAuthorization(transferURL, nil, nil, nil).ValidateBasic())
+	require.Error(t, NewTokenizeShareRecordAuthorization(transferURL, nil, limit100, []sdk.AccAddress{allowedOwner}).ValidateBasic())
+	require.Error(t, NewTokenizeShareRecordAuthorization(withdrawURL, nil, nil, []sdk.AccAddress{allowedOwner}).ValidateBasic())
+	require.Error(t, NewTokenizeShareRecordAuthorization(withdrawURL, []uint64{1, 1}, nil, nil).ValidateBasic())
+	require.Error(t, NewTokenizeShareRecordAuthorization(withdrawURL, nil, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}, nil).ValidateBasic())
+	require.Error(t, NewTokenizeShareRecordAuthorization(sdk.MsgTypeURL(&MsgFundCommunityPool{}), nil, nil, nil).ValidateBasic())
+	withdrawing := NewTokenizeShareRecordAuthorization(withdrawURL, nil, limit100, nil)
+	withdrawing.Withdrawing = true
+	require.Error(t, withdrawing.ValidateBasic())
+
+	tests := []struct {
+		name      string
+		auth      *TokenizeShareRecordAuthorization
+		msg       sdk.Msg
+		expectErr bool
+		isDelete  bool
+		updated   *TokenizeShareRecordAuthorization
+	}{
+		{
+			"withdraw: any record without a limit",
+			NewTokenizeShareRecordAuthorization(withdrawURL, nil, nil, nil),
+			NewMsgWithdrawTokenizeShareRecordReward(owner, 5),
+			false, false, nil,
+		},
+		{
+			"withdraw: record outside the scope",
+			NewTokenizeShareRecordAuthorization(withdrawURL, []uint64{1, 2}, nil, nil),
+			NewMsgWithdrawTokenizeShareRecordReward(owner, 5),
+			true, false, nil,
+		},
+		{
+			"withdraw: flagged as withdrawing under a spend limit",
+			NewTokenizeShareRecordAuthorization(withdrawURL, []uint64{1, 2}, limit100, nil),
+			NewMsgWithdrawTokenizeShareRecordReward(owner, 2),
+			false, false,
+			&TokenizeShareRecordAuthorization{MsgTypeUrl: withdrawURL, RecordIds: []uint64{1, 2}, SpendLimit: limit100, Withdrawing: true, AllowedNewOwners: []string{}},
+		},
+		{
+			"withdraw all: scoped authorization requires record ids",
+			NewTokenizeShareRecordAuthorization(withdrawAllURL, []uint64{1, 2}, nil, nil),
+			NewMsgWithdrawAllTokenizeShareRecordReward(owner, nil, 0, 0),
+			true, false, nil,
+		},
+		{
+			"withdraw all: records within the scope",
+			NewTokenizeShareRecordAuthorization(withdrawAllURL, []uint64{1, 2, 3}, nil, nil),
+			NewMsgWithdrawAllTokenizeShareRecordReward(owner, []uint64{1, 3}, 0, 0),
+			false, false, nil,
+		},
+		{
+			"transfer: allowed new owner",
+			NewTokenizeShareRecordAuthorization(transferURL, nil, nil, []sdk.AccAddress{allowedOwner}),
+			&stakingtypes.MsgTransferTokenizeShareRecord{TokenizeShareRecordId: 1, Sender: owner.String(), NewOwner: allowedOwner.String()},
+			false, false, nil,
+		},
+		{
+			"transfer: new owner not allowed",
+			NewTokenizeShareRecordAuthorization(transferURL, nil, nil, []sdk.AccAddress{allowedOwner}),
+			&stakingtypes.MsgTransferTokenizeShareRecord{TokenizeShareRecordId: 1, Sender: owner.String(), NewOwner: otherOwner.String()},
+			true, false, nil,
+		},
+		{
+			"transfer: record outside the scope",
+			NewTokenizeShareRecordAuthorization(transferURL, []uint64{2}, nil, []sdk.AccAddress{allowedOwner}),
+			&stakingtypes.MsgTransferTokenizeShareRecord{TokenizeShareRecordId: 1, Sender: owner.String(), NewOwner: allowedOwner.String()},
+			true, false, nil,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := tc.auth.Accept(ctx, tc.msg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.isDelete, resp.Delete)
+			if tc.updated != nil {
+				require.Equal(t, tc.updated.String(), resp.Updated.String())
+			}
+		})
+	}
+}
+
+func TestTokenizeShareRecordAuthorizationCharge(t *testing.T) {
+	auth := NewTokenizeShareRecordAuthorization(sdk.MsgTypeURL(&MsgWithdrawTokenizeShareRecordReward{}), nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil)
+	auth.Withdrawing = true
+
+	updated, exhausted, err := auth.Charge(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	require.NoError(t, err)
+	require.False(t, exhausted)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), updated.SpendLimit)
+	require.False(t, updated.Withdrawing)
+
+	_, exhausted, err = updated.Charge(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	require.NoError(t, err)
+	require.True(t, exhausted)
+
+	_, _, err = updated.Charge(sdk.NewCoins(sdk.NewInt64Coin("stake", 61)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, _, err = updated.Charge(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -32,6 +32,16 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// tokenize_share_reward_payout_interval is the number of blocks between the
+	// starts of automatic payouts of tokenize share record rewards; zero disables
+	// automatic payouts
+	TokenizeShareRewardPayoutInterval uint64 `protobuf:"varint,5,opt,name=tokenize_share_reward_payout_interval,json=tokenizeShareRewardPayoutInterval,proto3" json:"tokenize_share_reward_payout_interval,omitempty" yaml:"tokenize_share_reward_payout_interval"`
+	// tokenize_share_reward_payout_batch_size is the maximum number of records
+	// paid out per block
+	TokenizeShareRewardPayoutBatchSize uint64 `protobuf:"varint,6,opt,name=tokenize_share_reward_payout_batch_size,json=tokenizeShareRewardPayoutBatchSize,proto3" json:"tokenize_share_reward_payout_batch_size,omitempty" yaml:"tokenize_share_reward_payout_batch_size"`
+	// tokenize_share_reward_payout_gas_limit is the maximum gas consumed by
+	// automatic payouts per block; zero means no limit
+	TokenizeShareRewardPayoutGasLimit uint64 `protobuf:"varint,7,opt,name=tokenize_share_reward_payout_gas_limit,json=tokenizeShareRewardPayoutGasLimit,proto3" json:"tokenize_share_reward_payout_gas_limit,omitempty" yaml:"tokenize_share_reward_payout_gas_limit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +83,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetTokenizeShareRewardPayoutInterval() uint64 {
+	if m != nil {
+		return m.TokenizeShareRewardPayoutInterval
+	}
+	return 0
+}
+
+func (m *Params) GetTokenizeShareRewardPayoutBatchSize() uint64 {
+	if m != nil {
+		return m.TokenizeShareRewardPayoutBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetTokenizeShareRewardPayoutGasLimit() uint64 {
+	if m != nil {
+		return m.TokenizeShareRewardPayoutGasLimit
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,14 +111,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
+//
+// Cumulative liquid reward ratio is the same sum for the rewards of delegations
+// held by tokenize share record module accounts, which are net of the liquid
+// commission rate instead of the regular one.
 type ValidatorHistoricalRewards struct {
-	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
-	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+	CumulativeRewardRatio       github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
+	ReferenceCount              uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+	CumulativeLiquidRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=cumulative_liquid_reward_ratio,json=cumulativeLiquidRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_liquid_reward_ratio"`
 }
 
 func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalRewards{} }
@@ -137,12 +174,22 @@ func (m *ValidatorHistoricalRewards) GetReferenceCount() uint32 {
 	return 0
 }
 
+func (m *ValidatorHistoricalRewards) GetCumulativeLiquidRewardRatio() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeLiquidRewardRatio
+	}
+	return nil
+}
+
 // ValidatorCurrentRewards represents current rewards and current
 // period for a validator kept as a running counter and incremented
 // each block as long as the validator's tokens remain constant.
+// Liquid rewards are the rewards of the period net of the liquid commission
+// rate, owed to delegations held by tokenize share record module accounts.
 type ValidatorCurrentRewards struct {
-	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
-	Period  uint64                                      `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	Rewards       github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+	Period        uint64                                      `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	LiquidRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=liquid_rewards,json=liquidRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"liquid_rewards"`
 }
 
 func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards{} }
@@ -192,6 +239,13 @@ func (m *ValidatorCurrentRewards) GetPeriod() uint64 {
 	return 0
 }
 
+func (m *ValidatorCurrentRewards) GetLiquidRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.LiquidRewards
+	}
+	return nil
+}
+
 // ValidatorAccumulatedCommission represents accumulated commission
 // for a validator kept as a running counter, can be withdrawn at any time.
 type ValidatorAccumulatedCommission struct {
@@ -528,6 +582,11 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 type DelegationDelegatorReward struct {
 	ValidatorAddress string                                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Reward           github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+	// record_id is the tokenize share record the reward is accrued through, if any.
+	RecordId uint64 `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// module_address is the tokenize share record module account holding the
+	// delegation, set only when record_id is.
+	ModuleAddress string `protobuf:"bytes,4,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
 }
 
 func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorReward{} }
@@ -666,70 +725,83 @@ func init() {
 }
 
 var fileDescriptor_c3e6168184371676 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xb4, 0x8e, 0x93, 0x4e, 0x69, 0x02, 0x13, 0x27, 0x75, 0x4c, 0x65, 0x47, 0x2b, 0xd1,
-	0x06, 0x2a, 0xdb, 0xb4, 0x3d, 0x20, 0x45, 0x5c, 0xf2, 0x0b, 0xd1, 0x13, 0xd1, 0xa6, 0x02, 0xc4,
-	0x65, 0x35, 0xde, 0x7d, 0xb1, 0x47, 0xd9, 0x9d, 0xd9, 0xcc, 0xcc, 0x3a, 0x09, 0xd7, 0x1e, 0xf8,
-	0x71, 0x02, 0x71, 0x41, 0x1c, 0x50, 0x8e, 0x08, 0x71, 0xcc, 0x3f, 0xc0, 0xad, 0xe2, 0x54, 0x7a,
-	0x29, 0xe2, 0x10, 0x50, 0x72, 0x41, 0xfc, 0x15, 0x68, 0x76, 0xc6, 0x6b, 0x17, 0x02, 0xf4, 0x90,
-	0xa8, 0xa7, 0xe4, 0xbd, 0xb7, 0xf3, 0xbe, 0xef, 0x7b, 0xf3, 0xde, 0x1b, 0xe3, 0x5b, 0x11, 0x53,
-	0x5a, 0xb2, 0x6e, 0xa6, 0x99, 0xe0, 0x9d, 0xc1, 0x9d, 0x2e, 0x68, 0x7a, 0xa7, 0x33, 0xee, 0x6c,
-	0xa7, 0x52, 0x68, 0x41, 0xbc, 0x98, 0xed, 0x66, 0x2c, 0x52, 0x9a, 0xee, 0x30, 0xde, 0x6b, 0x3f,
-	0xf3, 0x85, 0x3b, 0x56, 0xaf, 0xf6, 0x44, 0x4f, 0xe4, 0x9f, 0x77, 0xcc, 0x7f, 0xf6, 0x64, 0xbd,
-	0x11, 0x0a, 0x95, 0x08, 0xd5, 0xe9, 0x52, 0x05, 0x05, 0x42, 0x28, 0x98, 0xcb, 0x5c, 0x5f, 0xb0,
-	0xf1, 0xc0, 0x1e, 0xb4, 0x86, 0x0d, 0x79, 0x9f, 0x5c, 0xc6, 0x95, 0x4d, 0x2a, 0x69, 0xa2, 0x08,
-	0xc5, 0xd7, 0x42, 0x91, 0x24, 0x19, 0x67, 0xfa, 0x20, 0xd0, 0x74, 0xbf, 0x86, 0x16, 0xd1, 0xd2,
-	0x95, 0xd5, 0xb7, 0x1f, 0x1d, 0x37, 0x4b, 0xbf, 0x1e, 0x37, 0x6f, 0xf6, 0x98, 0xee, 0x67, 0xdd,
-	0x76, 0x28, 0x12, 0x97, 0xc2, 0xfd, 0x69, 0xa9, 0x68, 0xa7, 0xa3, 0x0f, 0x52, 0x50, 0xed, 0x75,
-	0x08, 0x9f, 0x1c, 0xb5, 0xb0, 0x43, 0x58, 0x87, 0xd0, 0x7f, 0xa9, 0x48, 0xf9, 0x80, 0xee, 0x13,
-	0x8e, 0xab, 0x86, 0xa3, 0x21, 0x92, 0x0a, 0x05, 0x32, 0x90, 0xb0, 0x47, 0x65, 0x54, 0xbb, 0x74,
-	0x0e, 0x48, 0xc4, 0x64, 0xde, 0x74, 0x89, 0xfd, 0x3c, 0x2f, 0x49, 0xf1, 0x5c, 0x57, 0xf0, 0x4c,
-	0xfd, 0x03, 0xf0, 0xf2, 0x39, 0x00, 0xce, 0xe6, 0xa9, 0xff, 0x86, 0x78, 0x17, 0xcf, 0xed, 0x31,
-	0xdd, 0x8f, 0x24, 0xdd, 0x0b, 0x68, 0x14, 0xc9, 0x00, 0x38, 0xed, 0xc6, 0x10, 0xd5, 0xca, 0x8b,
-	0x68, 0x69, 0xca, 0x9f, 0x1d, 0x06, 0x57, 0xa2, 0x48, 0x6e, 0xd8, 0xd0, 0x72, 0xf9, 0xeb, 0xc3,
-	0x66, 0xc9, 0xfb, 0x19, 0xe1, 0xfa, 0xfb, 0x34, 0x66, 0x11, 0xd5, 0x42, 0xbe, 0xcb, 0x94, 0x16,
-	0x92, 0x85, 0x34, 0xb6, 0x79, 0x15, 0xf9, 0x0c, 0xe1, 0xeb, 0x61, 0x96, 0x64, 0x31, 0xd5, 0x6c,
-	0x00, 0x4e, 0x47, 0x20, 0xa9, 0x66, 0xa2, 0x86, 0x16, 0x2f, 0x2f, 0x5d, 0xbd, 0x7b, 0xa3, 0xed,
-	0xc8, 0x99, 0x42, 0x0c, 0x3b, 0xc6, 0x30, 0x5d, 0x13, 0x8c, 0xaf, 0xde, 0x33, 0x5a, 0xbf, 0xff,
-	0xad, 0x79, 0xfb, 0xf9, 0xb4, 0x9a, 0x33, 0xca, 0x9f, 0x1b, 0x21, 0x5a, 0x1e, 0xbe, 0xc1, 0x23,
-	0xb7, 0xf0, 0x8c, 0x84, 0x6d, 0x90, 0xc0, 0x43, 0x08, 0x42, 0x91, 0x71, 0x9d, 0xdf, 0xe0, 0x35,
-	0x7f, 0xba, 0x70, 0xaf, 0x19, 0xaf, 0xf7, 0x2d, 0xc2, 0xd7, 0x0b, 0x4d, 0x6b, 0x99, 0x94, 0xc0,
-	0xf5, 0x50, 0xd0, 0x0e, 0x9e, 0xb4, 0x22, 0xd4, 0xc5, 0xf1, 0x1f, 0x22, 0x90, 0x79, 0x5c, 0x49,
-	0x41, 0x32, 0x61, 0x5b, 0xad, 0xec, 0x3b, 0xcb, 0xfb, 0x0a, 0xe1, 0x46, 0x41, 0x70, 0x25, 0x74,
-	0x72, 0x21, 0x5a, 0x13, 0x49, 0xc2, 0x94, 0x62, 0x82, 0x93, 0x5d, 0x8c, 0xc3, 0xc2, 0xba, 0x38,
-	0xaa, 0x63, 0x20, 0xde, 0xe7, 0x08, 0xbf, 0x5a, 0xb0, 0x7a, 0x2f, 0xd3, 0x4a, 0x53, 0x1e, 0x31,
-	0xde, 0x7b, 0x11, 0xa5, 0xf3, 0xbe, 0x41, 0x78, 0xb6, 0x20, 0xb3, 0x15, 0x53, 0xd5, 0xdf, 0x18,
-	0x00, 0xd7, 0xe4, 0x75, 0xfc, 0xf2, 0x60, 0xe8, 0x0e, 0x5c, 0x71, 0x51, 0x5e, 0xdc, 0x99, 0xc2,
-	0xbf, 0x99, 0xbb, 0xc9, 0x87, 0x78, 0x6a, 0x5b, 0xd2, 0xd0, 0x6c, 0xb2, 0x73, 0x19, 0xf5, 0x22,
-	0x9b, 0xf7, 0x25, 0xc2, 0xd5, 0x33, 0xc8, 0x29, 0xa2, 0xf0, 0xfc, 0x88, 0x9d, 0x32, 0x81, 0x00,
-	0xf2, 0x88, 0xab, 0xd8, 0x5b, 0xed, 0xff, 0xdf, 0xb6, 0xed, 0x33, 0x32, 0xaf, 0x96, 0x0d, 0x73,
-	0xbf, 0x3a, 0x38, 0x03, 0xd4, 0x0d, 0xf2, 0x43, 0x84, 0x27, 0xdf, 0x01, 0xd8, 0x14, 0x22, 0x26,
-	0xfb, 0x78, 0x7a, 0xb4, 0x53, 0x53, 0x21, 0xe2, 0x8b, 0xbb, 0xb0, 0xd1, 0xf2, 0x36, 0xc8, 0xde,
-	0xc3, 0x4b, 0xb8, 0xbe, 0x36, 0xee, 0xd9, 0x4a, 0x81, 0x47, 0x76, 0x5b, 0xd1, 0x98, 0x54, 0xf1,
-	0x84, 0x66, 0x3a, 0x06, 0xbb, 0xe4, 0x7d, 0x6b, 0x90, 0x45, 0x7c, 0x35, 0x02, 0x15, 0x4a, 0x96,
-	0x8e, 0xee, 0xca, 0x1f, 0x77, 0x91, 0x1b, 0xf8, 0x8a, 0x84, 0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0x5b,
-	0xd4, 0x1f, 0x39, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0xf7, 0x41, 0x39, 0x97, 0xb9, 0x70, 0xa6, 0xcc,
-	0x5c, 0xe3, 0x9b, 0x4e, 0xe3, 0xd2, 0x73, 0x68, 0xb4, 0x02, 0x5d, 0xea, 0xe5, 0x37, 0x3e, 0x3d,
-	0x6c, 0x96, 0x4c, 0xa5, 0xff, 0x38, 0x6c, 0x96, 0x7e, 0x3a, 0x6a, 0xd5, 0x1d, 0x46, 0x4f, 0x0c,
-	0xc6, 0x20, 0xb8, 0x06, 0xae, 0xbd, 0x1f, 0x11, 0x9e, 0x5b, 0x87, 0x18, 0x7a, 0xf9, 0x55, 0x69,
-	0x2a, 0x35, 0xe3, 0xbd, 0xfb, 0x7c, 0x3b, 0xdf, 0x61, 0xa9, 0x84, 0x01, 0x13, 0xe6, 0x75, 0x18,
-	0xef, 0xde, 0xe9, 0xa1, 0xdb, 0x35, 0xaf, 0x8f, 0x27, 0x4c, 0x93, 0xc0, 0xb9, 0x74, 0xae, 0x4d,
-	0x45, 0x6e, 0xe3, 0x4a, 0x1f, 0x58, 0xaf, 0x6f, 0x4b, 0x58, 0x5e, 0x9d, 0xfd, 0xf3, 0xb8, 0x39,
-	0x13, 0x4a, 0x30, 0xdb, 0x95, 0x07, 0x36, 0xe4, 0xbb, 0x4f, 0xbc, 0xa7, 0x08, 0x2f, 0x38, 0x0d,
-	0x4c, 0xf0, 0x42, 0x8d, 0x7b, 0x70, 0x36, 0xf0, 0x2b, 0xa3, 0x46, 0x37, 0x2f, 0x0e, 0x28, 0xe5,
-	0x5e, 0xee, 0xda, 0x93, 0xa3, 0x56, 0xd5, 0x81, 0xaf, 0xd8, 0xc8, 0x96, 0x96, 0x66, 0x8f, 0x8c,
-	0x26, 0xd7, 0xf9, 0x09, 0xc3, 0x95, 0xe2, 0x2d, 0xbe, 0xa0, 0x06, 0x75, 0x00, 0xcb, 0x53, 0xee,
-	0xfe, 0x90, 0xf7, 0x03, 0xc2, 0x0b, 0x0f, 0xc4, 0x0e, 0x70, 0xf6, 0x31, 0x6c, 0xf5, 0xa9, 0x04,
-	0x1f, 0x42, 0x21, 0x23, 0xa7, 0xac, 0x8e, 0xa7, 0x64, 0x6e, 0xdf, 0x1f, 0x5e, 0x4d, 0x61, 0xbf,
-	0x18, 0xba, 0x4f, 0x11, 0x7e, 0xed, 0xdf, 0x47, 0xea, 0x03, 0xa6, 0xfb, 0xeb, 0x90, 0x0a, 0xc5,
-	0xf4, 0x05, 0x4d, 0xd7, 0xfc, 0xd8, 0x74, 0x99, 0x90, 0xb3, 0x48, 0x0d, 0x4f, 0x46, 0x16, 0xb8,
-	0x36, 0x91, 0x07, 0x86, 0xe6, 0xf2, 0xcd, 0x21, 0xf7, 0xff, 0x1e, 0x93, 0xd5, 0xee, 0x77, 0x27,
-	0x0d, 0xf4, 0xe8, 0xa4, 0x81, 0x1e, 0x9f, 0x34, 0xd0, 0xef, 0x27, 0x0d, 0xf4, 0xc5, 0x69, 0xa3,
-	0xf4, 0xf8, 0xb4, 0x51, 0xfa, 0xe5, 0xb4, 0x51, 0xfa, 0x68, 0x7d, 0xac, 0x6c, 0x6c, 0x37, 0xce,
-	0xcc, 0x1b, 0xc5, 0x78, 0xd8, 0xb1, 0x1b, 0x94, 0xe9, 0x83, 0x96, 0xdb, 0xa2, 0xad, 0x44, 0x44,
-	0x59, 0x0c, 0x9d, 0xfd, 0x67, 0x7e, 0xde, 0xda, 0xc2, 0x76, 0x2b, 0xf9, 0x0f, 0xce, 0x7b, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x23, 0x17, 0x82, 0x10, 0x0b, 0x00, 0x00,
+	// 1208 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xa4, 0xae, 0x93, 0x4e, 0xbf, 0x49, 0xbf, 0x4c, 0x9c, 0xd6, 0x71, 0x2b, 0x3b, 0xac,
+	0xd4, 0x36, 0x50, 0x6c, 0xf7, 0xc7, 0x01, 0x29, 0x42, 0x42, 0x75, 0x52, 0xa0, 0x52, 0x25, 0xa2,
+	0x4d, 0x05, 0x88, 0xcb, 0x6a, 0xbc, 0x3b, 0xb5, 0x47, 0xd9, 0x9d, 0xd9, 0xce, 0xcc, 0xba, 0x49,
+	0x8f, 0x14, 0x89, 0x1f, 0x27, 0x10, 0x12, 0xaa, 0x90, 0x90, 0x7a, 0x44, 0x88, 0x63, 0xf8, 0x03,
+	0xb8, 0x55, 0x9c, 0xaa, 0x5e, 0x40, 0x1c, 0x0c, 0x4a, 0x2e, 0xa8, 0xc7, 0xfc, 0x05, 0x68, 0x76,
+	0xc6, 0xeb, 0x0d, 0xa4, 0x69, 0x0e, 0x31, 0x9c, 0xec, 0x79, 0xf3, 0xe6, 0x7d, 0x3e, 0xef, 0x33,
+	0x6f, 0x66, 0xde, 0xc2, 0x8b, 0x01, 0x95, 0x4a, 0xd0, 0x4e, 0xa2, 0x28, 0x67, 0xad, 0xfe, 0x95,
+	0x0e, 0x51, 0xf8, 0x4a, 0x2b, 0x6f, 0x6c, 0xc6, 0x82, 0x2b, 0x8e, 0x9c, 0x90, 0xde, 0x4d, 0x68,
+	0x20, 0x15, 0x5e, 0xa7, 0xac, 0xdb, 0xdc, 0xe3, 0x61, 0x97, 0x55, 0xcb, 0x5d, 0xde, 0xe5, 0xa9,
+	0x7b, 0x4b, 0xff, 0x33, 0x2b, 0xab, 0x35, 0x9f, 0xcb, 0x88, 0xcb, 0x56, 0x07, 0x4b, 0x92, 0x21,
+	0xf8, 0x9c, 0xda, 0xc8, 0xd5, 0x79, 0x33, 0xef, 0x99, 0x85, 0x66, 0x60, 0xa6, 0x9c, 0x6f, 0x4b,
+	0xb0, 0xb4, 0x8a, 0x05, 0x8e, 0x24, 0xc2, 0x70, 0xda, 0xe7, 0x51, 0x94, 0x30, 0xaa, 0x36, 0x3d,
+	0x85, 0x37, 0x2a, 0x60, 0x01, 0x2c, 0x9e, 0x68, 0xbf, 0xf1, 0x78, 0x50, 0x2f, 0xfc, 0x36, 0xa8,
+	0x5f, 0xe8, 0x52, 0xd5, 0x4b, 0x3a, 0x4d, 0x9f, 0x47, 0x36, 0x84, 0xfd, 0x69, 0xc8, 0x60, 0xbd,
+	0xa5, 0x36, 0x63, 0x22, 0x9b, 0x2b, 0xc4, 0x7f, 0xba, 0xd5, 0x80, 0x16, 0x61, 0x85, 0xf8, 0xee,
+	0xff, 0xb2, 0x90, 0xb7, 0xf1, 0x06, 0x62, 0xb0, 0xac, 0x39, 0x6a, 0x22, 0x31, 0x97, 0x44, 0x78,
+	0x82, 0xdc, 0xc3, 0x22, 0xa8, 0x4c, 0x1c, 0x01, 0x12, 0xd2, 0x91, 0x57, 0x6d, 0x60, 0x37, 0x8d,
+	0x8b, 0x62, 0x38, 0xd7, 0xe1, 0x2c, 0x91, 0xff, 0x00, 0x3c, 0x76, 0x04, 0x80, 0xb3, 0x69, 0xe8,
+	0xbf, 0x21, 0x5e, 0x85, 0x73, 0xf7, 0xa8, 0xea, 0x05, 0x02, 0xdf, 0xf3, 0x70, 0x10, 0x08, 0x8f,
+	0x30, 0xdc, 0x09, 0x49, 0x50, 0x29, 0x2e, 0x80, 0xc5, 0x29, 0x77, 0x76, 0x38, 0x79, 0x3d, 0x08,
+	0xc4, 0x0d, 0x33, 0x85, 0x3e, 0x02, 0xf0, 0xbc, 0xe2, 0xeb, 0x84, 0xd1, 0xfb, 0xc4, 0x93, 0x3d,
+	0x2c, 0x88, 0xa5, 0xe9, 0xc5, 0x78, 0x93, 0x27, 0xca, 0xa3, 0x4c, 0x11, 0xd1, 0xc7, 0x61, 0xe5,
+	0xf8, 0x02, 0x58, 0x2c, 0xb6, 0x2f, 0xef, 0x0e, 0xea, 0xaf, 0x6d, 0xe2, 0x28, 0x5c, 0x72, 0x0e,
+	0xb5, 0xcc, 0x71, 0x5f, 0x1e, 0xfa, 0xad, 0x69, 0x37, 0x43, 0x75, 0x35, 0x75, 0xba, 0x69, 0x7d,
+	0xd0, 0x27, 0x00, 0x5e, 0x3c, 0x30, 0x5a, 0x07, 0x2b, 0xbf, 0xe7, 0x49, 0x7a, 0x9f, 0x54, 0x4a,
+	0x29, 0x8d, 0xab, 0xbb, 0x83, 0x7a, 0xf3, 0x10, 0x34, 0x46, 0x0b, 0x1d, 0xd7, 0x79, 0x2e, 0x91,
+	0xb6, 0xf6, 0x5a, 0xa3, 0xf7, 0x09, 0xfa, 0x18, 0xc0, 0x0b, 0x07, 0x06, 0xec, 0x62, 0xe9, 0x85,
+	0x34, 0xa2, 0xaa, 0x32, 0x99, 0x12, 0xb9, 0xb2, 0x3b, 0xa8, 0x37, 0x0e, 0x41, 0x24, 0x5b, 0x77,
+	0x90, 0x20, 0x6f, 0x63, 0x79, 0x4b, 0xfb, 0x2c, 0x15, 0x1f, 0x3e, 0xaa, 0x17, 0x9c, 0x67, 0x13,
+	0xb0, 0xfa, 0x1e, 0x0e, 0x69, 0x80, 0x15, 0x17, 0xef, 0x50, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0xcd,
+	0x0a, 0x89, 0x3e, 0x03, 0xf0, 0x8c, 0x9f, 0x44, 0x49, 0x88, 0x15, 0xed, 0x67, 0x78, 0x02, 0x2b,
+	0xca, 0x2b, 0x60, 0xe1, 0xd8, 0xe2, 0xc9, 0xab, 0xe7, 0x9a, 0xb6, 0x64, 0x74, 0x79, 0x0e, 0xcf,
+	0xb1, 0xae, 0x9f, 0x65, 0x4e, 0x59, 0xfb, 0x9a, 0xae, 0xc0, 0xef, 0x7f, 0xaf, 0x5f, 0x3a, 0x5c,
+	0x05, 0xea, 0x35, 0xd2, 0x9d, 0x1b, 0x21, 0x1a, 0x1e, 0xae, 0xc6, 0x43, 0x17, 0xe1, 0x29, 0x41,
+	0xee, 0x10, 0x41, 0x98, 0x4f, 0x3c, 0x9f, 0x27, 0x4c, 0xa5, 0xe7, 0x6a, 0xda, 0x9d, 0xc9, 0xcc,
+	0xcb, 0xda, 0x8a, 0xbe, 0x06, 0xb0, 0x96, 0x23, 0x6d, 0xae, 0x9d, 0xbd, 0xdc, 0x8f, 0x8d, 0x8b,
+	0xfb, 0xd9, 0x11, 0xf0, 0xad, 0x14, 0x37, 0x97, 0x81, 0xf3, 0x70, 0x02, 0x9e, 0xc9, 0xc4, 0x5e,
+	0x4e, 0x84, 0x20, 0x4c, 0x0d, 0x95, 0x5e, 0x87, 0x93, 0x86, 0xa1, 0x1c, 0x9f, 0xb0, 0x43, 0x04,
+	0x74, 0x1a, 0x96, 0x62, 0x22, 0x28, 0x37, 0x37, 0x53, 0xd1, 0xb5, 0x23, 0xb4, 0x01, 0x67, 0xf6,
+	0xa8, 0x25, 0xc7, 0x27, 0xd4, 0x74, 0x98, 0x93, 0x47, 0x3a, 0x5f, 0x01, 0x58, 0xcb, 0xa4, 0xb9,
+	0xee, 0x5b, 0x15, 0x49, 0xb0, 0xcc, 0xa3, 0x88, 0x4a, 0x49, 0x39, 0x43, 0x77, 0x21, 0xf4, 0xb3,
+	0xd1, 0xf8, 0x44, 0xca, 0x81, 0x38, 0x9f, 0x03, 0x78, 0x36, 0x63, 0xf5, 0x6e, 0xa2, 0xa4, 0xc2,
+	0x2c, 0xa0, 0xac, 0xfb, 0x5f, 0x6c, 0x9a, 0xf3, 0x0d, 0x80, 0xb3, 0x19, 0x99, 0xb5, 0x10, 0xcb,
+	0xde, 0x8d, 0x3e, 0x61, 0x0a, 0xbd, 0x02, 0xff, 0xdf, 0x1f, 0x9a, 0x3d, 0xbb, 0xad, 0x20, 0xdd,
+	0xd6, 0x53, 0x99, 0x7d, 0xd5, 0xec, 0xef, 0x07, 0x70, 0xea, 0x8e, 0xc0, 0xbe, 0x7e, 0x72, 0x8f,
+	0xe4, 0x4d, 0xca, 0xa2, 0x39, 0x5f, 0x02, 0x58, 0xde, 0x87, 0x9c, 0x44, 0x12, 0x9e, 0x1e, 0xb1,
+	0x93, 0x7a, 0xc2, 0x23, 0xe9, 0x8c, 0x55, 0xec, 0xf5, 0xe6, 0x8b, 0xdb, 0x82, 0xe6, 0x3e, 0x91,
+	0xdb, 0x45, 0xcd, 0xdc, 0x2d, 0xf7, 0xf7, 0x01, 0xb5, 0x77, 0xdb, 0x03, 0x00, 0x27, 0xdf, 0x22,
+	0x64, 0x95, 0xf3, 0x50, 0x57, 0xf6, 0xe8, 0xf1, 0x8f, 0x39, 0x0f, 0xc7, 0xb7, 0x61, 0xa3, 0x2e,
+	0x43, 0x23, 0x3b, 0x0f, 0x26, 0x60, 0x75, 0x39, 0x6f, 0x59, 0x8b, 0x09, 0x0b, 0xcc, 0xb3, 0x8a,
+	0x43, 0x54, 0x86, 0xc7, 0x15, 0x55, 0x21, 0x31, 0xdd, 0x88, 0x6b, 0x06, 0x68, 0x01, 0x9e, 0x0c,
+	0x88, 0xf4, 0x05, 0x8d, 0x47, 0x7b, 0xe5, 0xe6, 0x4d, 0xe8, 0x1c, 0x3c, 0x21, 0x88, 0x4f, 0x63,
+	0x4a, 0x98, 0x32, 0xcf, 0xbd, 0x3b, 0x32, 0x20, 0x1f, 0x96, 0x70, 0x94, 0x5e, 0x91, 0xc5, 0x34,
+	0xcd, 0xf9, 0x7d, 0xd3, 0x4c, 0x73, 0xbc, 0x6c, 0x73, 0x5c, 0x3c, 0x44, 0x8e, 0x26, 0x41, 0x1b,
+	0x7a, 0xe9, 0xd5, 0x4f, 0x1f, 0xd5, 0x0b, 0x5a, 0xe9, 0x3f, 0x1f, 0xd5, 0x0b, 0x3f, 0x6f, 0x35,
+	0xaa, 0x16, 0xa3, 0xcb, 0xfb, 0x39, 0x08, 0xa6, 0x08, 0x53, 0xce, 0x4f, 0x00, 0xce, 0xad, 0x90,
+	0x90, 0x74, 0xd3, 0xad, 0x52, 0x58, 0x28, 0xca, 0xba, 0x37, 0xd9, 0x9d, 0xf4, 0x5a, 0x8f, 0x05,
+	0xe9, 0x53, 0xae, 0xdb, 0x98, 0x7c, 0xf5, 0xce, 0x0c, 0xcd, 0xb6, 0x78, 0x5d, 0x78, 0x5c, 0x17,
+	0x09, 0x39, 0x92, 0xca, 0x35, 0xa1, 0xd0, 0x25, 0x58, 0xea, 0x11, 0xda, 0xed, 0x19, 0x09, 0x8b,
+	0xed, 0xd9, 0x67, 0x83, 0xfa, 0x29, 0x5f, 0x10, 0xac, 0x35, 0xf6, 0xcc, 0x94, 0x6b, 0x5d, 0x9c,
+	0x1f, 0x27, 0xe0, 0xbc, 0xcd, 0x81, 0x72, 0x96, 0x65, 0x63, 0x3b, 0xa3, 0x1b, 0xf0, 0xa5, 0x51,
+	0xa1, 0xeb, 0xd6, 0x88, 0x48, 0x69, 0x5b, 0xcc, 0xca, 0xd3, 0xad, 0x46, 0xd9, 0x82, 0x5f, 0x37,
+	0x33, 0x6b, 0x4a, 0xe8, 0x7b, 0x64, 0x74, 0x72, 0xad, 0x1d, 0x51, 0x58, 0xca, 0x9a, 0xc6, 0x31,
+	0x15, 0xa8, 0x05, 0x40, 0x67, 0xd3, 0x12, 0xe2, 0x22, 0xf0, 0xa8, 0xe9, 0x18, 0x8b, 0xee, 0x94,
+	0x31, 0xdc, 0x0c, 0xd0, 0x9b, 0x70, 0x26, 0xe2, 0x41, 0x12, 0x92, 0x2c, 0x97, 0xe2, 0x0b, 0x72,
+	0x99, 0x36, 0xfe, 0xd6, 0xb8, 0x34, 0x65, 0xab, 0x03, 0x38, 0x3f, 0x00, 0x38, 0x7f, 0x7b, 0x6f,
+	0x3f, 0xa2, 0x41, 0xac, 0x6e, 0x55, 0x98, 0x81, 0xda, 0x8d, 0x1f, 0x91, 0xf8, 0xf7, 0xc4, 0xc8,
+	0xd1, 0xfd, 0x05, 0xc0, 0xf3, 0xcf, 0x3f, 0xb0, 0xef, 0x53, 0xd5, 0x5b, 0x21, 0x31, 0x97, 0x54,
+	0x8d, 0xe9, 0xec, 0x9e, 0xce, 0x9d, 0x5d, 0x3d, 0x65, 0x47, 0xa8, 0x02, 0x27, 0x03, 0x03, 0x9c,
+	0xf6, 0xc9, 0x27, 0xdc, 0xe1, 0x70, 0xe9, 0xc2, 0x90, 0xfb, 0xc1, 0x87, 0xb0, 0xdd, 0xf9, 0x6e,
+	0xbb, 0x06, 0x1e, 0x6f, 0xd7, 0xc0, 0x93, 0xed, 0x1a, 0xf8, 0x63, 0xbb, 0x06, 0xbe, 0xd8, 0xa9,
+	0x15, 0x9e, 0xec, 0xd4, 0x0a, 0xbf, 0xee, 0xd4, 0x0a, 0x1f, 0xae, 0xe4, 0x64, 0xa3, 0x77, 0xc3,
+	0x44, 0xbf, 0x80, 0x94, 0xf9, 0x2d, 0x73, 0x3f, 0x53, 0xb5, 0xd9, 0xb0, 0x77, 0x74, 0xc3, 0x6c,
+	0x74, 0x6b, 0x63, 0xcf, 0x57, 0x9e, 0x11, 0xb6, 0x53, 0x4a, 0xbf, 0xbb, 0xae, 0xfd, 0x35, 0x00,
+	0x32, 0x3c, 0xda, 0x80, 0x17, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -763,6 +835,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.TokenizeShareRewardPayoutInterval != that1.TokenizeShareRewardPayoutInterval {
+		return false
+	}
+	if this.TokenizeShareRewardPayoutBatchSize != that1.TokenizeShareRewardPayoutBatchSize {
+		return false
+	}
+	if this.TokenizeShareRewardPayoutGasLimit != that1.TokenizeShareRewardPayoutGasLimit {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -795,6 +876,14 @@ func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
 	if this.ReferenceCount != that1.ReferenceCount {
 		return false
 	}
+	if len(this.CumulativeLiquidRewardRatio) != len(that1.CumulativeLiquidRewardRatio) {
+		return false
+	}
+	for i := range this.CumulativeLiquidRewardRatio {
+		if !this.CumulativeLiquidRewardRatio[i].Equal(&that1.CumulativeLiquidRewardRatio[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
@@ -827,6 +916,14 @@ func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
 	if this.Period != that1.Period {
 		return false
 	}
+	if len(this.LiquidRewards) != len(that1.LiquidRewards) {
+		return false
+	}
+	for i := range this.LiquidRewards {
+		if !this.LiquidRewards[i].Equal(&that1.LiquidRewards[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
@@ -1032,6 +1129,12 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
 	return true
 }
 func (this *TokenizeShareRecordReward) Equal(that interface{}) bool {
@@ -1122,6 +1225,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TokenizeShareRewardPayoutGasLimit != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.TokenizeShareRewardPayoutGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TokenizeShareRewardPayoutBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.TokenizeShareRewardPayoutBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TokenizeShareRewardPayoutInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.TokenizeShareRewardPayoutInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1185,6 +1303,20 @@ func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.CumulativeLiquidRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeLiquidRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeLiquidRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ReferenceCount != 0 {
 		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
 		i--
@@ -1227,6 +1359,20 @@ func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.LiquidRewards) > 0 {
+		for iNdEx := len(m.LiquidRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Period != 0 {
 		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
 		i--
@@ -1556,6 +1702,18 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Reward) > 0 {
 		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1706,6 +1864,15 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.TokenizeShareRewardPayoutInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.TokenizeShareRewardPayoutInterval))
+	}
+	if m.TokenizeShareRewardPayoutBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.TokenizeShareRewardPayoutBatchSize))
+	}
+	if m.TokenizeShareRewardPayoutGasLimit != 0 {
+		n += 1 + sovDistribution(uint64(m.TokenizeShareRewardPayoutGasLimit))
+	}
 	return n
 }
 
@@ -1724,6 +1891,12 @@ func (m *ValidatorHistoricalRewards) Size() (n int) {
 	if m.ReferenceCount != 0 {
 		n += 1 + sovDistribution(uint64(m.ReferenceCount))
 	}
+	if len(m.CumulativeLiquidRewardRatio) > 0 {
+		for _, e := range m.CumulativeLiquidRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1742,6 +1915,12 @@ func (m *ValidatorCurrentRewards) Size() (n int) {
 	if m.Period != 0 {
 		n += 1 + sovDistribution(uint64(m.Period))
 	}
+	if len(m.LiquidRewards) > 0 {
+		for _, e := range m.LiquidRewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1879,6 +2058,13 @@ func (m *DelegationDelegatorReward) Size() (n int) {
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
+	if m.RecordId != 0 {
+		n += 1 + sovDistribution(uint64(m.RecordId))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
 	return n
 }
 
@@ -2086,6 +2272,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRewardPayoutInterval", wireType)
+			}
+			m.TokenizeShareRewardPayoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenizeShareRewardPayoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRewardPayoutBatchSize", wireType)
+			}
+			m.TokenizeShareRewardPayoutBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenizeShareRewardPayoutBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRewardPayoutGasLimit", wireType)
+			}
+			m.TokenizeShareRewardPayoutGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenizeShareRewardPayoutGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2189,6 +2432,40 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeLiquidRewardRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeLiquidRewardRatio = append(m.CumulativeLiquidRewardRatio, types.DecCoin{})
+			if err := m.CumulativeLiquidRewardRatio[len(m.CumulativeLiquidRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2292,6 +2569,40 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidRewards = append(m.LiquidRewards, types.DecCoin{})
+			if err := m.LiquidRewards[len(m.LiquidRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3149,6 +3460,57 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
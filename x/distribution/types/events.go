@@ -12,6 +12,8 @@ const (
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyRecordId        = "record_id"
+	AttributeKeyError           = "error"
 
 	AttributeValueCategory = ModuleName
 )
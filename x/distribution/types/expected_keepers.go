@@ -1,9 +1,13 @@
 package types
 
 import (
+	"context"
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
@@ -72,7 +76,21 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
 
 	GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (tokenizeShareRecords []stakingtypes.TokenizeShareRecord)
+	GetTokenizeShareRecordsByOwnerFrom(ctx sdk.Context, owner sdk.AccAddress, startId uint64, limit uint64) (tokenizeShareRecords []stakingtypes.TokenizeShareRecord, nextId uint64)
+	GetTokenizeShareRecordsFrom(ctx sdk.Context, startId uint64, limit uint64) (tokenizeShareRecords []stakingtypes.TokenizeShareRecord, nextId uint64)
 	GetTokenizeShareRecord(ctx sdk.Context, id uint64) (tokenizeShareRecord stakingtypes.TokenizeShareRecord, err error)
+	GetTokenizeShareRecordByModuleAccount(ctx sdk.Context, moduleAccount sdk.AccAddress) (stakingtypes.TokenizeShareRecord, error)
+	GetTokenizeShareRecordOwner(ctx sdk.Context, recordId uint64) (sdk.AccAddress, error)
+	GetLiquidValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetValidatorTokenizedShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec
+}
+
+// AuthzKeeper defines the expected authz keeper used to charge the rewards withdrawn
+// through a TokenizeShareRecordAuthorization to its spend limit
+type AuthzKeeper interface {
+	GranterGrants(c context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error)
+	SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
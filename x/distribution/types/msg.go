@@ -16,13 +16,19 @@ const (
 	TypeMsgFundCommunityPool                    = "fund_community_pool"
 	TypeMsgWithdrawTokenizeShareRecordReward    = "withdraw_tokenize_share_record_reward"
 	TypeMsgWithdrawAllTokenizeShareRecordReward = "withdraw_all_tokenize_share_record_reward"
+	TypeMsgUpdateParams                         = "update_params"
 )
 
+// MaxTokenizeShareRecordRewardWithdrawals is the maximum number of records processed
+// by a single MsgWithdrawAllTokenizeShareRecordReward
+const MaxTokenizeShareRecordRewardWithdrawals = 100
+
 // Verify interface at compile time
 var (
 	_, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
 	_       sdk.Msg = &MsgWithdrawTokenizeShareRecordReward{}
 	_       sdk.Msg = &MsgWithdrawAllTokenizeShareRecordReward{}
+	_       sdk.Msg = &MsgUpdateParams{}
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -205,9 +211,12 @@ func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
 	return nil
 }
 
-func NewMsgWithdrawAllTokenizeShareRecordReward(ownerAddr sdk.AccAddress) *MsgWithdrawAllTokenizeShareRecordReward {
+func NewMsgWithdrawAllTokenizeShareRecordReward(ownerAddr sdk.AccAddress, recordIds []uint64, startRecordId, limit uint64) *MsgWithdrawAllTokenizeShareRecordReward {
 	return &MsgWithdrawAllTokenizeShareRecordReward{
-		OwnerAddress: ownerAddr.String(),
+		OwnerAddress:  ownerAddr.String(),
+		RecordIds:     recordIds,
+		StartRecordId: startRecordId,
+		Limit:         limit,
 	}
 }
 
@@ -236,5 +245,54 @@ func (msg MsgWithdrawAllTokenizeShareRecordReward) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
 	}
+	if len(msg.RecordIds) > 0 && (msg.StartRecordId != 0 || msg.Limit != 0) {
+		return sdkerrors.ErrInvalidRequest.Wrap("record ids cannot be combined with a start record id or limit")
+	}
+	if len(msg.RecordIds) > MaxTokenizeShareRecordRewardWithdrawals {
+		return sdkerrors.ErrInvalidRequest.Wrapf("too many record ids: %d > %d", len(msg.RecordIds), MaxTokenizeShareRecordRewardWithdrawals)
+	}
+	if msg.Limit > MaxTokenizeShareRecordRewardWithdrawals {
+		return sdkerrors.ErrInvalidRequest.Wrapf("limit too large: %d > %d", msg.Limit, MaxTokenizeShareRecordRewardWithdrawals)
+	}
+	seen := make(map[uint64]bool, len(msg.RecordIds))
+	for _, id := range msg.RecordIds {
+		if seen[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate record id: %d", id)
+		}
+		seen[id] = true
+	}
 	return nil
 }
+
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.ValidateBasic()
+}
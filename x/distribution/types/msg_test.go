@@ -93,3 +93,37 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgWithdrawAllTokenizeShareRecordReward
+func TestMsgWithdrawAllTokenizeShareRecordReward(t *testing.T) {
+	tooManyIds := make([]uint64, MaxTokenizeShareRecordRewardWithdrawals+1)
+	for i := range tooManyIds {
+		tooManyIds[i] = uint64(i + 1)
+	}
+
+	tests := []struct {
+		ownerAddr     sdk.AccAddress
+		recordIds     []uint64
+		startRecordId uint64
+		limit         uint64
+		expectPass    bool
+	}{
+		{delAddr1, nil, 0, 0, true},
+		{delAddr1, nil, 10, 20, true},
+		{delAddr1, []uint64{1, 2, 3}, 0, 0, true},
+		{emptyDelAddr, nil, 0, 0, false},
+		{delAddr1, []uint64{1, 2}, 1, 0, false},
+		{delAddr1, []uint64{1, 2}, 0, 5, false},
+		{delAddr1, []uint64{1, 1}, 0, 0, false},
+		{delAddr1, tooManyIds, 0, 0, false},
+		{delAddr1, nil, 0, MaxTokenizeShareRecordRewardWithdrawals + 1, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllTokenizeShareRecordReward(tc.ownerAddr, tc.recordIds, tc.startRecordId, tc.limit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 
 	"sigs.k8s.io/yaml"
 
@@ -15,6 +16,24 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyTokenizeShareRewardPayoutInterval  = []byte("tokenizesharerewardpayoutinterval")
+	ParamStoreKeyTokenizeShareRewardPayoutBatchSize = []byte("tokenizesharerewardpayoutbatchsize")
+	ParamStoreKeyTokenizeShareRewardPayoutGasLimit  = []byte("tokenizesharerewardpayoutgaslimit")
+)
+
+// Default parameter values of the automatic payout of tokenize share record rewards
+const (
+	DefaultTokenizeShareRewardPayoutInterval  uint64 = 0
+	DefaultTokenizeShareRewardPayoutBatchSize uint64 = 20
+	DefaultTokenizeShareRewardPayoutGasLimit  uint64 = 10_000_000
+)
+
+// Bounds of the parameter values of the automatic payout of tokenize share record rewards
+const (
+	MaxTokenizeShareRewardPayoutInterval  uint64 = math.MaxInt64
+	MaxTokenizeShareRewardPayoutBatchSize uint64 = 1_000
+	MaxTokenizeShareRewardPayoutGasLimit  uint64 = 100_000_000
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,6 +48,10 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+
+		TokenizeShareRewardPayoutInterval:  DefaultTokenizeShareRewardPayoutInterval,
+		TokenizeShareRewardPayoutBatchSize: DefaultTokenizeShareRewardPayoutBatchSize,
+		TokenizeShareRewardPayoutGasLimit:  DefaultTokenizeShareRewardPayoutGasLimit,
 	}
 }
 
@@ -44,6 +67,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyTokenizeShareRewardPayoutInterval, &p.TokenizeShareRewardPayoutInterval, validateTokenizeShareRewardPayoutInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyTokenizeShareRewardPayoutBatchSize, &p.TokenizeShareRewardPayoutBatchSize, validateTokenizeShareRewardPayoutBatchSize),
+		paramtypes.NewParamSetPair(ParamStoreKeyTokenizeShareRewardPayoutGasLimit, &p.TokenizeShareRewardPayoutGasLimit, validateTokenizeShareRewardPayoutGasLimit),
 	}
 }
 
@@ -69,6 +95,25 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if err := validateTokenizeShareRewardPayoutInterval(p.TokenizeShareRewardPayoutInterval); err != nil {
+		return err
+	}
+	if err := validateTokenizeShareRewardPayoutBatchSize(p.TokenizeShareRewardPayoutBatchSize); err != nil {
+		return err
+	}
+	if err := validateTokenizeShareRewardPayoutGasLimit(p.TokenizeShareRewardPayoutGasLimit); err != nil {
+		return err
+	}
+	if p.TokenizeShareRewardPayoutInterval > 0 && p.TokenizeShareRewardPayoutBatchSize == 0 {
+		return fmt.Errorf(
+			"tokenize share reward payout batch size must be positive when payouts are enabled",
+		)
+	}
+	if p.TokenizeShareRewardPayoutInterval > 0 && p.TokenizeShareRewardPayoutGasLimit == 0 {
+		return fmt.Errorf(
+			"tokenize share reward payout gas limit must be positive when payouts are enabled",
+		)
+	}
 
 	return nil
 }
@@ -138,3 +183,42 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateTokenizeShareRewardPayoutInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxTokenizeShareRewardPayoutInterval {
+		return fmt.Errorf("tokenize share reward payout interval too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateTokenizeShareRewardPayoutBatchSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxTokenizeShareRewardPayoutBatchSize {
+		return fmt.Errorf("tokenize share reward payout batch size too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateTokenizeShareRewardPayoutGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxTokenizeShareRewardPayoutGasLimit {
+		return fmt.Errorf("tokenize share reward payout gas limit too large: %d", v)
+	}
+
+	return nil
+}
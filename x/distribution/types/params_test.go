@@ -17,17 +17,27 @@ func TestParams_ValidateBasic(t *testing.T) {
 		BaseProposerReward  sdk.Dec
 		BonusProposerReward sdk.Dec
 		WithdrawAddrEnabled bool
+
+		TokenizeShareRewardPayoutInterval  uint64
+		TokenizeShareRewardPayoutBatchSize uint64
+		TokenizeShareRewardPayoutGasLimit  uint64
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false}, true},
-		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false}, true},
-		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false}, true},
-		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 0, 0, 0}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false, 0, 0, 0}, true},
+		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false, 0, 0, 0}, true},
+		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false, 0, 0, 0}, true},
+		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, 0, 0, 0}, true},
+		{"payout enabled", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 10, 5, 1000}, false},
+		{"payout enabled without batch size", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 10, 0, 1000}, true},
+		{"payout enabled without gas limit", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 10, 5, 0}, true},
+		{"payout interval too large", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, types.MaxTokenizeShareRewardPayoutInterval + 1, 5, 1000}, true},
+		{"payout batch size too large", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 10, types.MaxTokenizeShareRewardPayoutBatchSize + 1, 1000}, true},
+		{"payout gas limit too large", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 10, 5, types.MaxTokenizeShareRewardPayoutGasLimit + 1}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -36,6 +46,10 @@ func TestParams_ValidateBasic(t *testing.T) {
 				BaseProposerReward:  tt.fields.BaseProposerReward,
 				BonusProposerReward: tt.fields.BonusProposerReward,
 				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+
+				TokenizeShareRewardPayoutInterval:  tt.fields.TokenizeShareRewardPayoutInterval,
+				TokenizeShareRewardPayoutBatchSize: tt.fields.TokenizeShareRewardPayoutBatchSize,
+				TokenizeShareRewardPayoutGasLimit:  tt.fields.TokenizeShareRewardPayoutGasLimit,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -28,6 +28,11 @@ func (res QueryDelegatorTotalRewardsResponse) String() string {
 		out += fmt.Sprintf(`  
 	ValidatorAddress: %s
 	Reward: %s`, reward.ValidatorAddress, reward.Reward)
+		if reward.ModuleAddress != "" {
+			out += fmt.Sprintf(`
+	RecordId: %d
+	ModuleAddress: %s`, reward.RecordId, reward.ModuleAddress)
+		}
 	}
 	out += fmt.Sprintf("\n  Total: %s\n", res.Total)
 	return strings.TrimSpace(out)
@@ -41,3 +46,18 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 ) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr.String(), Reward: reward}
 }
+
+// NewTokenizeShareRecordDelegatorReward constructs a DelegationDelegatorReward for
+// the delegation held by a tokenize share record module account.
+//
+//nolint:interfacer
+func NewTokenizeShareRecordDelegatorReward(valAddr sdk.ValAddress, recordId uint64,
+	moduleAddr sdk.AccAddress, reward sdk.DecCoins,
+) DelegationDelegatorReward {
+	return DelegationDelegatorReward{
+		ValidatorAddress: valAddr.String(),
+		Reward:           reward,
+		RecordId:         recordId,
+		ModuleAddress:    moduleAddr.String(),
+	}
+}
@@ -415,6 +415,13 @@ func (m *QueryValidatorSlashesResponse) GetPagination() *query.PageResponse {
 type QueryDelegationTotalRewardsRequest struct {
 	// delegator_address defines the delegator address to query for.
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// include_tokenized also reports the rewards of the tokenize share records
+	// owned by the delegator, at most MaxTokenizeShareRecordRewardWithdrawals
+	// records per query.
+	IncludeTokenized bool `protobuf:"varint,2,opt,name=include_tokenized,json=includeTokenized,proto3" json:"include_tokenized,omitempty"`
+	// tokenized_start_record_id is the first record id to report, as returned in
+	// next_tokenized_record_id of the previous query
+	TokenizedStartRecordId uint64 `protobuf:"varint,3,opt,name=tokenized_start_record_id,json=tokenizedStartRecordId,proto3" json:"tokenized_start_record_id,omitempty"`
 }
 
 func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegationTotalRewardsRequest{} }
@@ -457,6 +464,9 @@ type QueryDelegationTotalRewardsResponse struct {
 	Rewards []DelegationDelegatorReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
 	// total defines the sum of all the rewards.
 	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
+	// next_tokenized_record_id is the id of the next owned record to report, zero
+	// once all owned records are reported
+	NextTokenizedRecordId uint64 `protobuf:"varint,3,opt,name=next_tokenized_record_id,json=nextTokenizedRecordId,proto3" json:"next_tokenized_record_id,omitempty"`
 }
 
 func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelegationTotalRewardsResponse{} }
@@ -506,6 +516,13 @@ func (m *QueryDelegationTotalRewardsResponse) GetTotal() github_com_cosmos_cosmo
 	return nil
 }
 
+func (m *QueryDelegationTotalRewardsResponse) GetNextTokenizedRecordId() uint64 {
+	if m != nil {
+		return m.NextTokenizedRecordId
+	}
+	return 0
+}
+
 // QueryDelegatorValidatorsRequest is the request type for the
 // Query/DelegatorValidators RPC method.
 type QueryDelegatorValidatorsRequest struct {
@@ -868,85 +885,89 @@ func init() {
 func init() { proto.RegisterFile("distribution/v1beta1/query.proto", fileDescriptor_bee02899ef89b167) }
 
 var fileDescriptor_bee02899ef89b167 = []byte{
-	// 1248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xba, 0x69, 0x4a, 0xa7, 0x0d, 0x4d, 0xa7, 0x11, 0x72, 0xb6, 0xc1, 0x8e, 0x36, 0xb4,
-	0x89, 0x1a, 0xc5, 0xab, 0x26, 0x12, 0x95, 0x82, 0x02, 0xe4, 0xb3, 0xa1, 0x8d, 0xfa, 0xe1, 0x44,
-	0x44, 0x20, 0x81, 0x35, 0xf1, 0x8e, 0xd6, 0xa3, 0xac, 0x77, 0x9c, 0x9d, 0xd9, 0x98, 0x10, 0xe5,
-	0x02, 0x2a, 0x42, 0x82, 0x03, 0x88, 0x0b, 0xc7, 0x9c, 0x38, 0x70, 0xe6, 0x1f, 0x40, 0x5c, 0x7a,
-	0x2c, 0x70, 0xe1, 0x54, 0x50, 0xd2, 0x03, 0x97, 0x4a, 0x15, 0x07, 0xce, 0x68, 0x67, 0x66, 0x6d,
-	0x6f, 0xfc, 0x5d, 0x3b, 0xe2, 0x14, 0xef, 0x9b, 0x79, 0xbf, 0xf7, 0x7e, 0xef, 0xcd, 0x9b, 0xf9,
-	0x29, 0x60, 0xd4, 0x22, 0x8c, 0x7b, 0x64, 0xcb, 0xe7, 0x84, 0xba, 0xe6, 0xee, 0xcd, 0x2d, 0xcc,
-	0xd1, 0x4d, 0x73, 0xc7, 0xc7, 0xde, 0x5e, 0xba, 0xe8, 0x51, 0x4e, 0xa1, 0xe1, 0x90, 0x1d, 0x9f,
-	0x58, 0x8c, 0xa3, 0x6d, 0xe2, 0xda, 0xe9, 0xea, 0xfd, 0x69, 0xb5, 0x5f, 0xbf, 0x91, 0xa3, 0xac,
-	0x40, 0x99, 0xb9, 0x85, 0x18, 0x96, 0xce, 0x65, 0xa8, 0x22, 0xb2, 0x89, 0x8b, 0xc4, 0x6e, 0x81,
-	0xa7, 0x0f, 0xd9, 0xd4, 0xa6, 0xe2, 0xa7, 0x19, 0xfc, 0x52, 0xd6, 0x11, 0x9b, 0x52, 0xdb, 0xc1,
-	0x26, 0x2a, 0x12, 0x13, 0xb9, 0x2e, 0xe5, 0xc2, 0x85, 0xa9, 0xd5, 0x64, 0x35, 0x7e, 0x88, 0x9c,
-	0xa3, 0x24, 0xc4, 0x1c, 0xaf, 0xcb, 0x22, 0x92, 0xaa, 0xda, 0xa8, 0x80, 0x5a, 0xb1, 0xd6, 0x87,
-	0xe5, 0xc6, 0xac, 0x4c, 0x54, 0x7e, 0xc8, 0x25, 0x63, 0x08, 0xc0, 0x87, 0xc1, 0xce, 0x07, 0xc8,
-	0x43, 0x05, 0x96, 0xc1, 0x3b, 0x3e, 0x66, 0xdc, 0xc8, 0x82, 0x2b, 0x11, 0x2b, 0x2b, 0x52, 0x97,
-	0x61, 0xb8, 0x0a, 0xfa, 0x8b, 0xc2, 0x92, 0xd0, 0x46, 0xb5, 0x89, 0x0b, 0xd3, 0x37, 0xd2, 0xad,
-	0xcb, 0x99, 0x96, 0x18, 0x0b, 0x7d, 0x8f, 0x9f, 0xa6, 0x62, 0x19, 0xe5, 0x6f, 0x14, 0xc1, 0xb8,
-	0x08, 0xf0, 0x3e, 0x72, 0x88, 0x85, 0x38, 0xf5, 0xee, 0xfb, 0x9c, 0x71, 0xe4, 0x5a, 0xc4, 0xb5,
-	0x33, 0xb8, 0x84, 0x3c, 0x2b, 0xcc, 0x05, 0x2e, 0x83, 0xcb, 0xbb, 0xe1, 0xae, 0x2c, 0xb2, 0x2c,
-	0x0f, 0x33, 0x19, 0xff, 0xfc, 0x42, 0xe2, 0xb7, 0x9f, 0xa6, 0x86, 0x14, 0x9d, 0x79, 0xb9, 0xb2,
-	0xce, 0xbd, 0x00, 0x62, 0xb0, 0xec, 0xa2, 0xec, 0xc6, 0x57, 0x1a, 0x98, 0x68, 0x1d, 0x52, 0x11,
-	0xcd, 0x82, 0x73, 0x9e, 0x34, 0x29, 0xa6, 0xef, 0xb4, 0xc3, 0xb4, 0x09, 0xb2, 0xa2, 0x1f, 0xa2,
-	0x1a, 0x79, 0x90, 0x8a, 0x26, 0xb3, 0x48, 0x0b, 0x05, 0xc2, 0x18, 0xa1, 0x6e, 0x8f, 0x79, 0x7f,
-	0xad, 0x81, 0xd1, 0xc6, 0xa1, 0x14, 0xdf, 0x3c, 0x00, 0xb9, 0xb2, 0x55, 0x51, 0x5e, 0xe8, 0x88,
-	0xf2, 0x7c, 0x2e, 0xe7, 0x17, 0x7c, 0x07, 0x71, 0x6c, 0x55, 0xf0, 0x15, 0xeb, 0x2a, 0x6c, 0xe3,
-	0x51, 0x1c, 0x8c, 0x44, 0xd3, 0x59, 0x77, 0x10, 0xcb, 0xe3, 0x1e, 0xb7, 0x1b, 0x8e, 0x83, 0x4b,
-	0x8c, 0x23, 0x8f, 0x13, 0xd7, 0xce, 0xe6, 0x31, 0xb1, 0xf3, 0x3c, 0x11, 0x1f, 0xd5, 0x26, 0xfa,
-	0x32, 0xaf, 0x86, 0xe6, 0x55, 0x61, 0x85, 0x63, 0x60, 0x00, 0x8b, 0x4e, 0x85, 0xdb, 0xce, 0x88,
-	0x6d, 0x17, 0xa5, 0x51, 0x6d, 0x5a, 0x01, 0xa0, 0x32, 0xfa, 0x89, 0x3e, 0x51, 0x9f, 0xeb, 0x69,
-	0x95, 0x4a, 0x30, 0xc7, 0x69, 0x39, 0x6e, 0x95, 0x33, 0x6f, 0x63, 0x45, 0x28, 0x53, 0xe5, 0x39,
-	0xfb, 0xca, 0x97, 0x87, 0xa9, 0xd8, 0xf7, 0x87, 0x29, 0xcd, 0xf8, 0x59, 0x03, 0xaf, 0x37, 0xa8,
-	0x83, 0xea, 0xc9, 0x26, 0x38, 0xc7, 0xa4, 0x29, 0xa1, 0x8d, 0x9e, 0x99, 0xb8, 0x30, 0x7d, 0xab,
-	0xa3, 0x86, 0x08, 0xb8, 0xe5, 0x5d, 0xec, 0xf2, 0xf0, 0xec, 0x29, 0x34, 0x78, 0x3b, 0x42, 0x26,
-	0x2e, 0xc8, 0x8c, 0xb7, 0x24, 0x23, 0xb3, 0xaa, 0x66, 0x63, 0xf8, 0xc0, 0x10, 0x14, 0x96, 0xb0,
-	0x83, 0x6d, 0x61, 0xda, 0xa0, 0x1c, 0x39, 0xb5, 0xf3, 0x6b, 0xc9, 0x0d, 0x9d, 0x34, 0xb4, 0xec,
-	0xa2, 0xec, 0xb2, 0x74, 0x7f, 0x1f, 0xa6, 0x62, 0xc6, 0x73, 0x0d, 0x8c, 0x35, 0x8d, 0xab, 0x0a,
-	0xf8, 0x51, 0xf5, 0x10, 0x07, 0x05, 0x9c, 0x6b, 0xa7, 0x80, 0x15, 0xd0, 0xa5, 0x30, 0x05, 0x09,
-	0x7c, 0x62, 0x84, 0xa1, 0x0d, 0xce, 0xf2, 0x20, 0x6c, 0x22, 0x2e, 0xc0, 0x47, 0x22, 0x15, 0xac,
-	0xa0, 0xe5, 0x16, 0x29, 0x71, 0x17, 0x66, 0x02, 0xdf, 0x1f, 0xff, 0x4c, 0x4d, 0xda, 0x84, 0xe7,
-	0xfd, 0xad, 0x74, 0x8e, 0x16, 0xd4, 0x3d, 0xac, 0xfe, 0x4c, 0x31, 0x6b, 0xdb, 0xe4, 0x7b, 0x45,
-	0xcc, 0x42, 0x1f, 0x96, 0x91, 0xf8, 0x86, 0xa7, 0xee, 0x8a, 0x72, 0x3e, 0xe5, 0x1e, 0x9f, 0x5e,
-	0x8d, 0xd7, 0xd4, 0xa5, 0x51, 0x37, 0xa6, 0xaa, 0x6f, 0x12, 0x80, 0xf2, 0xd8, 0xc9, 0x12, 0x9f,
-	0xcf, 0x54, 0x59, 0xaa, 0xd0, 0x4a, 0xe0, 0x8d, 0x28, 0xda, 0x26, 0xe1, 0x79, 0xcb, 0x43, 0x25,
-	0x15, 0xf8, 0xd4, 0x68, 0xec, 0x82, 0x6b, 0x2d, 0x02, 0x2b, 0x2e, 0x8b, 0x60, 0xb0, 0xa4, 0x96,
-	0xda, 0x0e, 0x7c, 0xa9, 0x14, 0x05, 0xab, 0x8a, 0x7b, 0x15, 0x0c, 0x8b, 0xb8, 0xc1, 0x55, 0xe8,
-	0xbb, 0x84, 0xef, 0x3d, 0xa0, 0xd4, 0x09, 0x1f, 0xd7, 0xcf, 0x35, 0xa0, 0xd7, 0x5b, 0x55, 0xa9,
-	0x60, 0xd0, 0x57, 0xa4, 0xd4, 0x51, 0x67, 0xf6, 0x14, 0x8e, 0x95, 0x80, 0x37, 0x8a, 0xaa, 0x34,
-	0x1b, 0x74, 0x1b, 0xbb, 0xe4, 0x53, 0xbc, 0x9e, 0x47, 0x1e, 0xce, 0xe0, 0x1c, 0xf5, 0x2c, 0x79,
-	0xde, 0xc3, 0xa6, 0xcc, 0x81, 0x01, 0x5a, 0x72, 0x71, 0x4d, 0x43, 0xfe, 0x79, 0x9a, 0x1a, 0xda,
-	0x43, 0x05, 0x67, 0xd6, 0x88, 0x2c, 0x1b, 0x99, 0x8b, 0xe2, 0xbb, 0xb6, 0x28, 0x2f, 0x34, 0x70,
-	0xbd, 0x55, 0xc8, 0xae, 0x46, 0xb7, 0x21, 0xee, 0xff, 0x35, 0xba, 0xd3, 0xbf, 0x42, 0x70, 0x56,
-	0x50, 0x86, 0x3f, 0x68, 0xa0, 0x5f, 0x2a, 0x21, 0xf8, 0x66, 0x3b, 0x5c, 0x6a, 0x45, 0x99, 0x7e,
-	0xab, 0x63, 0x3f, 0x59, 0x4d, 0x63, 0xf2, 0xb3, 0xdf, 0x9f, 0x7d, 0x17, 0xbf, 0x06, 0xc7, 0xcc,
-	0x66, 0x82, 0x51, 0x2a, 0x33, 0xf8, 0x6d, 0x1c, 0x5c, 0x6d, 0x22, 0x64, 0xe0, 0xdd, 0xb6, 0xb3,
-	0x68, 0xad, 0xed, 0xf4, 0xb5, 0xde, 0x80, 0x29, 0x9e, 0x9b, 0x82, 0xe7, 0x43, 0x78, 0xbf, 0x29,
-	0xcf, 0xca, 0x0d, 0x65, 0xee, 0xd7, 0x28, 0x8d, 0x03, 0x93, 0x56, 0xf0, 0xb3, 0xe1, 0x79, 0x79,
-	0xa1, 0x81, 0x2b, 0x75, 0xe4, 0x13, 0x5c, 0xec, 0x3c, 0xfd, 0x1a, 0x9d, 0xa7, 0x2f, 0x75, 0x07,
-	0xa2, 0xb8, 0xdf, 0x13, 0xdc, 0x57, 0xe1, 0x4a, 0x37, 0xdc, 0x2b, 0x3a, 0x0d, 0x3e, 0xd3, 0xc0,
-	0xe0, 0x49, 0x69, 0x02, 0xdf, 0xed, 0x3c, 0xd5, 0xa8, 0xba, 0xd3, 0xe7, 0xbb, 0x40, 0x50, 0x4c,
-	0xef, 0x0a, 0xa6, 0xcb, 0x70, 0xb1, 0x1b, 0xa6, 0xa1, 0x16, 0x7a, 0xae, 0x81, 0xcb, 0x95, 0x17,
-	0x3f, 0x3c, 0xe3, 0xb3, 0xe1, 0x85, 0xd0, 0x38, 0xbd, 0x1a, 0xa7, 0x90, 0xe1, 0x5b, 0x2f, 0xe5,
-	0xab, 0xb8, 0x65, 0x05, 0xb7, 0x0f, 0xe0, 0x66, 0x53, 0x6e, 0xe5, 0x07, 0x8f, 0x99, 0xfb, 0x35,
-	0xef, 0xe5, 0x81, 0xa9, 0x4e, 0x6d, 0x3d, 0xde, 0xf0, 0x5f, 0x0d, 0xbc, 0x56, 0x5f, 0x36, 0xc1,
-	0x95, 0xb6, 0x5b, 0xd3, 0x54, 0xef, 0xe9, 0xb7, 0xbb, 0xc6, 0xe9, 0xa8, 0xd1, 0xed, 0x15, 0x43,
-	0x8c, 0x70, 0x1d, 0x31, 0xd3, 0xc1, 0x08, 0x37, 0x96, 0x5f, 0x1d, 0x8c, 0x70, 0x13, 0x3d, 0xd5,
-	0xe6, 0x08, 0xb7, 0xe0, 0x5b, 0x39, 0xf7, 0xf0, 0x51, 0x1c, 0x24, 0x1a, 0x09, 0x1f, 0xb8, 0xda,
-	0x79, 0xca, 0xf5, 0x45, 0x9b, 0xfe, 0x5e, 0x0f, 0x90, 0x54, 0x05, 0x36, 0x44, 0x05, 0xee, 0xc1,
-	0xb5, 0x6e, 0x2a, 0x70, 0x52, 0xc7, 0xc1, 0x5f, 0x34, 0x30, 0x10, 0x91, 0x5a, 0x70, 0xae, 0xed,
-	0x94, 0xeb, 0x09, 0x38, 0xfd, 0xed, 0x97, 0x75, 0x57, 0x34, 0x67, 0x04, 0xcd, 0x29, 0x38, 0xd9,
-	0x94, 0x66, 0x2e, 0xf4, 0xcd, 0x06, 0x7a, 0x0d, 0x7e, 0x11, 0x07, 0xc3, 0x0d, 0x05, 0x0e, 0x6c,
-	0xbf, 0x09, 0xad, 0xf4, 0x9e, 0x7e, 0xa7, 0x17, 0x50, 0x8a, 0x69, 0x46, 0x30, 0x5d, 0x83, 0x77,
-	0x9a, 0x32, 0xdd, 0x8f, 0x08, 0xc8, 0x03, 0x93, 0x2b, 0xdc, 0x2c, 0x0b, 0x80, 0xb3, 0x9e, 0x40,
-	0x0e, 0x1f, 0xe3, 0x85, 0x8f, 0x1f, 0x1f, 0x25, 0xb5, 0x27, 0x47, 0x49, 0xed, 0xaf, 0xa3, 0xa4,
-	0xf6, 0xcd, 0x71, 0x32, 0xf6, 0xe4, 0x38, 0x19, 0xfb, 0xe3, 0x38, 0x19, 0xfb, 0x70, 0xa9, 0x4a,
-	0xa1, 0x91, 0x1d, 0xc7, 0x0f, 0x1e, 0x32, 0xe2, 0xe6, 0x4c, 0xc9, 0x87, 0xf0, 0xbd, 0x29, 0xc5,
-	0x69, 0xaa, 0x40, 0x2d, 0xdf, 0xc1, 0xe6, 0x27, 0xd1, 0x7c, 0x84, 0x86, 0xdb, 0xea, 0x17, 0xff,
-	0x18, 0x9b, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x77, 0xa6, 0xd8, 0x4d, 0x14, 0x00, 0x00,
+	// 1312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x1b, 0xd5,
+	0x17, 0xf6, 0xb8, 0xe9, 0xeb, 0xb6, 0xfd, 0x35, 0xbd, 0xcd, 0xaf, 0x72, 0xa6, 0xc5, 0xb6, 0xa6,
+	0xb4, 0x89, 0x1a, 0xc5, 0xa3, 0x36, 0x12, 0x11, 0x41, 0x01, 0xf2, 0x6c, 0xda, 0x46, 0x7d, 0x38,
+	0x11, 0x11, 0x48, 0x30, 0x9a, 0x78, 0xae, 0xc6, 0x57, 0x19, 0xcf, 0x75, 0x66, 0xee, 0xc4, 0x0d,
+	0x51, 0x36, 0xa0, 0x22, 0x24, 0x58, 0x80, 0xd8, 0xb0, 0xcc, 0xaa, 0x0b, 0xd6, 0xfc, 0x03, 0x88,
+	0x4d, 0x97, 0x05, 0x36, 0x6c, 0x28, 0x28, 0xe9, 0x82, 0x0d, 0x52, 0xc5, 0x82, 0x35, 0x9a, 0xfb,
+	0xb0, 0x67, 0xe2, 0x77, 0xed, 0x8a, 0x55, 0xec, 0x73, 0xcf, 0xf9, 0xce, 0xf9, 0xce, 0xb9, 0x8f,
+	0xcf, 0x01, 0x59, 0x0b, 0xfb, 0xd4, 0xc3, 0xeb, 0x01, 0xc5, 0xc4, 0xd5, 0xb7, 0xae, 0xaf, 0x23,
+	0x6a, 0x5e, 0xd7, 0x37, 0x03, 0xe4, 0x6d, 0xe7, 0xca, 0x1e, 0xa1, 0x04, 0x6a, 0x0e, 0xde, 0x0c,
+	0xb0, 0xe5, 0x53, 0x73, 0x03, 0xbb, 0x76, 0x2e, 0xea, 0x9f, 0x13, 0xfe, 0xea, 0xb5, 0x02, 0xf1,
+	0x4b, 0xc4, 0xd7, 0xd7, 0x4d, 0x1f, 0xf1, 0xe0, 0x2a, 0x54, 0xd9, 0xb4, 0xb1, 0x6b, 0x32, 0x6f,
+	0x86, 0xa7, 0x0e, 0xd9, 0xc4, 0x26, 0xec, 0xa3, 0x1e, 0x7e, 0x12, 0xd6, 0x4b, 0x36, 0x21, 0xb6,
+	0x83, 0x74, 0xb3, 0x8c, 0x75, 0xd3, 0x75, 0x09, 0x65, 0x21, 0xbe, 0x58, 0x4d, 0x47, 0xf1, 0x25,
+	0x72, 0x81, 0x60, 0x89, 0x39, 0xd2, 0x90, 0x45, 0xac, 0x54, 0xe1, 0x28, 0x80, 0xda, 0xb1, 0x56,
+	0x87, 0xb9, 0xa3, 0xc1, 0x0b, 0xe5, 0x5f, 0xf8, 0x92, 0x36, 0x04, 0xe0, 0x83, 0xd0, 0xf3, 0xbe,
+	0xe9, 0x99, 0x25, 0x3f, 0x8f, 0x36, 0x03, 0xe4, 0x53, 0xcd, 0x00, 0xe7, 0x63, 0x56, 0xbf, 0x4c,
+	0x5c, 0x1f, 0xc1, 0x25, 0x70, 0xac, 0xcc, 0x2c, 0x29, 0x25, 0xab, 0x8c, 0x9e, 0xba, 0x71, 0x2d,
+	0xd7, 0xbe, 0x9d, 0x39, 0x8e, 0x31, 0x3b, 0xf0, 0xe4, 0x59, 0x26, 0x91, 0x17, 0xf1, 0x5a, 0x19,
+	0x8c, 0xb0, 0x04, 0xef, 0x99, 0x0e, 0xb6, 0x4c, 0x4a, 0xbc, 0x7b, 0x01, 0xf5, 0xa9, 0xe9, 0x5a,
+	0xd8, 0xb5, 0xf3, 0xa8, 0x62, 0x7a, 0x96, 0xac, 0x05, 0x2e, 0x80, 0x73, 0x5b, 0xd2, 0xcb, 0x30,
+	0x2d, 0xcb, 0x43, 0x3e, 0xcf, 0x7f, 0x72, 0x36, 0xf5, 0xf3, 0xf7, 0xe3, 0x43, 0x82, 0xce, 0x0c,
+	0x5f, 0x59, 0xa1, 0x5e, 0x08, 0x31, 0x58, 0x0d, 0x11, 0x76, 0xed, 0x0b, 0x05, 0x8c, 0xb6, 0x4f,
+	0x29, 0x88, 0x1a, 0xe0, 0xb8, 0xc7, 0x4d, 0x82, 0xe9, 0x3b, 0x9d, 0x30, 0x6d, 0x81, 0x2c, 0xe8,
+	0x4b, 0x54, 0xad, 0x08, 0x32, 0xf1, 0x62, 0xe6, 0x48, 0xa9, 0x84, 0x7d, 0x1f, 0x13, 0xb7, 0xcf,
+	0xbc, 0xbf, 0x54, 0x40, 0xb6, 0x79, 0x2a, 0xc1, 0xb7, 0x08, 0x40, 0xa1, 0x6a, 0x15, 0x94, 0x67,
+	0xbb, 0xa2, 0x3c, 0x53, 0x28, 0x04, 0xa5, 0xc0, 0x31, 0x29, 0xb2, 0x6a, 0xf8, 0x82, 0x75, 0x04,
+	0x5b, 0x7b, 0x94, 0x04, 0x97, 0xe2, 0xe5, 0xac, 0x38, 0xa6, 0x5f, 0x44, 0x7d, 0x1e, 0x37, 0x1c,
+	0x01, 0x67, 0x7d, 0x6a, 0x7a, 0x14, 0xbb, 0xb6, 0x51, 0x44, 0xd8, 0x2e, 0xd2, 0x54, 0x32, 0xab,
+	0x8c, 0x0e, 0xe4, 0xff, 0x27, 0xcd, 0x4b, 0xcc, 0x0a, 0x2f, 0x83, 0x33, 0xc8, 0xb5, 0x22, 0x6e,
+	0x47, 0x98, 0xdb, 0x69, 0x6e, 0x14, 0x4e, 0x8b, 0x00, 0xd4, 0x8e, 0x7e, 0x6a, 0x80, 0xf5, 0xe7,
+	0x6a, 0x4e, 0x94, 0x12, 0x9e, 0xe3, 0x1c, 0x3f, 0x6e, 0xb5, 0x3d, 0x6f, 0x23, 0x41, 0x28, 0x1f,
+	0x89, 0x9c, 0x3a, 0xf1, 0xf9, 0x5e, 0x26, 0xf1, 0xed, 0x5e, 0x46, 0xd1, 0x7e, 0x50, 0xc0, 0x6b,
+	0x4d, 0xfa, 0x20, 0x66, 0xb2, 0x06, 0x8e, 0xfb, 0xdc, 0x94, 0x52, 0xb2, 0x47, 0x46, 0x4f, 0xdd,
+	0x98, 0xec, 0x6a, 0x20, 0x0c, 0x6e, 0x61, 0x0b, 0xb9, 0x54, 0xee, 0x3d, 0x81, 0x06, 0x6f, 0xc6,
+	0xc8, 0x24, 0x19, 0x99, 0x91, 0xb6, 0x64, 0x78, 0x55, 0x51, 0x36, 0xda, 0x6f, 0x0a, 0xd0, 0x18,
+	0x87, 0x79, 0xe4, 0x20, 0x9b, 0xd9, 0x56, 0x09, 0x35, 0x9d, 0xfa, 0x03, 0x6c, 0x71, 0x87, 0x6e,
+	0x26, 0x5a, 0x0d, 0x91, 0x13, 0x1d, 0x03, 0xe7, 0xb0, 0x5b, 0x70, 0x02, 0x0b, 0x19, 0x94, 0x6c,
+	0x20, 0x17, 0x7f, 0x8c, 0x2c, 0x56, 0xfd, 0x89, 0xfc, 0xa0, 0x58, 0x58, 0x95, 0x76, 0xf8, 0x26,
+	0x18, 0xae, 0x3a, 0x19, 0x6c, 0xe2, 0x86, 0x87, 0x0a, 0xc4, 0xb3, 0x0c, 0x6c, 0x89, 0x09, 0x5f,
+	0xa8, 0x3a, 0xac, 0x84, 0xeb, 0x79, 0xb6, 0x7c, 0xcb, 0xe2, 0x33, 0xfa, 0x73, 0x2f, 0x93, 0xd0,
+	0x1e, 0x27, 0xc1, 0xe5, 0x96, 0xfc, 0xc4, 0xa4, 0x3e, 0x8c, 0xde, 0x16, 0xe1, 0xa4, 0xa6, 0x3b,
+	0x99, 0x54, 0x0d, 0x74, 0x5e, 0x52, 0xe5, 0xc0, 0x87, 0xee, 0x0a, 0x68, 0x83, 0xa3, 0x34, 0x4c,
+	0x9b, 0x4a, 0x32, 0xf0, 0x4b, 0xb1, 0x51, 0xd5, 0xd0, 0x0a, 0x73, 0x04, 0xbb, 0xb3, 0x13, 0x61,
+	0xec, 0x77, 0xbf, 0x67, 0xc6, 0x6c, 0x4c, 0x8b, 0xc1, 0x7a, 0xae, 0x40, 0x4a, 0xe2, 0xc2, 0x17,
+	0x7f, 0xc6, 0x7d, 0x6b, 0x43, 0xa7, 0xdb, 0x65, 0xe4, 0xcb, 0x18, 0x3f, 0xcf, 0xf1, 0xe1, 0x24,
+	0x48, 0xb9, 0xe8, 0x21, 0xad, 0xb5, 0xb7, 0xae, 0x67, 0xff, 0x0f, 0xd7, 0xab, 0x5d, 0x96, 0x2d,
+	0xd3, 0x3c, 0x71, 0x9b, 0x55, 0x89, 0x54, 0x77, 0x61, 0x9f, 0x37, 0x41, 0x64, 0x38, 0xcb, 0x20,
+	0xdb, 0x3c, 0xa7, 0x18, 0x4c, 0x1a, 0x80, 0xea, 0xc5, 0xc0, 0x67, 0x73, 0x32, 0x1f, 0xb1, 0x44,
+	0xd0, 0x2a, 0xe0, 0xf5, 0x38, 0xda, 0x1a, 0xa6, 0x45, 0xcb, 0x33, 0x2b, 0x22, 0xf1, 0x2b, 0xa3,
+	0xb1, 0x05, 0xae, 0xb4, 0x49, 0x2c, 0xb8, 0xcc, 0x81, 0xc1, 0x8a, 0x58, 0xea, 0x38, 0xf1, 0xd9,
+	0x4a, 0x1c, 0x2c, 0x92, 0xf7, 0x22, 0x18, 0x66, 0x79, 0xc3, 0xcb, 0x3a, 0x70, 0x31, 0xdd, 0xbe,
+	0x4f, 0x88, 0x23, 0x9f, 0xff, 0x4f, 0x15, 0xa0, 0x36, 0x5a, 0x15, 0xa5, 0x20, 0x30, 0x50, 0x26,
+	0xc4, 0x49, 0x29, 0xaf, 0x6a, 0x3f, 0x32, 0x78, 0xad, 0x2c, 0x5a, 0x23, 0xf7, 0xdb, 0x4a, 0xd1,
+	0xf4, 0x10, 0xdf, 0x73, 0xfc, 0xa0, 0xc8, 0xa1, 0x4c, 0x83, 0x33, 0xa4, 0xe2, 0xa2, 0xba, 0x81,
+	0xfc, 0xfd, 0x2c, 0x33, 0xb4, 0x6d, 0x96, 0x9c, 0x29, 0x2d, 0xb6, 0xac, 0xe5, 0x4f, 0xb3, 0xef,
+	0xf5, 0x4d, 0x79, 0xa1, 0x80, 0xab, 0xed, 0x52, 0xf6, 0x74, 0xe6, 0x9b, 0xe2, 0xfe, 0x57, 0x67,
+	0xfe, 0xc6, 0x4f, 0x10, 0x1c, 0x65, 0x94, 0xe1, 0x63, 0x05, 0x1c, 0xe3, 0x5a, 0x0d, 0xbe, 0xd1,
+	0x09, 0x97, 0x7a, 0xd9, 0xa8, 0x4e, 0x76, 0x1d, 0xc7, 0xbb, 0xa9, 0x8d, 0x7d, 0xf2, 0xcb, 0xf3,
+	0x6f, 0x92, 0x57, 0xe0, 0x65, 0xbd, 0x95, 0xa4, 0xe5, 0xda, 0x11, 0x7e, 0x9d, 0x04, 0x17, 0x5b,
+	0x48, 0x2d, 0x78, 0xa7, 0xe3, 0x2a, 0xda, 0xab, 0x4f, 0x75, 0xb9, 0x3f, 0x60, 0x82, 0xe7, 0x1a,
+	0xe3, 0xf9, 0x00, 0xde, 0x6b, 0xc9, 0xb3, 0x76, 0x43, 0xe9, 0x3b, 0x75, 0x5a, 0x68, 0x57, 0x27,
+	0x35, 0x7c, 0x43, 0xee, 0x97, 0x17, 0x0a, 0x38, 0xdf, 0x40, 0xe0, 0xc1, 0xb9, 0xee, 0xcb, 0xaf,
+	0x53, 0xa2, 0xea, 0x7c, 0x6f, 0x20, 0x82, 0xfb, 0x5d, 0xc6, 0x7d, 0x09, 0x2e, 0xf6, 0xc2, 0xbd,
+	0xa6, 0x24, 0xe1, 0x73, 0x05, 0x0c, 0x1e, 0x16, 0x4f, 0xf0, 0xdd, 0xee, 0x4b, 0x8d, 0xeb, 0x4f,
+	0x75, 0xa6, 0x07, 0x04, 0xc1, 0xf4, 0x0e, 0x63, 0xba, 0x00, 0xe7, 0x7a, 0x61, 0x2a, 0xd5, 0xda,
+	0x5f, 0x0a, 0x38, 0x57, 0x93, 0x0a, 0x72, 0x8f, 0x4f, 0xc9, 0x0b, 0xa1, 0x79, 0x79, 0x75, 0x41,
+	0x92, 0xe1, 0x5b, 0x2f, 0x15, 0x2b, 0xb8, 0x19, 0x8c, 0xdb, 0xfb, 0x70, 0xad, 0x25, 0xb7, 0xea,
+	0x83, 0xe7, 0xeb, 0x3b, 0x75, 0xef, 0xe5, 0xae, 0x2e, 0x76, 0x6d, 0x23, 0xde, 0xf0, 0x1f, 0x05,
+	0x5c, 0x68, 0xac, 0xb7, 0xe0, 0x62, 0xc7, 0xa3, 0x69, 0x29, 0x48, 0xd5, 0x9b, 0x3d, 0xe3, 0x74,
+	0x35, 0xe8, 0xce, 0x9a, 0xc1, 0x8e, 0x70, 0x03, 0x31, 0xd3, 0xc5, 0x11, 0x6e, 0x2e, 0xbf, 0xd4,
+	0xf9, 0xde, 0x40, 0xba, 0x3a, 0xc2, 0x6d, 0xf8, 0xd6, 0xf6, 0x3d, 0x7c, 0x94, 0x04, 0xa9, 0x66,
+	0xc2, 0x07, 0x2e, 0x75, 0x5f, 0x72, 0x63, 0xd1, 0xa6, 0xde, 0xea, 0x03, 0x92, 0xe8, 0xc0, 0x2a,
+	0xeb, 0xc0, 0x5d, 0xb8, 0xdc, 0x4b, 0x07, 0x0e, 0xeb, 0x38, 0xf8, 0xa3, 0x02, 0xce, 0xc4, 0xa4,
+	0x16, 0x9c, 0xee, 0xb8, 0xe4, 0x46, 0x02, 0x4e, 0x7d, 0xfb, 0x65, 0xc3, 0x05, 0xcd, 0x09, 0x46,
+	0x73, 0x1c, 0x8e, 0xb5, 0xa4, 0x59, 0x90, 0xb1, 0x46, 0xa8, 0xd7, 0xe0, 0x67, 0x49, 0x30, 0xdc,
+	0x54, 0xe0, 0xc0, 0xce, 0x87, 0xd0, 0x4e, 0xef, 0xa9, 0xb7, 0xfb, 0x01, 0x25, 0x98, 0xe6, 0x19,
+	0xd3, 0x65, 0x78, 0xbb, 0x25, 0xd3, 0x9d, 0x98, 0x80, 0xdc, 0xd5, 0xe5, 0x2f, 0x24, 0xc3, 0x0f,
+	0x81, 0xe5, 0xcf, 0x24, 0x71, 0x92, 0x67, 0x3f, 0x7a, 0xb2, 0x9f, 0x56, 0x9e, 0xee, 0xa7, 0x95,
+	0x3f, 0xf6, 0xd3, 0xca, 0x57, 0x07, 0xe9, 0xc4, 0xd3, 0x83, 0x74, 0xe2, 0xd7, 0x83, 0x74, 0xe2,
+	0x83, 0xf9, 0x88, 0x42, 0xc3, 0x9b, 0x4e, 0x10, 0x3e, 0x64, 0xd8, 0x2d, 0xe8, 0x9c, 0x0f, 0xa6,
+	0xdb, 0xe3, 0x82, 0xd3, 0x78, 0x89, 0x58, 0x81, 0x83, 0xf4, 0x87, 0xf1, 0x7a, 0x98, 0x86, 0x5b,
+	0x3f, 0xc6, 0xfe, 0x75, 0x37, 0xf1, 0xef, 0x00, 0x4b, 0xc1, 0x34, 0xf8, 0xef, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1678,6 +1699,21 @@ func (m *QueryDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.TokenizedStartRecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TokenizedStartRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IncludeTokenized {
+		i--
+		if m.IncludeTokenized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.DelegatorAddress) > 0 {
 		i -= len(m.DelegatorAddress)
 		copy(dAtA[i:], m.DelegatorAddress)
@@ -1708,6 +1744,11 @@ func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.NextTokenizedRecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextTokenizedRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Total) > 0 {
 		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2133,6 +2174,12 @@ func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.IncludeTokenized {
+		n += 2
+	}
+	if m.TokenizedStartRecordId != 0 {
+		n += 1 + sovQuery(uint64(m.TokenizedStartRecordId))
+	}
 	return n
 }
 
@@ -2154,6 +2201,9 @@ func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.NextTokenizedRecordId != 0 {
+		n += 1 + sovQuery(uint64(m.NextTokenizedRecordId))
+	}
 	return n
 }
 
@@ -3075,6 +3125,45 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTokenized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeTokenized = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedStartRecordId", wireType)
+			}
+			m.TokenizedStartRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenizedStartRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3193,6 +3282,25 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTokenizedRecordId", wireType)
+			}
+			m.NextTokenizedRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTokenizedRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
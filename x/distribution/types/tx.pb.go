@@ -366,6 +366,17 @@ var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalM
 // records owned by the designated owner
 type MsgWithdrawAllTokenizeShareRecordReward struct {
 	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	// record_ids optionally restricts the withdrawal to the given records;
+	// it cannot be combined with start_record_id or limit
+	RecordIds []uint64 `protobuf:"varint,2,rep,packed,name=record_ids,json=recordIds,proto3" json:"record_ids,omitempty" yaml:"record_ids"`
+	// start_record_id is the first record id to process, as returned in
+	// next_record_id by a previous withdrawal; the owner's records with an id at
+	// or after it are processed in id order, so a deleted record id continues with
+	// the next record
+	StartRecordId uint64 `protobuf:"varint,3,opt,name=start_record_id,json=startRecordId,proto3" json:"start_record_id,omitempty" yaml:"start_record_id"`
+	// limit is the maximum number of records to process; zero means the
+	// default maximum
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
 func (m *MsgWithdrawAllTokenizeShareRecordReward) Reset() {
@@ -405,6 +416,12 @@ var xxx_messageInfo_MsgWithdrawAllTokenizeShareRecordReward proto.InternalMessag
 
 // MsgWithdrawAllTokenizeShareRecordRewardResponse defines the Msg/WithdrawTokenizeShareRecordReward response type.
 type MsgWithdrawAllTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// results holds the outcome of the withdrawal for each processed record
+	Results []TokenizeShareRecordRewardResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
+	// next_record_id is the record id to resume from, or zero if all records
+	// of the owner were processed
+	NextRecordId uint64 `protobuf:"varint,3,opt,name=next_record_id,json=nextRecordId,proto3" json:"next_record_id,omitempty"`
 }
 
 func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Reset() {
@@ -444,6 +461,90 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawAllTokenizeShareRecordRewardResponse proto.InternalMessageInfo
 
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) GetResults() []TokenizeShareRecordRewardResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) GetNextRecordId() uint64 {
+	if m != nil {
+		return m.NextRecordId
+	}
+	return 0
+}
+
+// TokenizeShareRecordRewardResult is the outcome of a reward withdrawal for a
+// single tokenize share record
+type TokenizeShareRecordRewardResult struct {
+	RecordId uint64                                   `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// error is set when the withdrawal for the record failed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TokenizeShareRecordRewardResult) Reset()         { *m = TokenizeShareRecordRewardResult{} }
+func (m *TokenizeShareRecordRewardResult) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecordRewardResult) ProtoMessage()    {}
+func (*TokenizeShareRecordRewardResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{10}
+}
+func (m *TokenizeShareRecordRewardResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecordRewardResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecordRewardResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecordRewardResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecordRewardResult.Merge(m, src)
+}
+func (m *TokenizeShareRecordRewardResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecordRewardResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecordRewardResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecordRewardResult proto.InternalMessageInfo
+
+func (m *TokenizeShareRecordRewardResult) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecordRewardResult) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecordRewardResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // MsgFundCommunityPool allows an account to directly
 // fund the community pool.
 type MsgFundCommunityPool struct {
@@ -455,7 +556,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0452d52deb0ca76, []int{10}
+	return fileDescriptor_f0452d52deb0ca76, []int{11}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +593,7 @@ func (m *MsgFundCommunityPoolResponse) Reset()         { *m = MsgFundCommunityPo
 func (m *MsgFundCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPoolResponse) ProtoMessage()    {}
 func (*MsgFundCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0452d52deb0ca76, []int{11}
+	return fileDescriptor_f0452d52deb0ca76, []int{12}
 }
 func (m *MsgFundCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +622,101 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/distribution parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{13}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{14}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -532,62 +728,80 @@ func init() {
 	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 	proto.RegisterType((*MsgWithdrawAllTokenizeShareRecordReward)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawAllTokenizeShareRecordReward")
 	proto.RegisterType((*MsgWithdrawAllTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawAllTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*TokenizeShareRecordRewardResult)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordRewardResult")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "liquidstaking.distribution.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "liquidstaking.distribution.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("distribution/v1beta1/tx.proto", fileDescriptor_f0452d52deb0ca76) }
 
 var fileDescriptor_f0452d52deb0ca76 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x58, 0x29, 0xed, 0xa8, 0xd8, 0x86, 0x68, 0xdb, 0xad, 0xdd, 0xd4, 0xa5, 0x68, 0x11,
-	0xb3, 0x6b, 0x2a, 0x88, 0x06, 0x14, 0x9b, 0xfe, 0xc0, 0x1f, 0x04, 0xca, 0x46, 0x14, 0xbc, 0x94,
-	0x4d, 0x66, 0xd8, 0x0e, 0xdd, 0xdd, 0x49, 0x77, 0x66, 0x9b, 0xc6, 0xa3, 0x17, 0x15, 0x2c, 0x8a,
-	0x7f, 0x41, 0xbd, 0x89, 0x20, 0x78, 0xf0, 0xee, 0xc1, 0x4b, 0xd1, 0x4b, 0xf1, 0xe4, 0xa9, 0x4a,
-	0x7a, 0xd0, 0xb3, 0x7f, 0x81, 0x64, 0x7f, 0x75, 0x43, 0x92, 0x26, 0x6d, 0x63, 0x4f, 0x9b, 0x9d,
-	0xf7, 0xbe, 0xef, 0x7d, 0xdf, 0xcc, 0x9b, 0x97, 0x85, 0x63, 0x88, 0x30, 0x6e, 0x93, 0x82, 0xc3,
-	0x09, 0xb5, 0x94, 0xd5, 0x74, 0x01, 0x73, 0x2d, 0xad, 0xf0, 0x35, 0xb9, 0x64, 0x53, 0x4e, 0xe3,
-	0x92, 0x41, 0x56, 0x1c, 0x82, 0x18, 0xd7, 0x96, 0x89, 0xa5, 0xcb, 0xd1, 0x64, 0xd9, 0x4f, 0x16,
-	0x12, 0x3a, 0xd5, 0xa9, 0x9b, 0xae, 0xd4, 0x7e, 0x79, 0x48, 0x41, 0x2c, 0x52, 0x66, 0x52, 0xa6,
-	0x14, 0x34, 0x86, 0x43, 0xde, 0x22, 0x25, 0x96, 0x1f, 0x1f, 0xf1, 0xe2, 0x8b, 0x1e, 0xd0, 0x7b,
-	0xf1, 0x43, 0x43, 0x3e, 0xd4, 0x64, 0xba, 0xb2, 0x9a, 0xae, 0x3d, 0xbc, 0x80, 0xf4, 0x05, 0xc0,
-	0x33, 0x39, 0xa6, 0xe7, 0x31, 0x7f, 0x44, 0xf8, 0x12, 0xb2, 0xb5, 0xf2, 0x34, 0x42, 0x36, 0x66,
-	0x2c, 0x3e, 0x07, 0x07, 0x11, 0x36, 0xb0, 0xae, 0x71, 0x6a, 0x2f, 0x6a, 0xde, 0xe2, 0x30, 0x18,
-	0x07, 0x93, 0xfd, 0xd9, 0xe1, 0xef, 0x9f, 0x52, 0x09, 0x9f, 0xdf, 0x4f, 0xcf, 0x73, 0x9b, 0x58,
-	0xba, 0x3a, 0x10, 0x42, 0x02, 0x9a, 0x19, 0x38, 0x50, 0xf6, 0x99, 0x43, 0x96, 0x63, 0x6d, 0x58,
-	0x4e, 0x97, 0xeb, 0xb5, 0x64, 0xc4, 0xe7, 0x1b, 0xc9, 0xd8, 0x9f, 0x8d, 0x64, 0xec, 0xe9, 0xef,
-	0x8f, 0x97, 0x1a, 0x65, 0x49, 0x49, 0x38, 0xd6, 0xd4, 0x84, 0x8a, 0x59, 0x89, 0x5a, 0x0c, 0x4b,
-	0x5f, 0x01, 0x14, 0x72, 0x4c, 0x0f, 0xc2, 0xb3, 0x01, 0x83, 0x8a, 0xcb, 0x9a, 0x8d, 0xba, 0xe5,
-	0x75, 0x0e, 0x0e, 0xae, 0x6a, 0x06, 0x41, 0x75, 0x34, 0xed, 0xcc, 0x0e, 0x84, 0x90, 0x4e, 0xdd,
-	0xbe, 0x00, 0x50, 0x6a, 0x6d, 0x26, 0xf0, 0x1c, 0x2f, 0xc2, 0x5e, 0xcd, 0xa4, 0x8e, 0xc5, 0x87,
-	0xc1, 0x78, 0xcf, 0xe4, 0x89, 0xa9, 0x11, 0xd9, 0xaf, 0x5f, 0xeb, 0x9f, 0xa0, 0xd5, 0xe4, 0x19,
-	0x4a, 0xac, 0xec, 0x95, 0xcd, 0xed, 0x64, 0xec, 0xfd, 0xcf, 0xe4, 0xa4, 0x4e, 0xf8, 0x92, 0x53,
-	0x90, 0x8b, 0xd4, 0xf4, 0xfb, 0xc7, 0x7f, 0xa4, 0x18, 0x5a, 0x56, 0x78, 0xa5, 0x84, 0x99, 0x0b,
-	0x60, 0xaa, 0x4f, 0x2d, 0x3d, 0x03, 0x50, 0x8c, 0x68, 0x79, 0x18, 0x78, 0x99, 0xa1, 0xa6, 0x49,
-	0x18, 0x23, 0xd4, 0x6a, 0xbe, 0x2b, 0xe0, 0x90, 0xbb, 0xd2, 0xc0, 0x28, 0xad, 0x03, 0x78, 0x61,
-	0x6f, 0x25, 0x47, 0xbb, 0x33, 0x2f, 0x01, 0x9c, 0x88, 0xe8, 0x79, 0x40, 0x97, 0xb1, 0x45, 0x9e,
-	0xe0, 0xfc, 0x92, 0x66, 0x63, 0x15, 0x17, 0x69, 0xed, 0xa4, 0xdc, 0xe6, 0xbb, 0x09, 0x4f, 0xd1,
-	0xb2, 0x85, 0x1b, 0xf6, 0xe6, 0xef, 0x76, 0x32, 0x51, 0xd1, 0x4c, 0x23, 0x23, 0xd5, 0x85, 0x25,
-	0xf5, 0xa4, 0xfb, 0x1e, 0x34, 0xdd, 0x28, 0xec, 0xb7, 0x5d, 0xba, 0x45, 0x82, 0xdc, 0x66, 0x3b,
-	0xae, 0xf6, 0x79, 0x0b, 0x77, 0x51, 0xa6, 0x2f, 0xd8, 0x34, 0x49, 0x86, 0x97, 0x3b, 0x51, 0x13,
-	0xde, 0x18, 0x1b, 0x5e, 0x8c, 0xe4, 0x4f, 0x1b, 0xc6, 0xff, 0x32, 0x10, 0xd1, 0x98, 0x86, 0x4a,
-	0x87, 0x35, 0x43, 0x99, 0xdf, 0x00, 0x4c, 0xe4, 0x98, 0x3e, 0xef, 0x58, 0xa8, 0x76, 0xd0, 0x8e,
-	0x45, 0x78, 0x65, 0x81, 0x52, 0xe3, 0x48, 0xce, 0x38, 0x7e, 0x0d, 0xf6, 0x23, 0x5c, 0xa2, 0x8c,
-	0x70, 0x6a, 0xb7, 0xbd, 0xe8, 0xbb, 0xa9, 0x99, 0xb3, 0xd1, 0x5e, 0xde, 0x5d, 0x97, 0x44, 0x78,
-	0xae, 0x99, 0x99, 0xc0, 0xed, 0xd4, 0x7a, 0x1f, 0xec, 0xc9, 0x31, 0x3d, 0xfe, 0x06, 0xc0, 0x78,
-	0x93, 0x91, 0x7d, 0x43, 0x6e, 0xff, 0xdf, 0x22, 0x37, 0x1d, 0x94, 0xc2, 0xf4, 0x81, 0xa1, 0xe1,
-	0xad, 0x7a, 0x0b, 0xe0, 0x50, 0xab, 0x01, 0x7b, 0xab, 0x43, 0xfa, 0x16, 0x78, 0x61, 0xfe, 0x70,
-	0xf8, 0x50, 0xe3, 0x07, 0x00, 0x47, 0xf7, 0x9a, 0x55, 0xd9, 0x7d, 0xd6, 0x69, 0xc2, 0x21, 0xdc,
-	0x3b, 0x3c, 0x47, 0xa8, 0xf7, 0x33, 0x80, 0xe7, 0xdb, 0x4f, 0x90, 0x3b, 0xfb, 0xac, 0xd8, 0x92,
-	0x49, 0x58, 0xe8, 0x16, 0x53, 0xe8, 0x60, 0x13, 0xc0, 0x89, 0x8e, 0xa6, 0xc8, 0xfd, 0x7d, 0x96,
-	0xde, 0x8b, 0x4c, 0xc8, 0x77, 0x91, 0x2c, 0xb4, 0xf2, 0x0a, 0xc0, 0xc1, 0xc6, 0x41, 0x73, 0xbd,
-	0xc3, 0x52, 0x0d, 0x48, 0xe1, 0xf6, 0x41, 0x91, 0x81, 0xa2, 0x6c, 0xe1, 0x5d, 0x55, 0x04, 0x9b,
-	0x55, 0x11, 0x6c, 0x55, 0x45, 0xf0, 0xab, 0x2a, 0x82, 0xd7, 0x3b, 0x62, 0x6c, 0x6b, 0x47, 0x8c,
-	0xfd, 0xd8, 0x11, 0x63, 0x8f, 0x67, 0x23, 0xf3, 0x8c, 0xac, 0x18, 0x4e, 0xad, 0xb3, 0x88, 0x55,
-	0x54, 0xbc, 0xaa, 0x84, 0x57, 0x52, 0x7e, 0xe5, 0x94, 0x49, 0x91, 0x63, 0x60, 0x65, 0x4d, 0xa9,
-	0xfb, 0x74, 0x75, 0x27, 0x5e, 0xa1, 0xd7, 0xfd, 0x50, 0xbc, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x7e, 0x76, 0x14, 0x5b, 0xd7, 0x0a, 0x00, 0x00,
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xde, 0x49, 0xd2, 0xfc, 0xba, 0x6f, 0xd2, 0x26, 0xb1, 0xb6, 0x4d, 0xe2, 0xfe, 0xea, 0x2d,
+	0x56, 0x44, 0xa3, 0x8a, 0xd8, 0x24, 0x45, 0x08, 0x16, 0x81, 0xc8, 0xa6, 0xad, 0x0a, 0x68, 0xa5,
+	0xc8, 0xe1, 0x43, 0xe2, 0x12, 0x79, 0xd7, 0x23, 0x67, 0x14, 0xdb, 0xb3, 0x9d, 0x19, 0x67, 0xb3,
+	0x9c, 0x50, 0x2f, 0x80, 0x04, 0xa2, 0xe2, 0x2f, 0x68, 0xc5, 0x05, 0x21, 0x21, 0x71, 0xe0, 0xc2,
+	0x89, 0x03, 0x97, 0x08, 0x2e, 0x15, 0x27, 0x4e, 0x01, 0x25, 0x07, 0x38, 0xe7, 0x2f, 0x40, 0xfe,
+	0x5c, 0x6f, 0x77, 0x93, 0x75, 0x3e, 0x9a, 0xd3, 0xee, 0xcc, 0xbc, 0xcf, 0xf3, 0x3e, 0xcf, 0xeb,
+	0x77, 0x66, 0x6c, 0xb8, 0x6e, 0x11, 0x2e, 0x18, 0xa9, 0xfb, 0x82, 0x50, 0x4f, 0xdf, 0x5a, 0xac,
+	0x63, 0x61, 0x2e, 0xea, 0x62, 0x5b, 0x6b, 0x32, 0x2a, 0xa8, 0xa4, 0x3a, 0xe4, 0x81, 0x4f, 0x2c,
+	0x2e, 0xcc, 0x4d, 0xe2, 0xd9, 0x5a, 0x36, 0x58, 0x8b, 0x83, 0xe5, 0x92, 0x4d, 0x6d, 0x1a, 0x86,
+	0xeb, 0xc1, 0xbf, 0x08, 0x29, 0x2b, 0x0d, 0xca, 0x5d, 0xca, 0xf5, 0xba, 0xc9, 0x71, 0xca, 0xdb,
+	0xa0, 0xc4, 0x8b, 0xd7, 0x67, 0xa3, 0xf5, 0xf5, 0x08, 0x18, 0x0d, 0xe2, 0xa5, 0xe9, 0x18, 0xea,
+	0x72, 0x5b, 0xdf, 0x5a, 0x0c, 0x7e, 0xe2, 0x85, 0x9b, 0x7d, 0xc5, 0x76, 0x89, 0x0a, 0x03, 0xd5,
+	0x5f, 0x11, 0x5c, 0xa9, 0x71, 0x7b, 0x0d, 0x8b, 0x8f, 0x88, 0xd8, 0xb0, 0x98, 0xd9, 0x5a, 0xb6,
+	0x2c, 0x86, 0x39, 0x97, 0xee, 0xc2, 0x94, 0x85, 0x1d, 0x6c, 0x9b, 0x82, 0xb2, 0x75, 0x33, 0x9a,
+	0x9c, 0x41, 0x37, 0xd0, 0x7c, 0xb1, 0x3a, 0xf3, 0xc7, 0x4f, 0x0b, 0xa5, 0x58, 0x48, 0x1c, 0xbe,
+	0x26, 0x18, 0xf1, 0x6c, 0x63, 0x32, 0x85, 0x24, 0x34, 0x2b, 0x30, 0xd9, 0x8a, 0x99, 0x53, 0x96,
+	0xa1, 0x01, 0x2c, 0x13, 0xad, 0x6e, 0x2d, 0x15, 0xe5, 0xf3, 0xc7, 0xe5, 0xc2, 0xbf, 0x8f, 0xcb,
+	0x85, 0x87, 0xff, 0xfc, 0x78, 0xab, 0x57, 0x96, 0x5a, 0x86, 0xeb, 0x7d, 0x4d, 0x18, 0x98, 0x37,
+	0xa9, 0xc7, 0xb1, 0xfa, 0x1b, 0x02, 0xb9, 0xc6, 0xed, 0x64, 0xf9, 0x4e, 0xc2, 0x60, 0xe0, 0x96,
+	0xc9, 0xac, 0xb3, 0xf2, 0x7a, 0x17, 0xa6, 0xb6, 0x4c, 0x87, 0x58, 0x5d, 0x34, 0x83, 0xcc, 0x4e,
+	0xa6, 0x90, 0xbc, 0x6e, 0xbf, 0x40, 0xa0, 0x1e, 0x6e, 0x26, 0xf1, 0x2c, 0x35, 0x60, 0xd4, 0x74,
+	0xa9, 0xef, 0x89, 0x19, 0x74, 0x63, 0x78, 0x7e, 0x6c, 0x69, 0x56, 0x8b, 0xf3, 0x07, 0x8d, 0x96,
+	0xf4, 0xa4, 0xb6, 0x42, 0x89, 0x57, 0x7d, 0x79, 0x67, 0xb7, 0x5c, 0xf8, 0xfe, 0xaf, 0xf2, 0xbc,
+	0x4d, 0xc4, 0x86, 0x5f, 0xd7, 0x1a, 0xd4, 0x8d, 0x1b, 0x2d, 0xfe, 0x59, 0xe0, 0xd6, 0xa6, 0x2e,
+	0xda, 0x4d, 0xcc, 0x43, 0x00, 0x37, 0x62, 0x6a, 0xf5, 0x33, 0x04, 0x4a, 0x46, 0xcb, 0x87, 0x89,
+	0x97, 0x15, 0xea, 0xba, 0x84, 0x73, 0x42, 0xbd, 0xfe, 0x55, 0x41, 0xa7, 0xac, 0x4a, 0x0f, 0xa3,
+	0xfa, 0x15, 0x82, 0x17, 0x8f, 0x56, 0x72, 0xbe, 0x95, 0xf9, 0x12, 0xc1, 0x5c, 0x46, 0xcf, 0xfb,
+	0x74, 0x13, 0x7b, 0xe4, 0x13, 0xbc, 0xb6, 0x61, 0x32, 0x6c, 0xe0, 0x06, 0x65, 0x56, 0xf4, 0xbc,
+	0xa4, 0x37, 0xe1, 0x12, 0x6d, 0x79, 0xb8, 0xa7, 0x36, 0x07, 0xbb, 0xe5, 0x52, 0xdb, 0x74, 0x9d,
+	0x8a, 0xda, 0xb5, 0xac, 0x1a, 0xe3, 0xe1, 0x38, 0x69, 0xba, 0x6b, 0x50, 0x64, 0x21, 0xdd, 0x3a,
+	0xb1, 0xc2, 0x66, 0x1b, 0x31, 0x2e, 0x46, 0x13, 0xef, 0x58, 0x95, 0x8b, 0x49, 0xd1, 0x54, 0x0d,
+	0x5e, 0xca, 0xa3, 0x26, 0xdd, 0x31, 0x0f, 0x87, 0xe0, 0x66, 0x06, 0xb0, 0xec, 0x38, 0xcf, 0xcd,
+	0xc1, 0x2b, 0x00, 0xa9, 0x83, 0x60, 0xbf, 0x0c, 0xcf, 0x8f, 0x54, 0xaf, 0x1c, 0xec, 0x96, 0xa7,
+	0x22, 0x6c, 0x67, 0x4d, 0x35, 0x8a, 0x89, 0x33, 0x2e, 0x55, 0x61, 0x82, 0x0b, 0x93, 0x89, 0xf5,
+	0x8e, 0xfb, 0xe1, 0xc0, 0x7d, 0x55, 0x3e, 0xd8, 0x2d, 0x5f, 0x8d, 0xa0, 0xcf, 0x04, 0xa8, 0xc6,
+	0xa5, 0x70, 0xc6, 0x88, 0x49, 0xa4, 0x12, 0x5c, 0x70, 0x88, 0x4b, 0xc4, 0xcc, 0x48, 0x58, 0xb7,
+	0x68, 0x90, 0x29, 0xda, 0x93, 0x21, 0xd0, 0x73, 0x16, 0xe1, 0x5c, 0x9b, 0x4b, 0x6a, 0xc0, 0xff,
+	0x18, 0xe6, 0xbe, 0x23, 0xa2, 0x7a, 0x8d, 0x2d, 0xad, 0x68, 0x83, 0xef, 0x1f, 0xed, 0x28, 0xf1,
+	0xbe, 0x23, 0xaa, 0x23, 0x81, 0x1e, 0x23, 0x61, 0x96, 0xe6, 0xe0, 0xb2, 0x87, 0xb7, 0x7b, 0x0a,
+	0x6c, 0x8c, 0x07, 0xb3, 0x49, 0x0d, 0xd5, 0x9f, 0x11, 0x94, 0x07, 0x10, 0x77, 0xf7, 0x28, 0xea,
+	0xee, 0xd1, 0x4c, 0xc1, 0x86, 0x9e, 0x5f, 0xc1, 0x4a, 0x70, 0x01, 0x33, 0x46, 0x59, 0x68, 0xa1,
+	0x68, 0x44, 0x03, 0xf5, 0x77, 0x04, 0xa5, 0x1a, 0xb7, 0xef, 0xf9, 0x9e, 0x15, 0x1c, 0x13, 0xbe,
+	0x47, 0x44, 0x7b, 0x95, 0x52, 0xe7, 0x7c, 0x1e, 0xe2, 0xab, 0x50, 0xb4, 0x70, 0x93, 0x72, 0x22,
+	0x28, 0x1b, 0x78, 0x4d, 0x74, 0x42, 0x2b, 0x57, 0xb3, 0x27, 0x61, 0x67, 0x5e, 0x55, 0xe0, 0xff,
+	0xfd, 0xcc, 0xa4, 0x5b, 0xfa, 0x5b, 0x04, 0x13, 0x35, 0x6e, 0x7f, 0xd0, 0xb4, 0x4c, 0x81, 0x57,
+	0x4d, 0x66, 0xba, 0x3c, 0xd0, 0x60, 0xfa, 0x62, 0x83, 0x32, 0x22, 0xda, 0x03, 0x0f, 0xe5, 0x4e,
+	0xa8, 0x74, 0x1f, 0x46, 0x9b, 0x21, 0x43, 0x28, 0x7c, 0x6c, 0xe9, 0x56, 0x9e, 0xfe, 0x8b, 0x72,
+	0xc6, 0x6d, 0x16, 0xe3, 0x2b, 0x97, 0x43, 0x17, 0x29, 0xb3, 0x3a, 0x0b, 0xd3, 0xcf, 0x88, 0x4c,
+	0x0c, 0x2c, 0x3d, 0x2a, 0xc2, 0x70, 0x8d, 0xdb, 0xd2, 0x37, 0x08, 0xa4, 0x3e, 0x6f, 0x2c, 0xaf,
+	0xe7, 0xd1, 0xd0, 0xf7, 0x3d, 0x41, 0x5e, 0x3e, 0x31, 0x34, 0xdd, 0xf7, 0x4f, 0x10, 0x4c, 0x1f,
+	0xf6, 0x7e, 0xf1, 0x56, 0x4e, 0xfa, 0x43, 0xf0, 0xf2, 0xbd, 0xd3, 0xe1, 0x53, 0x8d, 0x3f, 0x20,
+	0xb8, 0x76, 0xd4, 0x55, 0x5d, 0x3d, 0x66, 0x9e, 0x3e, 0x1c, 0xf2, 0xbb, 0xa7, 0xe7, 0x48, 0xf5,
+	0xfe, 0x82, 0xe0, 0x85, 0xc1, 0x17, 0xe8, 0xfd, 0x63, 0x66, 0x3c, 0x94, 0x49, 0x5e, 0x3d, 0x2b,
+	0xa6, 0xd4, 0xc1, 0x0e, 0x82, 0xb9, 0x5c, 0x77, 0xe8, 0x7b, 0xc7, 0x4c, 0x7d, 0x14, 0x99, 0xbc,
+	0x76, 0x86, 0x64, 0xa9, 0x95, 0xaf, 0x11, 0x4c, 0xf5, 0x9e, 0x94, 0xaf, 0xe5, 0x4c, 0xd5, 0x83,
+	0x94, 0xdf, 0x3e, 0x29, 0x32, 0x55, 0xf4, 0x29, 0x82, 0xf1, 0xae, 0xd3, 0xec, 0x76, 0x4e, 0xca,
+	0x2c, 0x48, 0x7e, 0xe3, 0x04, 0xa0, 0x44, 0x42, 0xb5, 0xfe, 0xdd, 0x9e, 0x82, 0x76, 0xf6, 0x14,
+	0xf4, 0x74, 0x4f, 0x41, 0x7f, 0xef, 0x29, 0xe8, 0xd1, 0xbe, 0x52, 0x78, 0xba, 0xaf, 0x14, 0xfe,
+	0xdc, 0x57, 0x0a, 0x1f, 0xdf, 0xc9, 0xdc, 0x09, 0xe4, 0x81, 0xe3, 0x07, 0xcd, 0x4d, 0xbc, 0x86,
+	0x1e, 0x25, 0x24, 0xa2, 0xbd, 0x10, 0x27, 0x5d, 0x70, 0xa9, 0xe5, 0x3b, 0x58, 0xdf, 0xee, 0xfa,
+	0x46, 0x8b, 0x6e, 0x8d, 0xfa, 0x68, 0xf8, 0xa9, 0x76, 0xfb, 0xbf, 0x01, 0x00, 0x4c, 0x6d, 0x10,
+	0x90, 0x82, 0x0e, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -709,6 +923,60 @@ func (this *MsgWithdrawAllTokenizeShareRecordRewardResponse) Equal(that interfac
 	} else if this == nil {
 		return false
 	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(&that1.Results[i]) {
+			return false
+		}
+	}
+	if this.NextRecordId != that1.NextRecordId {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecordRewardResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecordRewardResult)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecordRewardResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
 	return true
 }
 func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
@@ -732,6 +1000,54 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -761,6 +1077,9 @@ type MsgClient interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -825,6 +1144,15 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -843,6 +1171,9 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -867,6 +1198,9 @@ func (*UnimplementedMsgServer) WithdrawAllTokenizeShareRecordReward(ctx context.
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -980,6 +1314,24 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "liquidstaking.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1008,6 +1360,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "distribution/v1beta1/tx.proto",
@@ -1292,6 +1648,34 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartRecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecordIds) > 0 {
+		dAtA2 := make([]byte, len(m.RecordIds)*10)
+		var j1 int
+		for _, num := range m.RecordIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.OwnerAddress) > 0 {
 		i -= len(m.OwnerAddress)
 		copy(dAtA[i:], m.OwnerAddress)
@@ -1322,10 +1706,43 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(d
 	_ = i
 	var l int
 	_ = l
+	if m.NextRecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
+func (m *TokenizeShareRecordRewardResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1335,22 +1752,22 @@ func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecordRewardResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecordRewardResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
@@ -1363,12 +1780,61 @@ func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1392,6 +1858,69 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1524,6 +2053,19 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.RecordIds) > 0 {
+		l = 0
+		for _, e := range m.RecordIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.StartRecordId != 0 {
+		n += 1 + sovTx(uint64(m.StartRecordId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
 	return n
 }
 
@@ -1533,6 +2075,43 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NextRecordId != 0 {
+		n += 1 + sovTx(uint64(m.NextRecordId))
+	}
+	return n
+}
+
+func (m *TokenizeShareRecordRewardResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1564,6 +2143,30 @@ func (m *MsgFundCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2310,56 +2913,120 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 			}
 			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RecordIds = append(m.RecordIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RecordIds) == 0 {
+					m.RecordIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RecordIds = append(m.RecordIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRecordId", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.StartRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2381,7 +3048,7 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2404,10 +3071,10 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2446,9 +3113,9 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2458,28 +3125,300 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Results = append(m.Results, TokenizeShareRecordRewardResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecordId", wireType)
+			}
+			m.NextRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecordRewardResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecordRewardResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecordRewardResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -2547,6 +3486,171 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,17 @@
+package lsmbasket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+)
+
+// EndBlocker claims the rewards of a batch of the records owned by the basket
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ClaimRewards(ctx)
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/lsmbasket transaction commands.
+func NewTxCmd() *cobra.Command {
+	basketTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Share token basket transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	basketTxCmd.AddCommand(
+		NewDepositSharesCmd(),
+		NewRedeemBasketCmd(),
+	)
+
+	return basketTxCmd
+}
+
+// NewDepositSharesCmd returns a CLI command handler for creating a MsgDepositShares transaction.
+func NewDepositSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-shares [share-tokens]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deposit share tokens into the basket in exchange for basket tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit share tokens of an allowed validator into the basket in exchange for
+basket tokens at the current exchange rate. The first deposit of a share token
+denom transfers its tokenize share record from the depositor to the basket.
+
+Example:
+$ %s tx %s deposit-shares 1000cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositShares(clientCtx.GetFromAddress(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRedeemBasketCmd returns a CLI command handler for creating a MsgRedeemBasket transaction.
+func NewRedeemBasketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-basket [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Redeem basket tokens for delegations and a pro-rata part of the other basket balances",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn basket tokens for a pro-rata part of every balance of the basket. The
+share tokens of that part are redeemed into delegations of the redeemer.
+
+Example:
+$ %s tx %s redeem-basket 1000%s --from mykey
+`,
+				version.AppName, types.ModuleName, types.BasketDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemBasket(clientCtx.GetFromAddress(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,290 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// ShareTokenValue returns the amount of bond denom tokens the share tokens are
+// worth, which is their share of the tokens of their record's delegation
+func (k Keeper) ShareTokenValue(ctx sdk.Context, shareTokens sdk.Coin) (math.Int, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if err != nil {
+		return math.ZeroInt(), types.ErrNotShareToken.Wrap(shareTokens.Denom)
+	}
+	return k.shareTokenValue(ctx, record, shareTokens.Amount)
+}
+
+func (k Keeper) shareTokenValue(ctx sdk.Context, record stakingtypes.TokenizeShareRecord, amount math.Int) (math.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	validator, found := k.stakingKeeper.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), sdkstaking.ErrNoValidatorFound
+	}
+
+	delegation, found := k.stakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	supply := k.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom())
+	if !found || !supply.Amount.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	return validator.TokensFromShares(delegation.Shares).MulInt(amount).QuoInt(supply.Amount).TruncateInt(), nil
+}
+
+// GetHoldings returns the balances of the basket together with their values.
+// Share tokens are valued at their share of their record's delegation, bond
+// denom tokens at face value and any other balance at zero.
+func (k Keeper) GetHoldings(ctx sdk.Context) (holdings []types.BasketHolding) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, k.GetModuleAddress()) {
+		holding := types.BasketHolding{Amount: balance, Value: math.ZeroInt()}
+		if balance.Denom == bondDenom {
+			holding.Value = balance.Amount
+		} else if value, err := k.ShareTokenValue(ctx, balance); err == nil {
+			holding.Value = value
+		}
+		holdings = append(holdings, holding)
+	}
+	return holdings
+}
+
+// getHeldRecords returns the tokenize share records owned by the basket whose
+// share tokens it holds
+func (k Keeper) getHeldRecords(ctx sdk.Context) (records []stakingtypes.TokenizeShareRecord) {
+	basket := k.GetModuleAddress()
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, basket) {
+		record, err := k.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, balance.Denom)
+		if err != nil {
+			continue
+		}
+		owner, err := k.stakingKeeper.GetTokenizeShareRecordOwner(ctx, record.Id)
+		if err != nil || !owner.Equals(basket) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetTotalValue returns the value of the basket in bond denom tokens, which
+// includes the bond denom rewards its held records accrued since their last claim
+func (k Keeper) GetTotalValue(ctx sdk.Context) math.Int {
+	total := math.ZeroInt()
+	for _, holding := range k.GetHoldings(ctx) {
+		total = total.Add(holding.Value)
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, record := range k.getHeldRecords(ctx) {
+		reward, err := k.distrKeeper.GetTokenizeShareRecordReward(ctx, record.Id)
+		if err != nil {
+			continue
+		}
+		total = total.Add(reward.AmountOf(bondDenom).TruncateInt())
+	}
+	return total
+}
+
+// GetExchangeRate returns the value of one basket token in bond denom tokens,
+// which is one while there are no basket tokens
+func (k Keeper) GetExchangeRate(ctx sdk.Context) sdk.Dec {
+	supply := k.bankKeeper.GetSupply(ctx, types.BasketDenom)
+	if !supply.Amount.IsPositive() {
+		return sdk.OneDec()
+	}
+	return sdk.NewDecFromInt(k.GetTotalValue(ctx)).QuoInt(supply.Amount)
+}
+
+// DepositShares moves share tokens of an allowed validator into the basket and
+// mints basket tokens for their value to the depositor. The basket must own the
+// record of the share tokens, so the first deposit of a denom transfers the
+// record from the depositor to the basket.
+func (k Keeper) DepositShares(ctx sdk.Context, depositor sdk.AccAddress, shareTokens sdk.Coin) (sdk.Coin, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if err != nil {
+		return sdk.Coin{}, types.ErrNotShareToken.Wrap(shareTokens.Denom)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !k.GetParams(ctx).IsValidatorAllowed(valAddr) {
+		return sdk.Coin{}, types.ErrValidatorNotAllowed.Wrap(record.Validator)
+	}
+
+	basket := k.GetModuleAddress()
+	owner, err := k.stakingKeeper.GetTokenizeShareRecordOwner(ctx, record.Id)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	switch {
+	case owner.Equals(basket):
+		// claim the rewards accrued so far for the current basket holders
+		if _, err := k.distrKeeper.WithdrawTokenizeShareRecordReward(ctx, basket, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	case owner.Equals(depositor):
+		// the rewards accrued so far belong to the depositor
+		if _, err := k.distrKeeper.WithdrawTokenizeShareRecordReward(ctx, depositor, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+		_, err := k.stakingMsgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTransferTokenizeShareRecord{
+			TokenizeShareRecordId: record.Id,
+			Sender:                depositor.String(),
+			NewOwner:              basket.String(),
+		})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	default:
+		return sdk.Coin{}, stakingtypes.ErrNotTokenizeShareRecordOwner.Wrapf(
+			"record %d must be owned by the depositor or the basket", record.Id,
+		)
+	}
+
+	value, err := k.shareTokenValue(ctx, record, shareTokens.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// mint at the exchange rate before the deposit
+	minted := value
+	supply := k.bankKeeper.GetSupply(ctx, types.BasketDenom)
+	if supply.Amount.IsPositive() {
+		totalValue := k.GetTotalValue(ctx)
+		if !totalValue.IsPositive() {
+			return sdk.Coin{}, types.ErrBasketNotBacked
+		}
+		minted = value.Mul(supply.Amount).Quo(totalValue)
+	}
+	if !minted.IsPositive() {
+		return sdk.Coin{}, types.ErrAmountTooSmall.Wrapf("%s are worth no basket tokens", shareTokens)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	basketTokens := sdk.NewCoin(types.BasketDenom, minted)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(basketTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(basketTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositShares,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordId, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, basketTokens.String()),
+		),
+	)
+
+	return basketTokens, nil
+}
+
+// RedeemBasket burns basket tokens of the redeemer for their pro-rata part of
+// every balance of the basket. The share tokens of that part are redeemed into
+// delegations of the redeemer, the other balances are sent as they are.
+func (k Keeper) RedeemBasket(ctx sdk.Context, redeemer sdk.AccAddress, basketTokens sdk.Coin) (delegated sdk.Coin, sent sdk.Coins, err error) {
+	supply := k.bankKeeper.GetSupply(ctx, types.BasketDenom)
+	if basketTokens.Amount.GT(supply.Amount) {
+		return sdk.Coin{}, nil, types.ErrAmountTooSmall.Wrapf("%s exceed the basket token supply of %s", basketTokens, supply)
+	}
+
+	// claim the rewards of the held records first, so that the redeemer is paid
+	// its part of them; a record that fails is skipped as in ClaimRewards
+	basket := k.GetModuleAddress()
+	for _, record := range k.getHeldRecords(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.distrKeeper.WithdrawTokenizeShareRecordReward(cacheCtx, basket, record.Id); err != nil {
+			k.Logger(ctx).Error("failed to claim tokenize share record rewards", "record_id", record.Id, "err", err)
+			continue
+		}
+		write()
+	}
+
+	payout := sdk.NewCoins()
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, basket) {
+		amount := balance.Amount.Mul(basketTokens.Amount).Quo(supply.Amount)
+		if amount.IsPositive() {
+			payout = payout.Add(sdk.NewCoin(balance.Denom, amount))
+		}
+	}
+	if payout.Empty() {
+		return sdk.Coin{}, nil, types.ErrAmountTooSmall.Wrapf("%s are worth nothing", basketTokens)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(basketTokens)); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(basketTokens)); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, payout); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	delegated = sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
+	sent = sdk.NewCoins()
+	for _, coin := range payout {
+		if _, err := k.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, coin.Denom); err != nil {
+			sent = sent.Add(coin)
+			continue
+		}
+
+		res, err := k.stakingMsgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+			DelegatorAddress: redeemer.String(),
+			Amount:           coin,
+		})
+		if err != nil {
+			return sdk.Coin{}, nil, err
+		}
+		delegated = delegated.Add(res.Amount)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemBasket,
+			sdk.NewAttribute(types.AttributeKeyRedeemer, redeemer.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, basketTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegated, delegated.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sent.String()),
+		),
+	)
+
+	return delegated, sent, nil
+}
+
+// ClaimRewards claims the rewards of at most MaxRewardClaimsPerBlock records owned
+// by the basket, continuing from a persisted cursor, so that the rewards of all
+// records are claimed over successive blocks. Each record is claimed on a cached
+// context; a record that fails is logged and skipped, and the cursor moves past
+// it so that it cannot hold up the other records.
+func (k Keeper) ClaimRewards(ctx sdk.Context) {
+	basket := k.GetModuleAddress()
+	cursor := k.GetRewardClaimCursor(ctx)
+
+	records, nextRecordId := k.stakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, basket, cursor, types.MaxRewardClaimsPerBlock)
+	for _, record := range records {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.distrKeeper.WithdrawTokenizeShareRecordReward(cacheCtx, basket, record.Id); err != nil {
+			k.Logger(ctx).Error("failed to claim tokenize share record rewards", "record_id", record.Id, "err", err)
+			continue
+		}
+		write()
+	}
+
+	k.SetRewardClaimCursor(ctx, nextRecordId)
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+)
+
+// InitGenesis sets lsmbasket information for genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	// ensure the module account exists
+	k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+	k.SetRewardClaimCursor(ctx, data.RewardClaimCursor)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetRewardClaimCursor(ctx))
+}
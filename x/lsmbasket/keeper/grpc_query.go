@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries params of the lsmbasket module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ExchangeRate queries the value of a basket token in bond denom tokens
+func (k Keeper) ExchangeRate(c context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryExchangeRateResponse{
+		Supply:       k.bankKeeper.GetSupply(ctx, types.BasketDenom),
+		TotalValue:   k.GetTotalValue(ctx),
+		ExchangeRate: k.GetExchangeRate(ctx),
+	}, nil
+}
+
+// Holdings queries the balances of the basket and their values
+func (k Keeper) Holdings(c context.Context, req *types.QueryHoldingsRequest) (*types.QueryHoldingsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryHoldingsResponse{Holdings: k.GetHoldings(ctx)}, nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+)
+
+// RegisterInvariants registers all lsmbasket invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "record-ownership",
+		RecordOwnershipInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "backing",
+		BackingInvariant(k))
+}
+
+// AllInvariants runs all invariants of the lsmbasket module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := RecordOwnershipInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return BackingInvariant(k)(ctx)
+	}
+}
+
+// RecordOwnershipInvariant checks that the basket owns the tokenize share record
+// of every share token it holds, so that their rewards accrue to the basket
+func RecordOwnershipInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		basket := k.GetModuleAddress()
+		for _, balance := range k.bankKeeper.GetAllBalances(ctx, basket) {
+			record, err := k.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, balance.Denom)
+			if err != nil {
+				continue
+			}
+			owner, err := k.stakingKeeper.GetTokenizeShareRecordOwner(ctx, record.Id)
+			if err != nil || !owner.Equals(basket) {
+				count++
+				msg += fmt.Sprintf("\trecord %d of held %s is owned by %s\n", record.Id, balance, owner)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "record ownership",
+			fmt.Sprintf("found %d held share tokens whose records are not owned by the basket\n%s", count, msg)), broken
+	}
+}
+
+// BackingInvariant checks that outstanding basket tokens are backed by share
+// tokens held by the basket, worth a positive value, and that the basket holds
+// no share tokens once all basket tokens are redeemed. Redemptions pay out a
+// pro-rata part of every balance, so the basket keeps some of every share
+// token while basket tokens are outstanding and hands out all of them with the
+// last basket tokens.
+func BackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supply := k.bankKeeper.GetSupply(ctx, types.BasketDenom)
+
+		shareTokens := sdk.NewCoins()
+		shareTokensValue := math.ZeroInt()
+		for _, balance := range k.bankKeeper.GetAllBalances(ctx, k.GetModuleAddress()) {
+			value, err := k.ShareTokenValue(ctx, balance)
+			if errors.Is(err, types.ErrNotShareToken) {
+				continue
+			}
+			shareTokens = shareTokens.Add(balance)
+			if err == nil {
+				shareTokensValue = shareTokensValue.Add(value)
+			}
+		}
+
+		var broken bool
+		if supply.Amount.IsPositive() {
+			broken = shareTokens.Empty() || !shareTokensValue.IsPositive()
+		} else {
+			broken = !shareTokens.Empty()
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "backing",
+			fmt.Sprintf("\tbasket token supply: %s\n\theld share tokens: %s\n\theld share tokens value: %s\n",
+				supply, shareTokens, shareTokensValue)), broken
+	}
+}
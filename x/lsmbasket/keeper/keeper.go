@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+)
+
+// Keeper of the lsmbasket store
+type Keeper struct {
+	storeKey         storetypes.StoreKey
+	cdc              codec.BinaryCodec
+	paramSpace       paramtypes.Subspace
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	stakingKeeper    types.StakingKeeper
+	stakingMsgServer types.StakingMsgServer
+	distrKeeper      types.DistributionKeeper
+}
+
+// NewKeeper creates a new lsmbasket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
+	stakingMsgServer types.StakingMsgServer, dk types.DistributionKeeper,
+) Keeper {
+	// ensure lsmbasket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		stakingMsgServer: stakingMsgServer,
+		distrKeeper:      dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetModuleAddress returns the address of the basket, which holds the share
+// tokens and owns their tokenize share records
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return k.authKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// GetRewardClaimCursor returns the id of the next record whose rewards are claimed
+func (k Keeper) GetRewardClaimCursor(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RewardClaimCursorKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetRewardClaimCursor sets the id of the next record whose rewards are claimed
+func (k Keeper) SetRewardClaimCursor(ctx sdk.Context, cursor uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RewardClaimCursorKey, sdk.Uint64ToBigEndian(cursor))
+}
@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+var PKS = simapp.CreateTestPubKeys(2)
+
+func TestDepositAndRedeem(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(200000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKS[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKS[1], 100, true)
+	tstaking.Delegate(addrs[0], valAddrs[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the depositor is the self-delegator of the first validator
+	depositor, buyer := addrs[0], addrs[2]
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	msgServer := keeper.NewMsgServerImpl(app.LSMBasketKeeper)
+	tokenize := func(valAddr sdk.ValAddress) sdk.Coin {
+		res, err := stakingMsgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+			DelegatorAddress:    depositor.String(),
+			ValidatorAddress:    valAddr.String(),
+			TokenizedShareOwner: depositor.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)),
+		})
+		require.NoError(t, err)
+		return res.Amount
+	}
+	deposit := func(depositor sdk.AccAddress, shareTokens sdk.Coin) (sdk.Coin, error) {
+		res, err := msgServer.DepositShares(sdk.WrapSDKContext(ctx), types.NewMsgDepositShares(depositor, shareTokens))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return res.Amount, nil
+	}
+
+	allowed := tokenize(valAddrs[0])
+	notAllowed := tokenize(valAddrs[1])
+	require.NoError(t, app.LSMBasketKeeper.SetParams(ctx, types.NewParams([]string{valAddrs[0].String()})))
+
+	// only share tokens of allowed validators whose records can be taken over are accepted
+	_, err := deposit(depositor, notAllowed)
+	require.ErrorIs(t, err, types.ErrValidatorNotAllowed)
+	_, err = deposit(depositor, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	require.ErrorIs(t, err, types.ErrNotShareToken)
+	half := sdk.NewCoin(allowed.Denom, allowed.Amount.QuoRaw(2))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, depositor, buyer, sdk.NewCoins(half)))
+	_, err = deposit(buyer, half)
+	require.ErrorIs(t, err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	// the first deposit takes over the record and mints at face value
+	minted, err := deposit(depositor, half)
+	require.NoError(t, err)
+	require.Equal(t, half.Amount, minted.Amount)
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, allowed.Denom)
+	require.NoError(t, err)
+	owner, err := app.StakingKeeper.GetTokenizeShareRecordOwner(ctx, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, app.LSMBasketKeeper.GetModuleAddress(), owner)
+	require.Equal(t, sdk.OneDec(), app.LSMBasketKeeper.GetExchangeRate(ctx))
+
+	// claimed rewards raise the exchange rate
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	rewards := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, rewards)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards.AmountOf(sdk.DefaultBondDenom).TruncateInt()))))
+	lsmbasket.EndBlocker(ctx, app.LSMBasketKeeper)
+	rate := app.LSMBasketKeeper.GetExchangeRate(ctx)
+	require.True(t, rate.GT(sdk.OneDec()))
+
+	// later deposits of the denom are accepted from any holder at the current rate
+	minted, err = deposit(buyer, half)
+	require.NoError(t, err)
+	require.True(t, minted.Amount.LT(half.Amount))
+	_, broken := keeper.AllInvariants(app.LSMBasketKeeper)(ctx)
+	require.False(t, broken)
+
+	// redemption burns basket tokens for delegations and the claimed rewards
+	bondBalance := app.BankKeeper.GetBalance(ctx, buyer, sdk.DefaultBondDenom)
+	res, err := msgServer.RedeemBasket(sdk.WrapSDKContext(ctx), types.NewMsgRedeemBasket(buyer, minted))
+	require.NoError(t, err)
+	require.True(t, res.Delegated.Amount.IsPositive())
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, buyer, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, res.Delegated.Amount, val.TokensFromShares(delegation.Shares).TruncateInt())
+	require.Equal(t, bondBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, res.Amount.AmountOf(sdk.DefaultBondDenom))), app.BankKeeper.GetBalance(ctx, buyer, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetBalance(ctx, buyer, types.BasketDenom).IsZero())
+
+	supply := app.BankKeeper.GetSupply(ctx, types.BasketDenom)
+	_, err = msgServer.RedeemBasket(sdk.WrapSDKContext(ctx), types.NewMsgRedeemBasket(depositor, supply.AddAmount(sdk.OneInt())))
+	require.ErrorIs(t, err, types.ErrAmountTooSmall)
+	_, err = msgServer.RedeemBasket(sdk.WrapSDKContext(ctx), types.NewMsgRedeemBasket(depositor, supply))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, app.LSMBasketKeeper.GetModuleAddress()).IsZero())
+	_, broken = keeper.AllInvariants(app.LSMBasketKeeper)(ctx)
+	require.False(t, broken)
+
+	// share tokens held without outstanding basket tokens break the backing
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, depositor, app.LSMBasketKeeper.GetModuleAddress(), sdk.NewCoins(notAllowed)))
+	_, broken = keeper.BackingInvariant(app.LSMBasketKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestExchangeRateIncludesPendingRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(200000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKS[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKS[1], 100, true)
+	tstaking.Delegate(addrs[0], valAddrs[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	app.LSMBasketKeeper.SetParams(ctx, types.NewParams([]string{valAddrs[0].String(), valAddrs[1].String()}))
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the depositor deposits the share tokens of one record on each validator
+	depositor := addrs[0]
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	msgServer := keeper.NewMsgServerImpl(app.LSMBasketKeeper)
+	var records []stakingtypes.TokenizeShareRecord
+	minted := sdk.NewCoin(types.BasketDenom, sdk.ZeroInt())
+	for _, valAddr := range valAddrs {
+		res, err := stakingMsgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+			DelegatorAddress:    depositor.String(),
+			ValidatorAddress:    valAddr.String(),
+			TokenizedShareOwner: depositor.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)),
+		})
+		require.NoError(t, err)
+		record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, res.Amount.Denom)
+		require.NoError(t, err)
+		records = append(records, record)
+
+		depositRes, err := msgServer.DepositShares(sdk.WrapSDKContext(ctx), types.NewMsgDepositShares(depositor, res.Amount))
+		require.NoError(t, err)
+		minted = minted.Add(depositRes.Amount)
+	}
+	require.Equal(t, sdk.OneDec(), app.LSMBasketKeeper.GetExchangeRate(ctx))
+
+	// rewards accrue on both records without being claimed
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(10)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	for _, valAddr := range valAddrs {
+		rewards := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}}
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), rewards)
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards.AmountOf(sdk.DefaultBondDenom).TruncateInt()))))
+	}
+
+	// the pending rewards of both records count towards the basket value
+	pending := sdk.ZeroInt()
+	for _, record := range records {
+		reward, err := app.DistrKeeper.GetTokenizeShareRecordReward(ctx, record.Id)
+		require.NoError(t, err)
+		require.True(t, reward.AmountOf(sdk.DefaultBondDenom).IsPositive())
+		pending = pending.Add(reward.AmountOf(sdk.DefaultBondDenom).TruncateInt())
+	}
+	holdingsValue := sdk.ZeroInt()
+	for _, holding := range app.LSMBasketKeeper.GetHoldings(ctx) {
+		holdingsValue = holdingsValue.Add(holding.Value)
+	}
+	require.Equal(t, holdingsValue.Add(pending), app.LSMBasketKeeper.GetTotalValue(ctx))
+	require.Equal(t, sdk.NewDecFromInt(holdingsValue.Add(pending)).QuoInt(minted.Amount), app.LSMBasketKeeper.GetExchangeRate(ctx))
+
+	// valuing the basket does not claim or change the pending rewards
+	for i, record := range records {
+		reward, err := app.DistrKeeper.GetTokenizeShareRecordReward(ctx, record.Id)
+		require.NoError(t, err)
+		require.True(t, reward.AmountOf(sdk.DefaultBondDenom).IsPositive(), "record %d", i)
+	}
+	require.Equal(t, holdingsValue.Add(pending), app.LSMBasketKeeper.GetTotalValue(ctx))
+
+	// redeeming all basket tokens pays out the rewards of both records
+	res, err := msgServer.RedeemBasket(sdk.WrapSDKContext(ctx), types.NewMsgRedeemBasket(depositor, minted))
+	require.NoError(t, err)
+	require.Equal(t, pending, res.Amount.AmountOf(sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, app.LSMBasketKeeper.GetModuleAddress()).IsZero())
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/lsmbasket/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the lsmbasket MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) DepositShares(goCtx context.Context, msg *types.MsgDepositShares) (*types.MsgDepositSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	minted, err := k.Keeper.DepositShares(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+
+	return &types.MsgDepositSharesResponse{Amount: minted}, nil
+}
+
+func (k msgServer) RedeemBasket(goCtx context.Context, msg *types.MsgRedeemBasket) (*types.MsgRedeemBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		return nil, err
+	}
+
+	delegated, sent, err := k.Keeper.RedeemBasket(ctx, redeemer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Redeemer),
+		),
+	)
+
+	return &types.MsgRedeemBasketResponse{Delegated: delegated, Amount: sent}, nil
+}
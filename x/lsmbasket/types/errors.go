@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/lsmbasket module sentinel errors
+var (
+	ErrValidatorNotAllowed = errorsmod.Register(ModuleName, 2, "validator is not allowed in the basket")
+	ErrNotShareToken       = errorsmod.Register(ModuleName, 3, "denom is not a share token")
+	ErrInvalidBasketDenom  = errorsmod.Register(ModuleName, 4, "invalid basket denom")
+	ErrAmountTooSmall      = errorsmod.Register(ModuleName, 5, "amount is too small")
+	ErrBasketNotBacked     = errorsmod.Register(ModuleName, 6, "basket tokens are not backed by any value")
+)
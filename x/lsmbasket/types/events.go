@@ -0,0 +1,16 @@
+package types
+
+// lsmbasket module event types
+const (
+	EventTypeDepositShares = "deposit_shares"
+	EventTypeRedeemBasket  = "redeem_basket"
+
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyRedeemer  = "redeemer"
+	AttributeKeyMinted    = "minted"
+	AttributeKeyBurned    = "burned"
+	AttributeKeyRecordId  = "record_id"
+	AttributeKeyDelegated = "delegated"
+
+	AttributeValueCategory = ModuleName
+)
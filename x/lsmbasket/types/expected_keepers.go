@@ -0,0 +1,51 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) types.ModuleAccountI
+}
+
+// BankKeeper defines the expected interface needed to mint, burn and move basket balances.
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// StakingKeeper expected staking keeper (noalias)
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetLiquidValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetLiquidDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
+	GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (stakingtypes.TokenizeShareRecord, error)
+	GetTokenizeShareRecordOwner(ctx sdk.Context, recordId uint64) (sdk.AccAddress, error)
+	GetTokenizeShareRecordsByOwnerFrom(ctx sdk.Context, owner sdk.AccAddress, startId uint64, limit uint64) ([]stakingtypes.TokenizeShareRecord, uint64)
+}
+
+// StakingMsgServer defines the staking messages the basket executes to take
+// over tokenize share records and to redeem share tokens (noalias)
+type StakingMsgServer interface {
+	TransferTokenizeShareRecord(context.Context, *stakingtypes.MsgTransferTokenizeShareRecord) (*stakingtypes.MsgTransferTokenizeShareRecordResponse, error)
+	RedeemTokens(context.Context, *stakingtypes.MsgRedeemTokensforShares) (*stakingtypes.MsgRedeemTokensforSharesResponse, error)
+}
+
+// DistributionKeeper defines the expected distribution keeper used to claim
+// the rewards of tokenize share records (noalias)
+type DistributionKeeper interface {
+	GetTokenizeShareRecordReward(ctx sdk.Context, recordId uint64) (sdk.DecCoins, error)
+	WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress, recordId uint64) (sdk.Coins, error)
+}
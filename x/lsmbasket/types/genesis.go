@@ -0,0 +1,19 @@
+package types
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, rewardClaimCursor uint64) *GenesisState {
+	return &GenesisState{
+		Params:            params,
+		RewardClaimCursor: rewardClaimCursor,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the lsmbasket module
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0)
+}
+
+// ValidateGenesis validates the genesis state of lsmbasket genesis input
+func ValidateGenesis(gs *GenesisState) error {
+	return gs.Params.ValidateBasic()
+}
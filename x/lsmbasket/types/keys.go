@@ -0,0 +1,30 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "lsmbasket"
+
+	// StoreKey is the store key string for lsmbasket
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for lsmbasket
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for lsmbasket
+	QuerierRoute = ModuleName
+
+	// BasketDenom is the denom of the fungible basket token
+	BasketDenom = ModuleName
+)
+
+// MaxRewardClaimsPerBlock is the maximum number of records owned by the basket
+// whose rewards are claimed in a single block
+const MaxRewardClaimsPerBlock = 100
+
+// Keys for lsmbasket store
+// Items are stored with the following key: values
+//
+// - 0x01: RewardClaimCursor
+var (
+	RewardClaimCursorKey = []byte{0x01} // key for the id of the next record whose rewards are claimed
+)
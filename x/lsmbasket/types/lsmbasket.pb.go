@@ -0,0 +1,561 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lsmbasket/v1beta1/lsmbasket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of params for the lsmbasket module.
+type Params struct {
+	// allowed_validators are the operator addresses of the validators whose
+	// share tokens can be deposited into the basket
+	AllowedValidators []string `protobuf:"bytes,1,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators,omitempty" yaml:"allowed_validators"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37889141050ac3d8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedValidators() []string {
+	if m != nil {
+		return m.AllowedValidators
+	}
+	return nil
+}
+
+// BasketHolding is a balance of the basket together with its value in bond
+// denom tokens.
+type BasketHolding struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// value is the amount of bond denom tokens the balance is worth; share
+	// tokens are valued at the tokens of the delegation of their record
+	Value github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+}
+
+func (m *BasketHolding) Reset()         { *m = BasketHolding{} }
+func (m *BasketHolding) String() string { return proto.CompactTextString(m) }
+func (*BasketHolding) ProtoMessage()    {}
+func (*BasketHolding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37889141050ac3d8, []int{1}
+}
+func (m *BasketHolding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketHolding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketHolding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasketHolding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketHolding.Merge(m, src)
+}
+func (m *BasketHolding) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketHolding) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketHolding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasketHolding proto.InternalMessageInfo
+
+func (m *BasketHolding) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "liquidstaking.lsmbasket.v1beta1.Params")
+	proto.RegisterType((*BasketHolding)(nil), "liquidstaking.lsmbasket.v1beta1.BasketHolding")
+}
+
+func init() { proto.RegisterFile("lsmbasket/v1beta1/lsmbasket.proto", fileDescriptor_37889141050ac3d8) }
+
+var fileDescriptor_37889141050ac3d8 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xbf, 0x4f, 0xc2, 0x40,
+	0x14, 0xee, 0x29, 0x92, 0x50, 0xe3, 0x60, 0xe3, 0x00, 0x24, 0xb6, 0xd8, 0xc1, 0xb0, 0xb4, 0x0d,
+	0x3a, 0x98, 0x10, 0x17, 0xeb, 0x22, 0x89, 0x83, 0xe9, 0xe0, 0xa0, 0x26, 0xe4, 0xfa, 0x23, 0xf5,
+	0xc2, 0xf5, 0x1e, 0x70, 0x57, 0x94, 0xff, 0xc2, 0xd1, 0x91, 0xf8, 0x37, 0xf8, 0x47, 0x30, 0x12,
+	0x27, 0xe3, 0x40, 0x0c, 0x2c, 0xce, 0xfe, 0x05, 0x06, 0xee, 0x04, 0x13, 0xa7, 0xbb, 0x7b, 0xdf,
+	0xfb, 0xbe, 0xf7, 0xbe, 0xfb, 0xf4, 0x03, 0xca, 0xb3, 0x10, 0xf3, 0x4e, 0x22, 0xbc, 0x41, 0x23,
+	0x4c, 0x04, 0x6e, 0x78, 0xab, 0x8a, 0xdb, 0xed, 0x83, 0x00, 0xc3, 0xa2, 0xa4, 0x97, 0x93, 0x98,
+	0x0b, 0xdc, 0x21, 0x2c, 0x75, 0xd7, 0xb0, 0x22, 0x54, 0xf7, 0x52, 0x48, 0x61, 0xd9, 0xeb, 0x2d,
+	0x6e, 0x92, 0x56, 0x35, 0x23, 0xe0, 0x19, 0x70, 0x2f, 0xc4, 0x3c, 0x59, 0x69, 0x47, 0x40, 0x98,
+	0xc2, 0x2b, 0x12, 0x6f, 0x4b, 0xa2, 0x7c, 0x48, 0xc8, 0xbe, 0xd3, 0x8b, 0x57, 0xb8, 0x8f, 0x33,
+	0x6e, 0x5c, 0xea, 0x06, 0xa6, 0x14, 0x1e, 0x92, 0xb8, 0x3d, 0xc0, 0x94, 0xc4, 0x58, 0x40, 0x9f,
+	0x97, 0x51, 0x6d, 0xb3, 0x5e, 0xf2, 0xf7, 0xbf, 0xa7, 0x56, 0x65, 0x88, 0x33, 0xda, 0xb4, 0xff,
+	0xf7, 0xd8, 0xc1, 0xae, 0x2a, 0x5e, 0xaf, 0x6a, 0xcd, 0xc2, 0xf3, 0xc8, 0xd2, 0xec, 0x17, 0xa4,
+	0xef, 0xf8, 0x4b, 0x07, 0x17, 0x40, 0x63, 0xc2, 0x52, 0xe3, 0x44, 0x2f, 0xe2, 0x0c, 0x72, 0x26,
+	0xca, 0xa8, 0x86, 0xea, 0xdb, 0x47, 0x15, 0x57, 0xad, 0xb3, 0xd8, 0xfd, 0xd7, 0xa6, 0x7b, 0x0e,
+	0x84, 0xf9, 0x85, 0xf1, 0xd4, 0xd2, 0x02, 0xd5, 0x6e, 0x04, 0xfa, 0xd6, 0x00, 0xd3, 0x3c, 0x29,
+	0x6f, 0xd4, 0x50, 0xbd, 0xe4, 0x9f, 0x2e, 0xc0, 0x8f, 0xa9, 0x75, 0x98, 0x12, 0x71, 0x9f, 0x87,
+	0x6e, 0x04, 0x99, 0x32, 0xa6, 0x0e, 0x87, 0xc7, 0x1d, 0x4f, 0x0c, 0xbb, 0x09, 0x77, 0x5b, 0x4c,
+	0xbc, 0xbd, 0x3a, 0xba, 0x1a, 0xd4, 0x62, 0x22, 0x90, 0x52, 0xcd, 0xc2, 0xd7, 0xc8, 0xd2, 0xfc,
+	0xdb, 0xf1, 0xcc, 0x44, 0x93, 0x99, 0x89, 0x3e, 0x67, 0x26, 0x7a, 0x9a, 0x9b, 0xda, 0x64, 0x6e,
+	0x6a, 0xef, 0x73, 0x53, 0xbb, 0x39, 0xfb, 0x23, 0x4e, 0x7a, 0x34, 0xe7, 0x04, 0x18, 0x61, 0x91,
+	0x27, 0x53, 0x22, 0x62, 0xe8, 0xa8, 0xa4, 0x9c, 0x0c, 0xe2, 0x9c, 0x26, 0xde, 0xe3, 0x3a, 0x51,
+	0x39, 0x3b, 0x2c, 0x2e, 0xbf, 0xf9, 0xf8, 0x67, 0x00, 0xf5, 0xfc, 0xe5, 0x02, 0xfd, 0x01, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedValidators) > 0 {
+		for iNdEx := len(m.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValidators[iNdEx])
+			copy(dAtA[i:], m.AllowedValidators[iNdEx])
+			i = encodeVarintLsmbasket(dAtA, i, uint64(len(m.AllowedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BasketHolding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketHolding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLsmbasket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLsmbasket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLsmbasket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLsmbasket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedValidators) > 0 {
+		for _, s := range m.AllowedValidators {
+			l = len(s)
+			n += 1 + l + sovLsmbasket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BasketHolding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovLsmbasket(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovLsmbasket(uint64(l))
+	return n
+}
+
+func sovLsmbasket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLsmbasket(x uint64) (n int) {
+	return sovLsmbasket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLsmbasket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsmbasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidators = append(m.AllowedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLsmbasket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasketHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLsmbasket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsmbasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsmbasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLsmbasket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLsmbasket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLsmbasket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLsmbasket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLsmbasket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLsmbasket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLsmbasket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLsmbasket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLsmbasket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLsmbasket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLsmbasket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLsmbasket = fmt.Errorf("proto: unexpected end of group")
+)
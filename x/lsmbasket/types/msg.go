@@ -0,0 +1,101 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// lsmbasket message types
+const (
+	TypeMsgDepositShares = "deposit_shares"
+	TypeMsgRedeemBasket  = "redeem_basket"
+)
+
+// Verify interface at compile time
+var (
+	_ sdk.Msg = &MsgDepositShares{}
+	_ sdk.Msg = &MsgRedeemBasket{}
+)
+
+// NewMsgDepositShares creates a new MsgDepositShares instance
+func NewMsgDepositShares(depositor sdk.AccAddress, amount sdk.Coin) *MsgDepositShares {
+	return &MsgDepositShares{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+func (msg MsgDepositShares) Route() string { return RouterKey }
+func (msg MsgDepositShares) Type() string  { return TypeMsgDepositShares }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDepositShares) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgDepositShares) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDepositShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+	if msg.Amount.Denom == BasketDenom {
+		return ErrNotShareToken.Wrap(msg.Amount.Denom)
+	}
+
+	return nil
+}
+
+// NewMsgRedeemBasket creates a new MsgRedeemBasket instance
+func NewMsgRedeemBasket(redeemer sdk.AccAddress, amount sdk.Coin) *MsgRedeemBasket {
+	return &MsgRedeemBasket{
+		Redeemer: redeemer.String(),
+		Amount:   amount,
+	}
+}
+
+func (msg MsgRedeemBasket) Route() string { return RouterKey }
+func (msg MsgRedeemBasket) Type() string  { return TypeMsgRedeemBasket }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemBasket) GetSigners() []sdk.AccAddress {
+	redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{redeemer}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemBasket) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRedeemBasket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Redeemer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid redeemer address: %s", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+	if msg.Amount.Denom != BasketDenom {
+		return ErrInvalidBasketDenom.Wrapf("got %s, expected %s", msg.Amount.Denom, BasketDenom)
+	}
+
+	return nil
+}
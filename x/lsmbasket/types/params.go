@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys
+var (
+	ParamStoreKeyAllowedValidators = []byte("allowedvalidators")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(allowedValidators []string) Params {
+	return Params{
+		AllowedValidators: allowedValidators,
+	}
+}
+
+// DefaultParams returns default lsmbasket parameters, which allow no validators
+func DefaultParams() Params {
+	return NewParams([]string{})
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedValidators, &p.AllowedValidators, validateAllowedValidators),
+	}
+}
+
+// ValidateBasic performs basic validation on lsmbasket parameters.
+func (p Params) ValidateBasic() error {
+	return validateAllowedValidators(p.AllowedValidators)
+}
+
+// IsValidatorAllowed returns true if share tokens of the validator can be deposited
+func (p Params) IsValidatorAllowed(valAddr sdk.ValAddress) bool {
+	for _, allowed := range p.AllowedValidators {
+		if allowed == valAddr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAllowedValidators(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, valAddr := range v {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return fmt.Errorf("invalid allowed validator %s: %w", valAddr, err)
+		}
+		if seen[valAddr] {
+			return fmt.Errorf("duplicate allowed validator: %s", valAddr)
+		}
+		seen[valAddr] = true
+	}
+
+	return nil
+}
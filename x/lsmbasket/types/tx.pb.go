@@ -0,0 +1,1131 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lsmbasket/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgDepositShares defines a deposit of share tokens into the basket.
+type MsgDepositShares struct {
+	Depositor string     `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDepositShares) Reset()         { *m = MsgDepositShares{} }
+func (m *MsgDepositShares) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositShares) ProtoMessage()    {}
+func (*MsgDepositShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc99285d5a38b5ee, []int{0}
+}
+func (m *MsgDepositShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositShares.Merge(m, src)
+}
+func (m *MsgDepositShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositShares proto.InternalMessageInfo
+
+// MsgDepositSharesResponse defines the Msg/DepositShares response type.
+type MsgDepositSharesResponse struct {
+	// amount is the amount of basket tokens minted to the depositor
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDepositSharesResponse) Reset()         { *m = MsgDepositSharesResponse{} }
+func (m *MsgDepositSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSharesResponse) ProtoMessage()    {}
+func (*MsgDepositSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc99285d5a38b5ee, []int{1}
+}
+func (m *MsgDepositSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSharesResponse.Merge(m, src)
+}
+func (m *MsgDepositSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositSharesResponse proto.InternalMessageInfo
+
+func (m *MsgDepositSharesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgRedeemBasket defines a redemption of basket tokens.
+type MsgRedeemBasket struct {
+	Redeemer string     `protobuf:"bytes,1,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemBasket) Reset()         { *m = MsgRedeemBasket{} }
+func (m *MsgRedeemBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemBasket) ProtoMessage()    {}
+func (*MsgRedeemBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc99285d5a38b5ee, []int{2}
+}
+func (m *MsgRedeemBasket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemBasket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemBasket.Merge(m, src)
+}
+func (m *MsgRedeemBasket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemBasket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemBasket proto.InternalMessageInfo
+
+// MsgRedeemBasketResponse defines the Msg/RedeemBasket response type.
+type MsgRedeemBasketResponse struct {
+	// delegated is the amount of tokens delegated to the redeemer from the
+	// redeemed share tokens
+	Delegated types.Coin `protobuf:"bytes,1,opt,name=delegated,proto3" json:"delegated"`
+	// amount is the other balances of the basket sent to the redeemer
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgRedeemBasketResponse) Reset()         { *m = MsgRedeemBasketResponse{} }
+func (m *MsgRedeemBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemBasketResponse) ProtoMessage()    {}
+func (*MsgRedeemBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc99285d5a38b5ee, []int{3}
+}
+func (m *MsgRedeemBasketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemBasketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemBasketResponse.Merge(m, src)
+}
+func (m *MsgRedeemBasketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemBasketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemBasketResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemBasketResponse) GetDelegated() types.Coin {
+	if m != nil {
+		return m.Delegated
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRedeemBasketResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgDepositShares)(nil), "liquidstaking.lsmbasket.v1beta1.MsgDepositShares")
+	proto.RegisterType((*MsgDepositSharesResponse)(nil), "liquidstaking.lsmbasket.v1beta1.MsgDepositSharesResponse")
+	proto.RegisterType((*MsgRedeemBasket)(nil), "liquidstaking.lsmbasket.v1beta1.MsgRedeemBasket")
+	proto.RegisterType((*MsgRedeemBasketResponse)(nil), "liquidstaking.lsmbasket.v1beta1.MsgRedeemBasketResponse")
+}
+
+func init() { proto.RegisterFile("lsmbasket/v1beta1/tx.proto", fileDescriptor_bc99285d5a38b5ee) }
+
+var fileDescriptor_bc99285d5a38b5ee = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x2d, 0xaa, 0xe8, 0x01, 0x02, 0x59, 0x85, 0xa6, 0x1e, 0xec, 0x2a, 0x53, 0x84,
+	0x14, 0x5f, 0x53, 0x10, 0xbf, 0x24, 0x86, 0x06, 0xd6, 0x2c, 0xc9, 0x06, 0x03, 0xf2, 0x8f, 0xa7,
+	0xeb, 0x29, 0xb6, 0x2f, 0xf5, 0x3b, 0x57, 0x2d, 0x12, 0x12, 0x62, 0x62, 0x64, 0x63, 0x60, 0xe9,
+	0xcc, 0xc4, 0xc0, 0xca, 0xde, 0xb1, 0x62, 0x62, 0x02, 0x94, 0x0c, 0xf0, 0x67, 0x20, 0xdb, 0x17,
+	0xc7, 0xcd, 0x40, 0x93, 0x4e, 0xe7, 0xf3, 0xfb, 0x7e, 0xde, 0x7b, 0xdf, 0x77, 0x67, 0x53, 0x2b,
+	0xc2, 0xd8, 0xf7, 0x70, 0x08, 0x8a, 0x1d, 0x76, 0x7c, 0x50, 0x5e, 0x87, 0xa9, 0x23, 0x77, 0x94,
+	0x4a, 0x25, 0x4d, 0x27, 0x12, 0x07, 0x99, 0x08, 0x51, 0x79, 0x43, 0x91, 0x70, 0xb7, 0x52, 0xba,
+	0x5a, 0x69, 0x6d, 0x70, 0xc9, 0x65, 0xa1, 0x65, 0xf9, 0x53, 0x89, 0x59, 0x76, 0x20, 0x31, 0x96,
+	0xc8, 0x7c, 0x0f, 0xa1, 0x4a, 0x1a, 0x48, 0x91, 0xe8, 0xf8, 0x56, 0x19, 0x7f, 0x55, 0x82, 0xe5,
+	0x46, 0x87, 0x36, 0x35, 0x1a, 0x23, 0x67, 0x87, 0x9d, 0x7c, 0x29, 0x03, 0xcd, 0x4f, 0x84, 0xde,
+	0xea, 0x21, 0x7f, 0x0e, 0x23, 0x89, 0x42, 0x0d, 0xf6, 0xbd, 0x14, 0xd0, 0x7c, 0x40, 0xd7, 0xc3,
+	0xf2, 0x85, 0x4c, 0x1b, 0x64, 0x9b, 0xb4, 0xd6, 0xbb, 0x8d, 0xef, 0x5f, 0xdb, 0x1b, 0x3a, 0xe5,
+	0x5e, 0x18, 0xa6, 0x80, 0x38, 0x50, 0xa9, 0x48, 0x78, 0x7f, 0x26, 0x35, 0x1f, 0xd2, 0x35, 0x2f,
+	0x96, 0x59, 0xa2, 0x1a, 0x2b, 0xdb, 0xa4, 0x75, 0x6d, 0x77, 0xcb, 0xd5, 0x44, 0xde, 0xf1, 0xd4,
+	0x9c, 0xfb, 0x4c, 0x8a, 0xa4, 0x7b, 0xe5, 0xf4, 0xa7, 0x63, 0xf4, 0xb5, 0xfc, 0xc9, 0x9d, 0xf7,
+	0x27, 0x8e, 0xf1, 0xf7, 0xc4, 0x31, 0xde, 0xfd, 0xf9, 0x72, 0x77, 0x96, 0xb0, 0x39, 0xa0, 0x8d,
+	0xf9, 0xe6, 0xfa, 0x80, 0x23, 0x99, 0x20, 0xd4, 0x8a, 0x91, 0xa5, 0x8a, 0x35, 0x3f, 0x12, 0x7a,
+	0xb3, 0x87, 0xbc, 0x0f, 0x21, 0x40, 0xdc, 0x2d, 0x06, 0x6f, 0xde, 0xa7, 0x57, 0xd3, 0x62, 0x0f,
+	0x17, 0x1b, 0xae, 0x94, 0x97, 0xf7, 0x7b, 0xbb, 0xee, 0xb7, 0xca, 0xd7, 0xfc, 0x46, 0xe8, 0xe6,
+	0x5c, 0x67, 0x95, 0xdd, 0xa7, 0xf9, 0x99, 0x44, 0xc0, 0x3d, 0x05, 0xe1, 0xa2, 0x8e, 0x67, 0x84,
+	0x19, 0xd4, 0x5a, 0x5d, 0xfd, 0x3f, 0xbb, 0x93, 0xb3, 0x9f, 0x7f, 0x39, 0x2d, 0x2e, 0xd4, 0x7e,
+	0xe6, 0xbb, 0x81, 0x8c, 0xf5, 0x65, 0xd2, 0x4b, 0x1b, 0xc3, 0x21, 0x53, 0xc7, 0x23, 0xc0, 0x02,
+	0xc0, 0xa9, 0xad, 0xdd, 0xb7, 0x2b, 0x74, 0xb5, 0x87, 0xdc, 0x7c, 0x43, 0x6f, 0x9c, 0xbf, 0x50,
+	0x1d, 0xf7, 0x82, 0x1b, 0xef, 0xce, 0x1f, 0xb3, 0xf5, 0x78, 0x69, 0xa4, 0x1a, 0xd5, 0x6b, 0x7a,
+	0xfd, 0xdc, 0xe1, 0xee, 0x2c, 0x92, 0xaa, 0x4e, 0x58, 0x8f, 0x96, 0x25, 0xa6, 0xb5, 0xbb, 0x2f,
+	0x4f, 0xc7, 0x36, 0x39, 0x1b, 0xdb, 0xe4, 0xf7, 0xd8, 0x26, 0x1f, 0x26, 0xb6, 0x71, 0x36, 0xb1,
+	0x8d, 0x1f, 0x13, 0xdb, 0x78, 0xb1, 0x57, 0x1b, 0xa7, 0x38, 0x88, 0x32, 0x14, 0x32, 0x11, 0x49,
+	0xc0, 0xca, 0x4a, 0x42, 0x1d, 0xb7, 0x75, 0xb5, 0x76, 0x2c, 0xc3, 0x2c, 0x02, 0x76, 0xc4, 0x66,
+	0xbf, 0x90, 0x62, 0xda, 0xfe, 0x5a, 0xf1, 0xcd, 0xde, 0xfb, 0x37, 0x00, 0xfd, 0x1c, 0xe7, 0x93,
+	0x5c, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// DepositShares defines a method to deposit share tokens into the basket in
+	// exchange for basket tokens
+	DepositShares(ctx context.Context, in *MsgDepositShares, opts ...grpc.CallOption) (*MsgDepositSharesResponse, error)
+	// RedeemBasket defines a method to burn basket tokens for a pro-rata part of
+	// the basket, with the share tokens redeemed into delegations
+	RedeemBasket(ctx context.Context, in *MsgRedeemBasket, opts ...grpc.CallOption) (*MsgRedeemBasketResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) DepositShares(ctx context.Context, in *MsgDepositShares, opts ...grpc.CallOption) (*MsgDepositSharesResponse, error) {
+	out := new(MsgDepositSharesResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.lsmbasket.v1beta1.Msg/DepositShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemBasket(ctx context.Context, in *MsgRedeemBasket, opts ...grpc.CallOption) (*MsgRedeemBasketResponse, error) {
+	out := new(MsgRedeemBasketResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.lsmbasket.v1beta1.Msg/RedeemBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// DepositShares defines a method to deposit share tokens into the basket in
+	// exchange for basket tokens
+	DepositShares(context.Context, *MsgDepositShares) (*MsgDepositSharesResponse, error)
+	// RedeemBasket defines a method to burn basket tokens for a pro-rata part of
+	// the basket, with the share tokens redeemed into delegations
+	RedeemBasket(context.Context, *MsgRedeemBasket) (*MsgRedeemBasketResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) DepositShares(ctx context.Context, req *MsgDepositShares) (*MsgDepositSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositShares not implemented")
+}
+func (*UnimplementedMsgServer) RedeemBasket(ctx context.Context, req *MsgRedeemBasket) (*MsgRedeemBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemBasket not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_DepositShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.lsmbasket.v1beta1.Msg/DepositShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositShares(ctx, req.(*MsgDepositShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemBasket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.lsmbasket.v1beta1.Msg/RedeemBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemBasket(ctx, req.(*MsgRedeemBasket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "liquidstaking.lsmbasket.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DepositShares",
+			Handler:    _Msg_DepositShares_Handler,
+		},
+		{
+			MethodName: "RedeemBasket",
+			Handler:    _Msg_RedeemBasket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lsmbasket/v1beta1/tx.proto",
+}
+
+func (m *MsgDepositShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Delegated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDepositShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDepositShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemBasket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemBasket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemBasket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
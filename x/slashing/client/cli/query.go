@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMissedBlocks(),
+		GetCmdQuerySlashHistory(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +111,99 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryMissedBlocks implements the command to query the missed blocks of
+// a validator.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [validator-addr]",
+		Short: "Query the missed blocks of a validator in the signed blocks window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Use a validator's operator or consensus address to query its missed blocks
+in the signed blocks window, as a bitmap and as a list of missed indexes:
+
+$ <appd> query slashing missed-blocks %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ <appd> query slashing missed-blocks %s1nrqsld3aw6lh6t082frdqc84uwxn0t958c
+`,
+				bech32PrefixValAddr, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMissedBlocksRequest{ValidatorAddress: args[0]}
+			res, err := queryClient.MissedBlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySlashHistory implements the command to query the slash history of
+// a validator.
+func GetCmdQuerySlashHistory() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "slash-history [validator-addr]",
+		Short: "Query the slash history of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the slash records of a validator, oldest first, including the tokens
+burned from liquid shares, validator bond shares and ordinary delegations:
+
+$ <appd> query slashing slash-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySlashHistoryRequest{ValidatorAddress: valAddr.String(), Pagination: pageReq}
+			res, err := queryClient.SlashHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash history")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
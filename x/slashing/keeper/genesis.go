@@ -38,7 +38,13 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
-	keeper.SetParams(ctx, data.Params)
+	if err := keeper.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, record := range data.SlashRecords {
+		keeper.AppendSlashRecord(ctx, record)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -65,5 +71,11 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	slashRecords := make([]types.SlashRecord, 0)
+	keeper.IterateSlashRecords(ctx, func(record types.SlashRecord) (stop bool) {
+		slashRecords = append(slashRecords, record)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, slashRecords)
 }
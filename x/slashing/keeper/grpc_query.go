@@ -72,3 +72,84 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the validator can be given by its operator address or consensus address
+	consAddr, err := sdk.ConsAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		valAddr, valErr := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if valErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid consensus or operator address %s", req.ValidatorAddress)
+		}
+
+		validator := k.sk.Validator(ctx, valAddr)
+		if validator == nil {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+		}
+
+		consAddr, err = validator.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ValidatorAddress)
+	}
+
+	bitmap, missedIndexes := k.GetValidatorMissedBlockBitmap(ctx, consAddr)
+
+	return &types.QueryMissedBlocksResponse{
+		ConsAddress:         consAddr.String(),
+		SignedBlocksWindow:  k.SignedBlocksWindow(ctx),
+		IndexOffset:         signingInfo.IndexOffset,
+		MissedBlocksCounter: signingInfo.MissedBlocksCounter,
+		MissedBlocksBitmap:  bitmap,
+		MissedIndexes:       missedIndexes,
+	}, nil
+}
+
+func (k Keeper) SlashHistory(c context.Context, req *types.QuerySlashHistoryRequest) (*types.QuerySlashHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var records []types.SlashRecord
+
+	recordStore := prefix.NewStore(store, types.SlashRecordPrefixKey(valAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.SlashRecord
+		err := k.cdc.Unmarshal(value, &record)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySlashHistoryResponse{SlashRecords: records, Pagination: pageRes}, nil
+}
@@ -105,6 +105,91 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ValidatorAddress: ""})
+	suite.Error(err)
+
+	_, err = queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ValidatorAddress: "invalid"})
+	suite.Error(err)
+
+	// a validator is queried by its consensus address
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2, false)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 9, true)
+
+	res, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ValidatorAddress: consAddr.String()})
+	suite.Require().NoError(err)
+	suite.Equal(consAddr.String(), res.ConsAddress)
+	suite.Equal(app.SlashingKeeper.SignedBlocksWindow(ctx), res.SignedBlocksWindow)
+	suite.Equal(int64(3), res.IndexOffset)
+	suite.Equal(int64(10), res.MissedBlocksCounter)
+	suite.Equal([]int64{1, 9}, res.MissedIndexes)
+	suite.Require().Len(res.MissedBlocksBitmap, int((res.SignedBlocksWindow+7)/8))
+	suite.Equal(byte(0x02), res.MissedBlocksBitmap[0])
+	suite.Equal(byte(0x02), res.MissedBlocksBitmap[1])
+
+	// a validator is queried by its operator address
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valConsAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, valConsAddr,
+		types.NewValidatorSigningInfo(valConsAddr, 0, 0, time.Unix(0, 0), false, 0))
+
+	res, err = queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ValidatorAddress: validator.OperatorAddress})
+	suite.Require().NoError(err)
+	suite.Equal(valConsAddr.String(), res.ConsAddress)
+	suite.Empty(res.MissedIndexes)
+
+	// a validator without signing info is not found
+	_, err = queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ValidatorAddress: sdk.ValAddress(suite.addrDels[1]).String()})
+	suite.Error(err)
+}
+
+func (suite *SlashingTestSuite) TestGRPCSlashHistory() {
+	queryClient := suite.queryClient
+	valAddr := sdk.ValAddress(suite.addrDels[0])
+
+	_, err := queryClient.SlashHistory(gocontext.Background(), &types.QuerySlashHistoryRequest{ValidatorAddress: ""})
+	suite.Error(err)
+
+	for height := int64(1); height <= 3; height++ {
+		suite.app.SlashingKeeper.AppendSlashRecord(suite.ctx, types.SlashRecord{
+			ValidatorAddress:          valAddr.String(),
+			Infraction:                types.InfractionDowntime,
+			Height:                    height,
+			SlashFraction:             sdk.NewDecWithPrec(1, 2),
+			TokensBurned:              sdk.NewInt(height),
+			LiquidTokensBurned:        sdk.ZeroInt(),
+			ValidatorBondTokensBurned: sdk.ZeroInt(),
+			DelegationTokensBurned:    sdk.NewInt(height),
+		})
+	}
+	records := suite.app.SlashingKeeper.GetValidatorSlashRecords(suite.ctx, valAddr)
+
+	// verify all values are returned without pagination
+	historyResp, err := queryClient.SlashHistory(gocontext.Background(),
+		&types.QuerySlashHistoryRequest{ValidatorAddress: valAddr.String()})
+	suite.NoError(err)
+	suite.Equal(records, historyResp.SlashRecords)
+
+	historyResp, err = queryClient.SlashHistory(gocontext.Background(),
+		&types.QuerySlashHistoryRequest{ValidatorAddress: valAddr.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.NoError(err)
+	suite.Len(historyResp.SlashRecords, 1)
+	suite.Equal(records[0], historyResp.SlashRecords[0])
+	suite.NotNil(historyResp.Pagination.NextKey)
+	suite.Equal(uint64(3), historyResp.Pagination.Total)
+
+	// the history of other validators is not returned
+	historyResp, err = queryClient.SlashHistory(gocontext.Background(),
+		&types.QuerySlashHistoryRequest{ValidatorAddress: sdk.ValAddress(suite.addrDels[1]).String()})
+	suite.NoError(err)
+	suite.Empty(historyResp.SlashRecords)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -8,11 +8,17 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/testslashing"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // Test a new validator entering the validator set
@@ -220,3 +226,175 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, sdkstaking.Unbonding, true)
 }
+
+// Test that unjailing requires the validator bond to meet the minimum validator bond
+func TestUnjailMinValidatorBond(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// require a validator bond of 1 token to unjail
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinValidatorBond = sdk.OneDec()
+	params.MinValidatorBondGracePeriod = 10
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// jail the validator with its jail period already concluded
+	app.StakingKeeper.Jail(ctx, consAddr)
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+	err := app.SlashingKeeper.Unjail(ctx, addr)
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+	tstaking.CheckValidator(addr, -1, true)
+
+	// bond the operator's delegation as validator bond
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: sdk.AccAddress(addr).String(),
+		ValidatorAddress: addr.String(),
+	})
+	require.NoError(t, err)
+
+	// halve the tokens backing the validator bond shares; the minimum is measured
+	// in tokens, so a minimum below the shares but above the tokens is not met
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 100, sdk.NewDecWithPrec(5, 1))
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addr)
+	require.True(t, found)
+	bondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 50)
+	require.Equal(t, sdk.NewDecFromInt(bondTokens), validator.ValidatorBondTokens())
+	require.True(t, validator.TotalValidatorBondShares.GT(sdk.NewDecFromInt(bondTokens)))
+
+	params.MinValidatorBond = sdk.NewDecFromInt(bondTokens).Add(sdk.OneDec())
+	app.StakingKeeper.SetParams(ctx, params)
+	err = app.SlashingKeeper.Unjail(ctx, addr)
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+	tstaking.CheckValidator(addr, -1, true)
+
+	params.MinValidatorBond = sdk.NewDecFromInt(bondTokens)
+	app.StakingKeeper.SetParams(ctx, params)
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	tstaking.CheckValidator(addr, -1, false)
+}
+
+// Test that the tokenize share records of a slashed and tombstoned validator are
+// reported and redelegated to the tombstone fallback validator
+func TestTombstoneTokenizeShareRecords(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	addr, fallbackAddr := valAddrs[0], valAddrs[1]
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, pks[0], 100, true)
+	tstaking.CreateValidatorWithValPower(fallbackAddr, pks[1], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// tokenize a delegation to the first validator
+	delAddr := addrDels[2]
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tstaking.Delegate(delAddr, addr, tokens)
+	tstaking.TokenizeShares(delAddr, addr, sdk.NewCoin(sdk.DefaultBondDenom, tokens), delAddr, true)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	// the records are reported when the validator is slashed
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.SlashingKeeper.Slash(ctx, consAddr, sdk.NewDecWithPrec(1, 1), 110, ctx.BlockHeight())
+	requireEventAttribute(t, ctx, stakingtypes.EventTypeTokenizeShareRecordsSlashed, stakingtypes.AttributeKeyShareRecordIds, "1")
+
+	// set the fallback validator and tombstone the first validator
+	params := app.StakingKeeper.GetParams(ctx)
+	params.TombstoneFallbackValidator = fallbackAddr.String()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.SlashingKeeper.Jail(ctx, consAddr)
+	app.SlashingKeeper.Tombstone(ctx, consAddr)
+	requireEventAttribute(t, ctx, stakingtypes.EventTypeTokenizeShareRecordsTombstoned, stakingtypes.AttributeKeyShareRecordIds, "1")
+	_, queued := app.StakingKeeper.GetTombstonedValidatorQueueCursor(ctx, addr)
+	require.True(t, queued)
+
+	// the queued records are redelegated at the end of the block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	requireEventAttribute(t, ctx, stakingtypes.EventTypeRedelegateTokenizeShareRecord, stakingtypes.AttributeKeyDstValidator, fallbackAddr.String())
+	_, queued = app.StakingKeeper.GetTombstonedValidatorQueueCursor(ctx, addr)
+	require.False(t, queued)
+
+	// the record and its liquid shares moved to the fallback validator while
+	// the share token denom still names the tombstoned validator
+	denom := record.GetShareTokenDenom()
+	record, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, fallbackAddr.String(), record.Validator)
+	require.Equal(t, denom, record.GetShareTokenDenom())
+
+	byDenom, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, record, byDenom)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, addr))
+	require.Equal(t, []stakingtypes.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, fallbackAddr))
+
+	_, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), addr)
+	require.False(t, found)
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), fallbackAddr)
+	require.True(t, found)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addr)
+	require.True(t, found)
+	require.True(t, validator.TotalLiquidShares.IsZero())
+	fallback, found := app.StakingKeeper.GetLiquidValidator(ctx, fallbackAddr)
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, fallback.TotalLiquidShares)
+}
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.SlashingKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 200
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(sdk.AccAddress("invalid"), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// invalid params are rejected
+	invalid := params
+	invalid.SignedBlocksWindow = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, invalid))
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, app.SlashingKeeper.GetParams(ctx))
+	require.Equal(t, int64(200), app.SlashingKeeper.SignedBlocksWindow(ctx))
+}
+
+func requireEventAttribute(t *testing.T, ctx sdk.Context, eventType, key, value string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				require.Equal(t, value, string(attr.Value))
+				return
+			}
+		}
+	}
+	t.Fatalf("event %s with attribute %s not found", eventType, key)
+}
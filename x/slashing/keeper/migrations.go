@@ -2,19 +2,36 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/exported"
+	v047 "github.com/iqlusioninc/liquidity-staking-module/x/slashing/migrations/v047"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper Keeper
+	keeper         Keeper
+	legacySubspace exported.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper Keeper) Migrator {
-	return Migrator{keeper: keeper}
+func NewMigrator(keeper Keeper, ss exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: ss}
 }
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3, setting the default maximum number
+// of slash history entries.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.legacySubspace.Set(ctx, types.KeyMaxSlashHistoryEntries, types.DefaultMaxSlashHistoryEntries)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4, moving the params from the legacy
+// x/params subspace to the x/slashing store.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.legacySubspace)
+}
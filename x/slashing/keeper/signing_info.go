@@ -101,6 +101,23 @@ func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddres
 	return missedBlocks
 }
 
+// GetValidatorMissedBlockBitmap returns the missed blocks of a validator in the
+// signed blocks window as a bitmap, where the bit i % 8 of byte i / 8 is set if
+// the block at index i was missed, along with the missed indexes
+func (k Keeper) GetValidatorMissedBlockBitmap(ctx sdk.Context, address sdk.ConsAddress) (bitmap []byte, missedIndexes []int64) {
+	bitmap = make([]byte, (k.SignedBlocksWindow(ctx)+7)/8)
+	missedIndexes = []int64{}
+	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
+		if missed {
+			bitmap[index/8] |= 1 << (index % 8)
+			missedIndexes = append(missedIndexes, index)
+		}
+		return false
+	})
+
+	return bitmap, missedIndexes
+}
+
 // JailUntil attempts to set a validator's JailedUntil attribute in its signing
 // info. It will panic if the signing info does not exist for the validator.
 func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
@@ -127,6 +144,8 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 
 	signInfo.Tombstoned = true
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	k.sk.HandleValidatorTombstoned(ctx, consAddr)
 }
 
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// slash slashes a validator through the staking module, burning an extra portion
+// of the validator bond delegations on double signs, and records the slash in
+// the slash history of the validator. It returns the amount of tokens burned.
+func (k Keeper) slash(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infraction types.Infraction,
+	infractionHeight, power int64, fraction sdk.Dec,
+) math.Int {
+	validator, found := k.sk.GetValidatorByConsAddr(ctx, consAddr)
+
+	tokensBurned := k.sk.Slash(ctx, consAddr, infractionHeight, power, fraction)
+
+	// the tokens burned from the validator itself are split between its shares,
+	// the remainder was burned from unbonding delegations and redelegations
+	validatorTokensBurned := math.ZeroInt()
+	if slashed, ok := k.sk.GetValidatorByConsAddr(ctx, consAddr); found && ok {
+		validatorTokensBurned = validator.Tokens.Sub(slashed.Tokens)
+	}
+
+	validatorBondTokensBurned := math.ZeroInt()
+	if infraction == types.InfractionDoubleSign {
+		validatorBondTokensBurned = k.sk.SlashValidatorBondDelegations(ctx, consAddr, fraction)
+	}
+	tokensBurned = tokensBurned.Add(validatorBondTokensBurned)
+
+	if !found || k.MaxSlashHistoryEntries(ctx) == 0 {
+		return tokensBurned
+	}
+
+	liquidTokensBurned := math.ZeroInt()
+	if validator.DelegatorShares.IsPositive() {
+		liquidTokensBurned = validator.TotalLiquidShares.MulInt(validatorTokensBurned).
+			Quo(validator.DelegatorShares).TruncateInt()
+		validatorBondTokensBurned = validatorBondTokensBurned.Add(validator.TotalValidatorBondShares.
+			MulInt(validatorTokensBurned).Quo(validator.DelegatorShares).TruncateInt())
+	}
+
+	k.AppendSlashRecord(ctx, types.SlashRecord{
+		ValidatorAddress:          validator.OperatorAddress,
+		Infraction:                infraction,
+		Height:                    ctx.BlockHeight(),
+		InfractionHeight:          infractionHeight,
+		Power:                     power,
+		SlashFraction:             fraction,
+		TokensBurned:              tokensBurned,
+		LiquidTokensBurned:        liquidTokensBurned,
+		ValidatorBondTokensBurned: validatorBondTokensBurned,
+		DelegationTokensBurned:    tokensBurned.Sub(liquidTokensBurned).Sub(validatorBondTokensBurned),
+	})
+
+	return tokensBurned
+}
+
+// nextSlashRecordSequence returns the sequence of the next slash record and
+// increments it
+func (k Keeper) nextSlashRecordSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if bz := store.Get(types.SlashRecordSequenceKey); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.SlashRecordSequenceKey, sdk.Uint64ToBigEndian(sequence+1))
+
+	return sequence
+}
+
+// AppendSlashRecord adds a record to the slash history of a validator, pruning
+// the oldest records of the validator beyond the maximum slash history entries
+func (k Keeper) AppendSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SlashRecordKey(valAddr, k.nextSlashRecordSequence(ctx)), k.cdc.MustMarshal(&record))
+
+	k.pruneSlashRecords(ctx, valAddr)
+}
+
+// pruneSlashRecords removes the oldest slash records of a validator until the
+// slash history fits the maximum slash history entries
+func (k Keeper) pruneSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordPrefixKey(valAddr))
+
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	maxEntries := k.MaxSlashHistoryEntries(ctx)
+	var keys [][]byte
+	for kept := uint64(0); iter.Valid(); iter.Next() {
+		if kept < maxEntries {
+			kept++
+			continue
+		}
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetValidatorSlashRecords returns the slash history of a validator, oldest first
+func (k Keeper) GetValidatorSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress) (records []types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.SlashRecordPrefixKey(valAddr))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// IterateSlashRecords iterates over the slash records of all validators
+func (k Keeper) IterateSlashRecords(ctx sdk.Context, handler func(record types.SlashRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.SlashRecordKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+)
+
+func TestSlashHistory(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// a quarter of the shares are liquid and another quarter are validator bond
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addr)
+	require.True(t, found)
+	validator.TotalLiquidShares = validator.DelegatorShares.QuoInt64(4)
+	validator.TotalValidatorBondShares = validator.DelegatorShares.QuoInt64(4)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	ctx = ctx.WithBlockHeight(10)
+	fraction := sdk.NewDecWithPrec(1, 1)
+	app.SlashingKeeper.Slash(ctx, consAddr, fraction, 100, 8)
+
+	records := app.SlashingKeeper.GetValidatorSlashRecords(ctx, addr)
+	require.Len(t, records, 1)
+	record := records[0]
+	require.Equal(t, addr.String(), record.ValidatorAddress)
+	require.Equal(t, types.InfractionDoubleSign, record.Infraction)
+	require.Equal(t, int64(10), record.Height)
+	require.Equal(t, int64(8), record.InfractionHeight)
+	require.Equal(t, int64(100), record.Power)
+	require.Equal(t, fraction, record.SlashFraction)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10), record.TokensBurned)
+	require.Equal(t, record.TokensBurned.QuoRaw(4), record.LiquidTokensBurned)
+	require.Equal(t, record.TokensBurned.QuoRaw(4), record.ValidatorBondTokensBurned)
+	require.Equal(t, record.TokensBurned.QuoRaw(2), record.DelegationTokensBurned)
+
+	// the oldest records are pruned beyond the maximum slash history entries
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.MaxSlashHistoryEntries = 2
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	app.SlashingKeeper.Slash(ctx.WithBlockHeight(11), consAddr, fraction, 100, 9)
+	app.SlashingKeeper.Slash(ctx.WithBlockHeight(12), consAddr, fraction, 100, 10)
+
+	records = app.SlashingKeeper.GetValidatorSlashRecords(ctx, addr)
+	require.Len(t, records, 2)
+	require.Equal(t, int64(11), records[0].Height)
+	require.Equal(t, int64(12), records[1].Height)
+
+	// the slash history is exported and imported with the genesis state
+	genesisState := app.SlashingKeeper.ExportGenesis(ctx)
+	require.Equal(t, records, genesisState.SlashRecords)
+
+	newApp := simapp.Setup(t, false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{})
+	newApp.SlashingKeeper.InitGenesis(newCtx, newApp.StakingKeeper, genesisState)
+	require.Equal(t, records, newApp.SlashingKeeper.GetValidatorSlashRecords(newCtx, addr))
+
+	// no slash is recorded once the slash history is disabled
+	params.MaxSlashHistoryEntries = 0
+	app.SlashingKeeper.SetParams(ctx, params)
+	app.SlashingKeeper.Slash(ctx.WithBlockHeight(13), consAddr, fraction, 100, 11)
+	require.Equal(t, records, app.SlashingKeeper.GetValidatorSlashRecords(ctx, addr))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyMaxSlashHistoryEntries, uint64(0))
+
+	require.NoError(t, keeper.NewMigrator(app.SlashingKeeper, subspace).Migrate2to3(ctx))
+	var maxSlashHistoryEntries uint64
+	subspace.Get(ctx, types.KeyMaxSlashHistoryEntries, &maxSlashHistoryEntries)
+	require.Equal(t, types.DefaultMaxSlashHistoryEntries, maxSlashHistoryEntries)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	params.MaxSlashHistoryEntries = 5
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(ctx, &params)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	require.NoError(t, keeper.NewMigrator(app.SlashingKeeper, subspace).Migrate3to4(ctx))
+	require.Equal(t, params, app.SlashingKeeper.GetParams(ctx))
+}
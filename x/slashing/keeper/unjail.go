@@ -1,22 +1,27 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
 
 // Unjail calls the staking Unjail function to unjail a validator if the
 // jailed period has concluded
 func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
-	validator := k.sk.Validator(ctx, validatorAddr)
-	if validator == nil {
+	validator, found := k.sk.GetLiquidValidator(ctx, validatorAddr)
+	if !found {
 		return sdkslashingtypes.ErrNoValidatorForAddress
 	}
 
-	// cannot be unjailed if no self-delegation exists
-	selfDel := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
-	if selfDel == nil {
-		return sdkslashingtypes.ErrMissingSelfDelegation
+	// cannot be unjailed if the tokens backing the validator bond are below the minimum
+	if k.sk.IsBelowMinValidatorBond(ctx, validator) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValidatorBond,
+			"validator bond %s tokens, minimum %s tokens", validator.ValidatorBondTokens(), k.sk.MinValidatorBond(ctx),
+		)
 	}
 
 	// cannot be unjailed if not jailed
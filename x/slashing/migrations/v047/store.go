@@ -0,0 +1,33 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/exported"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from the x/params managed
+// parameters to the module store. The migration includes:
+//
+// - Moving the params from the legacy subspace to the x/slashing store
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, legacySubspace exported.Subspace) error {
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -32,12 +32,23 @@ func TestDecodeStore(t *testing.T) {
 	missed := gogotypes.BoolValue{Value: true}
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
+	record := types.SlashRecord{
+		ValidatorAddress:          valAddr1.String(),
+		Infraction:                types.InfractionDoubleSign,
+		SlashFraction:             sdk.NewDecWithPrec(5, 2),
+		TokensBurned:              sdk.NewInt(100),
+		LiquidTokensBurned:        sdk.NewInt(25),
+		ValidatorBondTokensBurned: sdk.NewInt(25),
+		DelegationTokensBurned:    sdk.NewInt(50),
+	}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.SlashRecordKey(valAddr1, 1), Value: cdc.MustMarshal(&record)},
+			{Key: types.SlashRecordSequenceKey, Value: sdk.Uint64ToBigEndian(2)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -50,6 +61,8 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"SlashRecord", fmt.Sprintf("%v\n%v", record, record), false},
+		{"SlashRecordSequence", "sequenceA: 2\nsequenceB: 2", false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -71,9 +71,8 @@ func SimulateMsgUnjail(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Kee
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "unable to find validator signing info"), nil, nil // skip
 		}
 
-		selfDel := sk.Delegation(ctx, simAccount.Address, validator.GetOperator())
-		if selfDel == nil {
-			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "self delegation is nil"), nil, nil // skip
+		if sk.IsBelowMinValidatorBond(ctx, validator) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "validator bond is below minimum"), nil, nil // skip
 		}
 
 		account := ak.GetAccount(ctx, sdk.AccAddress(validator.GetOperator()))
@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // import (
 // 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 // )
@@ -14,3 +18,6 @@ package types
 // 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 // 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
 // )
+
+// x/slashing module sentinel errors specific to liquid staking
+var ErrInsufficientValidatorBond = errorsmod.Register(ModuleName, 9, "validator bond is below the minimum validator bond; cannot be unjailed")
@@ -6,8 +6,8 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // AccountKeeper expected account keeper
@@ -24,15 +24,6 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
-// ParamSubspace defines the expected Subspace interfacace
-type ParamSubspace interface {
-	HasKeyTable() bool
-	WithKeyTable(table paramtypes.KeyTable) paramtypes.Subspace
-	Get(ctx sdk.Context, key []byte, ptr interface{})
-	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
-	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
-}
-
 // StakingKeeper expected staking keeper
 type StakingKeeper interface {
 	// iterate through validators by operator address, execute func for each validator
@@ -44,6 +35,8 @@ type StakingKeeper interface {
 
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) math.Int
+	// burn an extra portion of the validator bond delegations of a double-signing validator
+	SlashValidatorBondDelegations(sdk.Context, sdk.ConsAddress, sdk.Dec) math.Int
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
 	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
 
@@ -53,6 +46,19 @@ type StakingKeeper interface {
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint32
+
+	// GetLiquidValidator returns a validator including its liquid staking fields
+	GetLiquidValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	// GetValidatorByConsAddr returns a validator including its liquid staking fields by consensus address
+	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
+	// MinValidatorBond returns the minimum validator bond tokens required to unjail
+	MinValidatorBond(sdk.Context) sdk.Dec
+	// IsBelowMinValidatorBond returns true if the tokens backing the validator bond
+	// shares of a validator are below the minimum validator bond
+	IsBelowMinValidatorBond(ctx sdk.Context, validator stakingtypes.Validator) bool
+	// HandleValidatorTombstoned notifies the holders of the tokenize share records
+	// of a tombstoned validator, redelegating them to the fallback validator if set
+	HandleValidatorTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
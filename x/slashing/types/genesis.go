@@ -9,12 +9,13 @@ import (
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
-	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks, slashRecords []SlashRecord,
 ) *GenesisState {
 	return &GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		SlashRecords: slashRecords,
 	}
 }
 
@@ -32,6 +33,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		SlashRecords: []SlashRecord{},
 	}
 }
 
@@ -62,5 +64,11 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for _, record := range data.SlashRecords {
+		if _, err := sdk.ValAddressFromBech32(record.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid slash record validator address %s: %w", record.ValidatorAddress, err)
+		}
+	}
+
 	return nil
 }
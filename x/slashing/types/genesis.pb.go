@@ -5,7 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -33,6 +34,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	// slash_records represents the slash history of the validators.
+	SlashRecords []SlashRecord `protobuf:"bytes,4,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records" yaml:"slash_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +92,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -267,34 +277,39 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x8e, 0xd3, 0x40,
-	0x14, 0xf6, 0x24, 0x10, 0x60, 0x9c, 0x6d, 0x46, 0x66, 0xb1, 0x56, 0xe0, 0xac, 0x2c, 0x16, 0x6d,
-	0x13, 0x5b, 0xbb, 0x74, 0x20, 0x1a, 0x37, 0x2b, 0x0a, 0x24, 0xe4, 0x95, 0x28, 0x68, 0xa2, 0x71,
-	0x3c, 0x3b, 0x19, 0xc5, 0xf6, 0x04, 0x3f, 0x13, 0x25, 0x57, 0xa0, 0xa2, 0xe6, 0x06, 0xf4, 0x1c,
-	0x22, 0x65, 0x4a, 0xaa, 0x08, 0x25, 0x37, 0xe0, 0x04, 0x28, 0x33, 0x0e, 0x71, 0xa2, 0x98, 0x88,
-	0xca, 0x7e, 0xd2, 0xf7, 0xf7, 0xbe, 0xd1, 0xc3, 0x17, 0x7d, 0x09, 0xa9, 0x04, 0x1f, 0x12, 0x0a,
-	0x03, 0x91, 0x71, 0x7f, 0x7c, 0x15, 0xb1, 0x82, 0x5e, 0xf9, 0x9c, 0x65, 0x0c, 0x04, 0x78, 0xa3,
-	0x5c, 0x16, 0x92, 0x3c, 0xd1, 0x30, 0x6f, 0x03, 0xf3, 0x4a, 0xd8, 0x99, 0xc5, 0x25, 0x97, 0x0a,
-	0xe3, 0xaf, 0xff, 0x34, 0xfc, 0xec, 0x45, 0x9d, 0xea, 0x5f, 0xbe, 0xc2, 0xb9, 0xdf, 0x1b, 0xb8,
-	0x7d, 0xa3, 0x8d, 0x6e, 0x0b, 0x5a, 0x30, 0xf2, 0x06, 0xb7, 0x46, 0x34, 0xa7, 0x29, 0xd8, 0xe8,
-	0x1c, 0x5d, 0x9a, 0xd7, 0x1d, 0xaf, 0xc6, 0xd8, 0x7b, 0xaf, 0x60, 0xc1, 0xbd, 0xd9, 0xa2, 0x63,
-	0x84, 0x25, 0x89, 0x70, 0x7c, 0x02, 0x82, 0x67, 0x22, 0xe3, 0x3d, 0x91, 0xdd, 0x49, 0xb0, 0x1b,
-	0xe7, 0xcd, 0x4b, 0xf3, 0xfa, 0x79, 0xad, 0xca, 0xad, 0x46, 0xbf, 0xcd, 0xee, 0x64, 0xf0, 0x74,
-	0x2d, 0xf5, 0x7b, 0xd1, 0xb1, 0xa6, 0x34, 0x4d, 0x5e, 0xb9, 0x3b, 0x42, 0x6e, 0xd8, 0x86, 0x2d,
-	0x14, 0xc8, 0x27, 0x7c, 0x92, 0x0a, 0x00, 0x16, 0xf7, 0xa2, 0x44, 0xf6, 0x87, 0x60, 0x37, 0x95,
-	0x91, 0x57, 0x6b, 0xf4, 0x81, 0x26, 0x22, 0xa6, 0x85, 0xcc, 0xdf, 0x29, 0x5a, 0xa0, 0x58, 0xfb,
-	0x96, 0x3b, 0x92, 0x6e, 0xd8, 0x4e, 0x2b, 0x58, 0xf7, 0x07, 0xc2, 0x66, 0x25, 0x2e, 0xb1, 0xf1,
-	0x03, 0x1a, 0xc7, 0x39, 0x03, 0xdd, 0xd5, 0xa3, 0x70, 0x33, 0x92, 0x2f, 0x08, 0x9f, 0x8e, 0x37,
-	0x7e, 0xbd, 0xea, 0x1e, 0x76, 0x43, 0xb5, 0xda, 0x3d, 0x1e, 0xb3, 0x5a, 0xcc, 0x45, 0x99, 0xf2,
-	0x99, 0x4e, 0x79, 0x58, 0xda, 0x0d, 0xad, 0xf1, 0x01, 0xb2, 0xfb, 0x0d, 0xe1, 0xc7, 0x07, 0x97,
-	0xff, 0xc7, 0x02, 0x7c, 0xbf, 0xdd, 0x63, 0xcf, 0x58, 0xd1, 0xfd, 0xaf, 0x4e, 0x5f, 0x63, 0xb3,
-	0x42, 0x25, 0x16, 0xbe, 0x2f, 0xb2, 0x98, 0x4d, 0x54, 0x9e, 0x66, 0xa8, 0x07, 0x72, 0x8a, 0x5b,
-	0x9a, 0xa4, 0xda, 0x7b, 0x18, 0x96, 0x53, 0x70, 0x33, 0x5b, 0x3a, 0x68, 0xbe, 0x74, 0xd0, 0xaf,
-	0xa5, 0x83, 0xbe, 0xae, 0x1c, 0x63, 0xbe, 0x72, 0x8c, 0x9f, 0x2b, 0xc7, 0xf8, 0xd8, 0xe5, 0xa2,
-	0x18, 0x7c, 0x8e, 0xbc, 0xbe, 0x4c, 0xfd, 0xf2, 0x12, 0xf4, 0xa7, 0x0b, 0xf1, 0xd0, 0x9f, 0x6c,
-	0xcf, 0xa2, 0x98, 0x8e, 0x18, 0x44, 0x2d, 0x75, 0x0c, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x3c, 0xa8, 0xf6, 0x8c, 0x03, 0x00, 0x00,
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x9b, 0x12, 0x60, 0x92, 0x6e, 0x2c, 0x53, 0x99, 0x0a, 0xdc, 0xca, 0x52, 0xab, 0x4a,
+	0x28, 0xb6, 0x1a, 0x60, 0x03, 0x1b, 0xb0, 0x90, 0x10, 0x0b, 0x24, 0xe4, 0x48, 0x2c, 0xba, 0xb1,
+	0x26, 0xf6, 0x74, 0x3a, 0xaa, 0x3d, 0x93, 0xfa, 0x8f, 0xa3, 0xe4, 0x16, 0x70, 0x11, 0x56, 0xec,
+	0xb8, 0x40, 0x97, 0x15, 0x12, 0x12, 0xab, 0x0a, 0x25, 0x37, 0xe0, 0x04, 0xc8, 0x33, 0x0e, 0x71,
+	0x4b, 0xa0, 0xa1, 0x3b, 0x8f, 0xe7, 0xbd, 0xf7, 0xff, 0xfb, 0xef, 0xdb, 0x68, 0x37, 0x16, 0x90,
+	0x09, 0xf0, 0x21, 0xc5, 0x70, 0xcc, 0x38, 0xf5, 0x47, 0x07, 0x03, 0x22, 0xf1, 0x81, 0x4f, 0x09,
+	0x27, 0xc0, 0xc0, 0x1b, 0xe6, 0x42, 0x0a, 0xd3, 0x49, 0xd9, 0x69, 0xc1, 0x12, 0x90, 0xf8, 0x84,
+	0x71, 0xea, 0xcd, 0xd1, 0x5e, 0x85, 0xde, 0xb2, 0xa8, 0xa0, 0x42, 0x41, 0xfd, 0xf2, 0x49, 0xb3,
+	0xb6, 0xf6, 0xfe, 0x26, 0xfe, 0x9b, 0xaf, 0x71, 0xf7, 0x35, 0x2e, 0xd2, 0x02, 0xfa, 0xa0, 0xaf,
+	0xdc, 0x4f, 0x4d, 0xd4, 0x79, 0xad, 0x5b, 0xe9, 0x4b, 0x2c, 0x89, 0xf9, 0x0a, 0xb5, 0x86, 0x38,
+	0xc7, 0x19, 0xd8, 0xc6, 0x8e, 0xb1, 0xdf, 0xee, 0xed, 0x79, 0xff, 0x6e, 0xcd, 0x7b, 0xa7, 0xd0,
+	0xc1, 0xfa, 0xd9, 0xc5, 0x76, 0x23, 0xac, 0xb8, 0x26, 0x47, 0x1b, 0xc0, 0x28, 0x67, 0x9c, 0x46,
+	0x8c, 0x1f, 0x09, 0xb0, 0xd7, 0x76, 0x9a, 0xfb, 0xed, 0xde, 0xa3, 0xeb, 0xc4, 0xfa, 0x9a, 0xf4,
+	0x86, 0x1f, 0x89, 0xe0, 0x41, 0xa9, 0xf8, 0xf3, 0x62, 0xdb, 0x9a, 0xe0, 0x2c, 0x7d, 0xe6, 0x5e,
+	0xd2, 0x73, 0xc3, 0x0e, 0x2c, 0xa0, 0x60, 0x8e, 0xd1, 0x46, 0xc6, 0x00, 0x48, 0x12, 0x0d, 0x52,
+	0x11, 0x9f, 0x80, 0xdd, 0x54, 0xf5, 0x9e, 0x5e, 0x57, 0xef, 0x3d, 0x4e, 0x59, 0x82, 0xa5, 0xc8,
+	0xdf, 0x2a, 0x76, 0xa0, 0xc8, 0x57, 0x2b, 0x5f, 0x52, 0x76, 0xc3, 0x4e, 0x56, 0xc3, 0x2a, 0xa7,
+	0xa5, 0x6a, 0x94, 0x93, 0x58, 0xe4, 0x09, 0xd8, 0xeb, 0x2b, 0x3a, 0x2d, 0x5f, 0x84, 0x8a, 0xf3,
+	0x87, 0xd3, 0xba, 0x5e, 0xe9, 0x74, 0x01, 0x05, 0xf7, 0x9b, 0x81, 0xda, 0xb5, 0x29, 0x99, 0x3d,
+	0x74, 0x1b, 0x27, 0x49, 0x4e, 0x40, 0x07, 0x76, 0x37, 0xb0, 0xbf, 0x7e, 0xee, 0x5a, 0x55, 0xc6,
+	0x2f, 0xf5, 0x4d, 0x5f, 0xe6, 0x8c, 0xd3, 0x70, 0x0e, 0x34, 0x3f, 0x1a, 0x68, 0x73, 0x34, 0x77,
+	0x1e, 0xd5, 0x07, 0x6b, 0xaf, 0xa9, 0xd0, 0x9f, 0xac, 0x3c, 0xb7, 0x7a, 0x60, 0xbb, 0x95, 0x8d,
+	0x87, 0xda, 0xc6, 0xf2, 0x0a, 0x6e, 0x68, 0x8d, 0x96, 0x90, 0xdd, 0x2f, 0x06, 0xba, 0xb7, 0x34,
+	0x8d, 0x1b, 0x39, 0xe4, 0x57, 0xf7, 0x61, 0xc5, 0xfd, 0xab, 0x15, 0xfe, 0x9f, 0x2d, 0x70, 0x9f,
+	0xa3, 0x76, 0x8d, 0x6a, 0x5a, 0xe8, 0x16, 0xe3, 0x09, 0x19, 0xab, 0x86, 0x9b, 0xa1, 0x3e, 0x98,
+	0x9b, 0xa8, 0xa5, 0x49, 0x6a, 0xca, 0x77, 0xc2, 0xea, 0x14, 0x1c, 0x9e, 0x4d, 0x1d, 0xe3, 0x7c,
+	0xea, 0x18, 0x3f, 0xa6, 0x8e, 0xf1, 0x61, 0xe6, 0x34, 0xce, 0x67, 0x4e, 0xe3, 0xfb, 0xcc, 0x69,
+	0x1c, 0xbe, 0xa0, 0x4c, 0x1e, 0x17, 0x03, 0x2f, 0x16, 0x99, 0xcf, 0x4e, 0xd3, 0x02, 0x98, 0xe0,
+	0x8c, 0xc7, 0xbe, 0x76, 0xc1, 0xe4, 0xa4, 0x5b, 0x39, 0xe9, 0x66, 0x22, 0x29, 0x52, 0xe2, 0x8f,
+	0x17, 0xff, 0x02, 0x39, 0x19, 0x12, 0x18, 0xb4, 0xd4, 0x67, 0xfe, 0xf8, 0xd7, 0x00, 0xf6, 0xeb,
+	0x7d, 0x01, 0x88, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -317,6 +332,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -511,6 +540,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -699,6 +734,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
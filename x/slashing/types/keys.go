@@ -25,15 +25,24 @@ const (
 // Keys for slashing store
 // Items are stored with the following key: values
 //
+// - 0x00: Params
+//
 // - 0x01<consAddrLen (1 Byte)><consAddress_Bytes>: ValidatorSigningInfo
 //
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<valAddrLen (1 Byte)><valAddr_Bytes><sequence_Bytes>: SlashRecord
+//
+// - 0x05: uint64
 var (
+	ParamsKey                             = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	SlashRecordKeyPrefix                  = []byte{0x04} // Prefix for slash records
+	SlashRecordSequenceKey                = []byte{0x05} // Key for the sequence of the next slash record
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -67,3 +76,15 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// SlashRecordPrefixKey gets the prefix of the slash records of a validator,
+// stored by *operator* address
+func SlashRecordPrefixKey(valAddr sdk.ValAddress) []byte {
+	return append(SlashRecordKeyPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// SlashRecordKey gets the key of a slash record, the sequence keeps the records
+// of a validator in the order they were created
+func SlashRecordKey(valAddr sdk.ValAddress, sequence uint64) []byte {
+	return append(SlashRecordPrefixKey(valAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
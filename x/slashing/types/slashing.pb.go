@@ -5,12 +5,12 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +29,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Infraction defines the infraction a validator was slashed for.
+type Infraction int32
+
+const (
+	// UNSPECIFIED defines an empty infraction.
+	InfractionUnspecified Infraction = 0
+	// DOUBLE_SIGN defines a validator that signed two blocks at the same height.
+	InfractionDoubleSign Infraction = 1
+	// DOWNTIME defines a validator that missed too many blocks.
+	InfractionDowntime Infraction = 2
+)
+
+var Infraction_name = map[int32]string{
+	0: "INFRACTION_UNSPECIFIED",
+	1: "INFRACTION_DOUBLE_SIGN",
+	2: "INFRACTION_DOWNTIME",
+}
+
+var Infraction_value = map[string]int32{
+	"INFRACTION_UNSPECIFIED": 0,
+	"INFRACTION_DOUBLE_SIGN": 1,
+	"INFRACTION_DOWNTIME":    2,
+}
+
+func (x Infraction) String() string {
+	return proto.EnumName(Infraction_name, int32(x))
+}
+
+func (Infraction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -130,6 +162,10 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	// max_slash_history_entries is the maximum number of slash records kept for
+	// each validator, the oldest records are pruned first. Zero disables the
+	// slash history.
+	MaxSlashHistoryEntries uint64 `protobuf:"varint,6,opt,name=max_slash_history_entries,json=maxSlashHistoryEntries,proto3" json:"max_slash_history_entries,omitempty" yaml:"max_slash_history_entries"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,9 +215,115 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetMaxSlashHistoryEntries() uint64 {
+	if m != nil {
+		return m.MaxSlashHistoryEntries
+	}
+	return 0
+}
+
+// SlashRecord records a slash of a validator, along with how the burned tokens
+// were split between liquid shares, validator bond shares and the remaining
+// delegations.
+type SlashRecord struct {
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// infraction is the infraction the validator was slashed for.
+	Infraction Infraction `protobuf:"varint,2,opt,name=infraction,proto3,enum=liquidstaking.slashing.v1beta1.Infraction" json:"infraction,omitempty"`
+	// height is the height at which the slash was applied.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// infraction_height is the height of the stake distribution that was slashed.
+	InfractionHeight int64 `protobuf:"varint,4,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty" yaml:"infraction_height"`
+	// power is the consensus power of the validator at the infraction.
+	Power int64 `protobuf:"varint,5,opt,name=power,proto3" json:"power,omitempty"`
+	// slash_fraction is the fraction of the stake that was slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// tokens_burned is the total amount of tokens burned by the slash.
+	TokensBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=tokens_burned,json=tokensBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens_burned" yaml:"tokens_burned"`
+	// liquid_tokens_burned is the part of the burned tokens that fell on liquid
+	// (tokenized) shares.
+	LiquidTokensBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=liquid_tokens_burned,json=liquidTokensBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquid_tokens_burned" yaml:"liquid_tokens_burned"`
+	// validator_bond_tokens_burned is the part of the burned tokens that fell on
+	// validator bond shares, including the extra validator bond slash.
+	ValidatorBondTokensBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=validator_bond_tokens_burned,json=validatorBondTokensBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_bond_tokens_burned" yaml:"validator_bond_tokens_burned"`
+	// delegation_tokens_burned is the part of the burned tokens that fell on
+	// ordinary delegations, unbonding delegations and redelegations.
+	DelegationTokensBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=delegation_tokens_burned,json=delegationTokensBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_tokens_burned" yaml:"delegation_tokens_burned"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetInfraction() Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return InfractionUnspecified
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("liquidstaking.slashing.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "liquidstaking.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "liquidstaking.slashing.v1beta1.Params")
+	proto.RegisterType((*SlashRecord)(nil), "liquidstaking.slashing.v1beta1.SlashRecord")
 }
 
 func init() {
@@ -189,47 +331,76 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xbd, 0x6f, 0xdb, 0x46,
+	0x14, 0x17, 0xe3, 0xef, 0x93, 0x12, 0x38, 0x67, 0x59, 0x96, 0x55, 0x97, 0x54, 0xd9, 0x22, 0x70,
+	0x03, 0x58, 0x42, 0xdc, 0x74, 0xf1, 0xd4, 0xd0, 0x1f, 0x89, 0x82, 0x46, 0x76, 0x28, 0xbb, 0x01,
+	0x3a, 0x84, 0xa0, 0xc4, 0x13, 0x7d, 0x35, 0x79, 0xa7, 0xf0, 0x4e, 0xb1, 0xdd, 0xa5, 0xe8, 0x16,
+	0xb8, 0x8b, 0xa7, 0x22, 0x8b, 0x81, 0xa0, 0x5d, 0x3a, 0x76, 0xe8, 0xd4, 0xbf, 0x20, 0x63, 0xd0,
+	0xa9, 0xc8, 0xa0, 0x16, 0xf6, 0xd2, 0x59, 0x7f, 0x41, 0xc1, 0xbb, 0x93, 0xf5, 0x61, 0x39, 0x80,
+	0x26, 0xe9, 0xfd, 0x7e, 0xef, 0xe3, 0xc7, 0xf7, 0x1e, 0x9f, 0x04, 0xee, 0xd4, 0x28, 0x0b, 0x29,
+	0x2b, 0xb2, 0xc0, 0x65, 0xfb, 0x98, 0xf8, 0xc5, 0x97, 0xf7, 0xaa, 0x88, 0xbb, 0xf7, 0x2e, 0x81,
+	0x42, 0x23, 0xa2, 0x9c, 0x42, 0x3d, 0xc0, 0x2f, 0x9a, 0xd8, 0x63, 0xdc, 0x3d, 0x88, 0xc1, 0x4b,
+	0x56, 0xb9, 0xe7, 0xd2, 0x3e, 0xf5, 0xa9, 0x70, 0x2d, 0xc6, 0xdf, 0x64, 0x54, 0x4e, 0xf7, 0x29,
+	0xf5, 0x03, 0x54, 0x14, 0x56, 0xb5, 0x59, 0x2f, 0x7a, 0xcd, 0xc8, 0xe5, 0x98, 0x12, 0xc5, 0x1b,
+	0x83, 0x3c, 0xc7, 0x21, 0x62, 0xdc, 0x0d, 0x1b, 0xca, 0x61, 0x51, 0xca, 0x73, 0x64, 0x66, 0x69,
+	0x48, 0xca, 0xfc, 0x65, 0x0c, 0xa4, 0xbf, 0x71, 0x03, 0xec, 0xb9, 0x9c, 0x46, 0x15, 0xec, 0x13,
+	0x4c, 0xfc, 0x12, 0xa9, 0x53, 0xb8, 0x0a, 0xa6, 0x5c, 0xcf, 0x8b, 0x10, 0x63, 0x59, 0x2d, 0xaf,
+	0x2d, 0xcf, 0x58, 0xd9, 0xbf, 0xfe, 0x58, 0x49, 0xab, 0xd8, 0x07, 0x92, 0xa9, 0xf0, 0x08, 0x13,
+	0xdf, 0xee, 0x38, 0xc2, 0x35, 0x90, 0x62, 0xdc, 0x8d, 0xb8, 0xb3, 0x8f, 0xb0, 0xbf, 0xcf, 0xb3,
+	0x37, 0xf2, 0xda, 0xf2, 0x98, 0xb5, 0xd0, 0x6e, 0x19, 0x73, 0xc7, 0x6e, 0x18, 0xac, 0x99, 0xbd,
+	0xac, 0x69, 0x27, 0x85, 0xf9, 0x48, 0x58, 0x71, 0x2c, 0x26, 0x1e, 0x3a, 0x72, 0x68, 0xbd, 0xce,
+	0x10, 0xcf, 0x8e, 0x0d, 0xc6, 0xf6, 0xb2, 0xa6, 0x9d, 0x14, 0xe6, 0xb6, 0xb0, 0xe0, 0x73, 0x90,
+	0xfa, 0xce, 0xc5, 0x01, 0xf2, 0x9c, 0x26, 0xe1, 0x38, 0xc8, 0x8e, 0xe7, 0xb5, 0xe5, 0xe4, 0x6a,
+	0xae, 0x20, 0xfb, 0x52, 0xe8, 0xf4, 0xa5, 0xb0, 0xdb, 0xe9, 0x8b, 0x65, 0xbc, 0x6d, 0x19, 0x89,
+	0x6e, 0xee, 0xde, 0x68, 0xf3, 0xf4, 0x1f, 0x43, 0xb3, 0x93, 0x12, 0xda, 0x8b, 0x11, 0xa8, 0x03,
+	0xc0, 0x69, 0x58, 0x65, 0x9c, 0x12, 0xe4, 0x65, 0x27, 0xf2, 0xda, 0xf2, 0xb4, 0xdd, 0x83, 0xc0,
+	0x5d, 0x30, 0x1f, 0x62, 0xc6, 0x90, 0xe7, 0x54, 0x03, 0x5a, 0x3b, 0x60, 0x4e, 0x8d, 0x36, 0x09,
+	0x47, 0x51, 0x76, 0x52, 0x3c, 0x44, 0xbe, 0xdd, 0x32, 0x96, 0x64, 0xa1, 0xa1, 0x6e, 0xa6, 0x3d,
+	0x27, 0x71, 0x4b, 0xc0, 0xeb, 0x12, 0x5d, 0x9b, 0x7e, 0xfd, 0xc6, 0x48, 0xfc, 0xf7, 0xc6, 0xd0,
+	0xcc, 0xf7, 0x13, 0x60, 0x72, 0xc7, 0x8d, 0xdc, 0x90, 0xc1, 0xa7, 0x20, 0xcd, 0xb0, 0x4f, 0xba,
+	0x39, 0x0e, 0x31, 0xf1, 0xe8, 0xa1, 0x98, 0xd1, 0x98, 0x65, 0xb4, 0x5b, 0xc6, 0x47, 0xaa, 0xd5,
+	0x43, 0xbc, 0x4c, 0x1b, 0x4a, 0x58, 0x16, 0x7a, 0x26, 0x40, 0xf8, 0xa3, 0x16, 0xcb, 0x27, 0x8e,
+	0x8a, 0x68, 0xa0, 0xa8, 0x93, 0x34, 0x9e, 0x5f, 0xca, 0x2a, 0xc7, 0xbd, 0x7a, 0xdf, 0x32, 0xee,
+	0xf8, 0x98, 0xef, 0x37, 0xab, 0x85, 0x1a, 0x0d, 0xd5, 0x0e, 0xa9, 0x8f, 0x15, 0xe6, 0x1d, 0x14,
+	0xf9, 0x71, 0x03, 0xb1, 0xc2, 0x06, 0xaa, 0xf5, 0x3e, 0xec, 0x90, 0xa4, 0xa6, 0x0d, 0x43, 0x4c,
+	0x2a, 0x02, 0xde, 0x41, 0x91, 0xd2, 0xf0, 0x3d, 0xc8, 0x78, 0xf4, 0x90, 0xc4, 0x8b, 0xeb, 0xc4,
+	0x9d, 0x77, 0x3a, 0x2b, 0x2e, 0xf6, 0x20, 0xb9, 0xba, 0x78, 0x65, 0x96, 0x1b, 0xca, 0xc1, 0xfa,
+	0x5c, 0x8d, 0xf2, 0x63, 0x59, 0x74, 0x78, 0x1a, 0xf3, 0x75, 0x3c, 0xd4, 0x74, 0x87, 0x7c, 0xec,
+	0xe2, 0xa0, 0x93, 0x00, 0x9e, 0x6a, 0x20, 0x27, 0xde, 0x44, 0xa7, 0x1e, 0xb9, 0xb5, 0x18, 0x72,
+	0x3c, 0xda, 0xac, 0x06, 0x48, 0x88, 0x17, 0xcb, 0x94, 0xb2, 0x2a, 0x23, 0x37, 0xe1, 0x13, 0x35,
+	0x87, 0x6b, 0x33, 0x9b, 0xf6, 0x82, 0x20, 0xb7, 0x14, 0xb7, 0x21, 0xa8, 0xb8, 0x33, 0xf0, 0x95,
+	0x06, 0x16, 0xae, 0x04, 0x4a, 0xe9, 0x62, 0xfd, 0x52, 0xd6, 0xce, 0xc8, 0x7a, 0xf4, 0x6b, 0xf4,
+	0xc8, 0xb4, 0xa6, 0x3d, 0x3f, 0x20, 0x46, 0xe2, 0xd0, 0x01, 0x8b, 0xa1, 0x7b, 0xe4, 0xc8, 0xb0,
+	0x7d, 0xcc, 0x38, 0x8d, 0x8e, 0x1d, 0x44, 0x78, 0x84, 0x11, 0x13, 0xfb, 0x3d, 0x6e, 0x7d, 0xd6,
+	0x6e, 0x19, 0x79, 0x35, 0xf2, 0xeb, 0x5c, 0x4d, 0x3b, 0x13, 0xba, 0x47, 0x95, 0x98, 0x7a, 0x24,
+	0x99, 0x4d, 0x45, 0xfc, 0x39, 0x05, 0x92, 0x02, 0xb7, 0x51, 0x8d, 0x46, 0x1e, 0x74, 0xc1, 0xed,
+	0x97, 0x9d, 0x83, 0xe4, 0xf4, 0x9f, 0xa0, 0xfb, 0xed, 0x96, 0x91, 0x95, 0x85, 0xae, 0xb8, 0x98,
+	0xd7, 0x9e, 0xa7, 0xd9, 0x4b, 0x5f, 0x85, 0xc3, 0xc7, 0x00, 0x60, 0xd2, 0x69, 0x81, 0xd8, 0xf2,
+	0x5b, 0xab, 0x77, 0x0b, 0x1f, 0xbe, 0xcd, 0x85, 0xd2, 0x65, 0x84, 0xdd, 0x13, 0x0d, 0x33, 0x60,
+	0x52, 0x5d, 0x3b, 0x71, 0xb1, 0x6c, 0x65, 0xc1, 0x12, 0xb8, 0xdd, 0xf5, 0xea, 0x1c, 0xc4, 0x71,
+	0xf1, 0x96, 0x2e, 0x75, 0x1f, 0xe3, 0x8a, 0x8b, 0x69, 0xcf, 0x76, 0x31, 0x75, 0x1a, 0xd3, 0x60,
+	0xa2, 0x41, 0x0f, 0x51, 0x24, 0x46, 0x3f, 0x66, 0x4b, 0x03, 0x12, 0x70, 0xab, 0x7f, 0x96, 0x62,
+	0x1a, 0x33, 0xd6, 0xc3, 0x91, 0x37, 0x63, 0x7e, 0xd8, 0x66, 0x98, 0xf6, 0xcd, 0xbe, 0x85, 0x80,
+	0x07, 0xe0, 0x26, 0xa7, 0x07, 0x88, 0x30, 0xa7, 0xda, 0x8c, 0xe2, 0x3b, 0x38, 0x25, 0xca, 0x6d,
+	0x8d, 0x50, 0xae, 0x44, 0x78, 0xbb, 0x65, 0xa4, 0x65, 0xb9, 0xbe, 0x64, 0xa6, 0x9d, 0x92, 0xb6,
+	0x25, 0x4c, 0xf8, 0x03, 0x48, 0xcb, 0x71, 0x38, 0xfd, 0x35, 0xa7, 0x45, 0xcd, 0x27, 0x23, 0xd7,
+	0x54, 0x47, 0x71, 0x58, 0x4e, 0xd3, 0x86, 0x12, 0xde, 0xed, 0x15, 0xf0, 0xb3, 0x06, 0x96, 0xba,
+	0x3b, 0x56, 0xa5, 0x64, 0x50, 0xc9, 0x8c, 0x50, 0xb2, 0x37, 0xb2, 0x92, 0x4f, 0x07, 0xf7, 0xf7,
+	0x6a, 0x6e, 0xd3, 0x5e, 0xbc, 0xa4, 0x2d, 0x4a, 0xfa, 0x85, 0xfd, 0xa4, 0x81, 0xac, 0x87, 0x02,
+	0xe4, 0x8b, 0xe3, 0x35, 0x20, 0x0a, 0x08, 0x51, 0x4f, 0x47, 0x16, 0x65, 0xa8, 0xdb, 0x79, 0x4d,
+	0x5e, 0xd3, 0xce, 0x74, 0xa9, 0x5e, 0x35, 0x77, 0x7f, 0xd7, 0x00, 0xe8, 0xbe, 0x18, 0xf0, 0x4b,
+	0x90, 0x29, 0x95, 0xb7, 0xec, 0x07, 0xeb, 0xbb, 0xa5, 0xed, 0xb2, 0xb3, 0x57, 0xae, 0xec, 0x6c,
+	0xae, 0x97, 0xb6, 0x4a, 0x9b, 0x1b, 0xb3, 0x89, 0xdc, 0xe2, 0xc9, 0x59, 0x7e, 0xbe, 0xeb, 0xbb,
+	0x47, 0x58, 0x03, 0xd5, 0x70, 0x1d, 0x23, 0x0f, 0xde, 0xef, 0x0b, 0xdb, 0xd8, 0xde, 0xb3, 0xbe,
+	0xde, 0x74, 0x2a, 0xa5, 0x87, 0xe5, 0x59, 0x2d, 0x97, 0x3d, 0x39, 0xcb, 0xa7, 0xbb, 0x61, 0x3d,
+	0x47, 0xb2, 0x08, 0xe6, 0xfa, 0xa2, 0x9e, 0x95, 0x77, 0x4b, 0x4f, 0x36, 0x67, 0x6f, 0xe4, 0x32,
+	0x27, 0x67, 0x79, 0xd8, 0x1b, 0x22, 0x4f, 0x59, 0x6e, 0xfc, 0xd5, 0xaf, 0x7a, 0xc2, 0x7a, 0xfe,
+	0xdb, 0xb9, 0xae, 0xbd, 0x3d, 0xd7, 0xb5, 0x77, 0xe7, 0xba, 0xf6, 0xef, 0xb9, 0xae, 0x9d, 0x5e,
+	0xe8, 0x89, 0x77, 0x17, 0x7a, 0xe2, 0xef, 0x0b, 0x3d, 0xf1, 0xed, 0x57, 0x3d, 0x3d, 0xc3, 0x2f,
+	0x82, 0x26, 0xc3, 0x94, 0x60, 0x52, 0x2b, 0xca, 0x15, 0xc1, 0xfc, 0x78, 0x45, 0x1d, 0x88, 0x95,
+	0x90, 0x7a, 0xcd, 0x00, 0x15, 0x8f, 0xba, 0x7f, 0xfa, 0x44, 0x47, 0xab, 0x93, 0xe2, 0x27, 0xea,
+	0x8b, 0xff, 0x07, 0x00, 0x1c, 0x80, 0xfd, 0xc9, 0x14, 0x0a, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -305,6 +476,60 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.MaxSlashHistoryEntries != that1.MaxSlashHistoryEntries {
+		return false
+	}
+	return true
+}
+func (this *SlashRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashRecord)
+	if !ok {
+		that2, ok := that.(SlashRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.TokensBurned.Equal(that1.TokensBurned) {
+		return false
+	}
+	if !this.LiquidTokensBurned.Equal(that1.LiquidTokensBurned) {
+		return false
+	}
+	if !this.ValidatorBondTokensBurned.Equal(that1.ValidatorBondTokensBurned) {
+		return false
+	}
+	if !this.DelegationTokensBurned.Equal(that1.DelegationTokensBurned) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -390,6 +615,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSlashHistoryEntries != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxSlashHistoryEntries))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -436,6 +666,106 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DelegationTokensBurned.Size()
+		i -= size
+		if _, err := m.DelegationTokensBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorBondTokensBurned.Size()
+		i -= size
+		if _, err := m.ValidatorBondTokensBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.LiquidTokensBurned.Size()
+		i -= size
+		if _, err := m.LiquidTokensBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TokensBurned.Size()
+		i -= size
+		if _, err := m.TokensBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Power != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -491,6 +821,44 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.MaxSlashHistoryEntries != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxSlashHistoryEntries))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHeight))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.TokensBurned.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.LiquidTokensBurned.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.ValidatorBondTokensBurned.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DelegationTokensBurned.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -872,6 +1240,353 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashHistoryEntries", wireType)
+			}
+			m.MaxSlashHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlashHistoryEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokensBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidTokensBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondTokensBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondTokensBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTokensBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationTokensBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
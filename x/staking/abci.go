@@ -23,5 +23,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.PruneTokenizeShareRecords(ctx)
+	k.RedelegateTombstonedTokenizeShareRecords(ctx)
+	k.ExpireMatureShareOffers(ctx)
+
 	return k.BlockValidatorUpdates(ctx)
 }
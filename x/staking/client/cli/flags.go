@@ -25,6 +25,14 @@ const (
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
+	FlagCommissionLiquidRate    = "commission-liquid-rate"
+
+	FlagMaxLiquidShareRatio = "max-liquid-share-ratio"
+
+	FlagShareTokens = "share-tokens"
+	FlagRecordId    = "record-id"
+
+	FlagMetadata = "metadata"
 
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
@@ -53,6 +61,7 @@ func FlagSetCommissionCreate() *flag.FlagSet {
 	fs.String(FlagCommissionRate, "", "The initial commission rate percentage")
 	fs.String(FlagCommissionMaxRate, "", "The maximum commission rate percentage")
 	fs.String(FlagCommissionMaxChangeRate, "", "The maximum commission change rate percentage (per day)")
+	fs.String(FlagCommissionLiquidRate, "", "The (optional) commission rate percentage charged to tokenized delegations")
 
 	return fs
 }
@@ -87,6 +96,7 @@ func flagSetCommissionUpdate() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagCommissionRate, "", "The new commission rate percentage")
+	fs.String(FlagCommissionLiquidRate, "", "The new commission rate percentage charged to tokenized delegations")
 
 	return fs
 }
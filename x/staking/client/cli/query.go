@@ -45,6 +45,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAllTokenizeShareRecords(),
 		GetCmdQueryLastTokenizeShareRecordId(),
 		GetCmdQueryTotalTokenizeSharedAssets(),
+		GetCmdQueryValidatorBondShortfalls(),
+		GetCmdQueryLiquidStakingPausedValidators(),
+		GetCmdQueryValidatorBondAccounts(),
+		GetCmdQueryShareOffer(),
+		GetCmdQueryShareOffers(),
+		GetCmdQueryShareOffersByValidator(),
+		GetCmdQueryShareOffersByDenom(),
 	)
 
 	return stakingQueryCmd
@@ -982,3 +989,306 @@ $ %s query staking total-tokenize-share-assets
 
 	return cmd
 }
+
+// GetCmdQueryValidatorBondShortfalls implements the query for bonded validators below the minimum validator bond
+func GetCmdQueryValidatorBondShortfalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bond-shortfalls",
+		Args:  cobra.NoArgs,
+		Short: "Query for bonded validators below the minimum validator bond",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for bonded validators whose validator bond is below the minimum
+validator bond, along with the height at which each of them will be jailed.
+
+Example:
+$ %s query staking validator-bond-shortfalls
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorBondShortfalls(cmd.Context(), &types.QueryValidatorBondShortfallsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLiquidStakingPausedValidators implements the query for validators for which liquid staking is paused
+func GetCmdQueryLiquidStakingPausedValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-staking-paused-validators",
+		Args:  cobra.NoArgs,
+		Short: "Query for validators for which liquid staking is paused",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for validators for which liquid staking is paused by governance.
+
+Example:
+$ %s query staking liquid-staking-paused-validators
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidStakingPausedValidators(cmd.Context(), &types.QueryLiquidStakingPausedValidatorsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorBondAccounts implements the query for a validator's validator bond allowlist
+func GetCmdQueryValidatorBondAccounts() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-bond-accounts [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the accounts allowed to validator bond to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the accounts the validator operator allowed to mark their delegations as validator bond.
+An empty list means any account may validator bond to the validator.
+
+Example:
+$ %s query staking validator-bond-accounts %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorBondAccounts(cmd.Context(), &types.QueryValidatorBondAccountsRequest{
+				ValidatorAddress: valAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryShareOffer implements the query for a share offer by id
+func GetCmdQueryShareOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share-offer [offer-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a share offer by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a share offer by id.
+
+Example:
+$ %s query staking share-offer 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ShareOffer(cmd.Context(), &types.QueryShareOfferRequest{
+				OfferId: offerId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryShareOffers implements the query for all open share offers
+func GetCmdQueryShareOffers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share-offers",
+		Args:  cobra.NoArgs,
+		Short: "Query for all open share offers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all open share offers.
+
+Example:
+$ %s query staking share-offers
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ShareOffers(cmd.Context(), &types.QueryShareOffersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "share offers")
+
+	return cmd
+}
+
+// GetCmdQueryShareOffersByValidator implements the query for the open share offers of a validator's shares
+func GetCmdQueryShareOffersByValidator() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "share-offers-by-validator [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the open share offers of shares delegated to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the open share offers whose share tokens or tokenize share record are delegated to a validator.
+
+Example:
+$ %s query staking share-offers-by-validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ShareOffersByValidator(cmd.Context(), &types.QueryShareOffersByValidatorRequest{
+				ValidatorAddress: valAddr.String(),
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "share offers")
+
+	return cmd
+}
+
+// GetCmdQueryShareOffersByDenom implements the query for the open share offers of a share token denom
+func GetCmdQueryShareOffersByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share-offers-by-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the open share offers of a share token denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the open share offers of share tokens, or of the tokenize share record, with the given denom.
+
+Example:
+$ %s query staking share-offers-by-denom cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ShareOffersByDenom(cmd.Context(), &types.QueryShareOffersByDenomRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "share offers")
+
+	return cmd
+}
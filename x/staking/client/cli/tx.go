@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -15,6 +16,10 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -48,6 +53,14 @@ func NewTxCmd() *cobra.Command {
 		NewRedeemTokensCmd(),
 		NewTransferTokenizeShareRecordCmd(),
 		NewValidatorBondCmd(),
+		NewAddValidatorBondAccountCmd(),
+		NewRemoveValidatorBondAccountCmd(),
+		NewSetValidatorBondAllowlistCmd(),
+		NewTransferValidatorBondCmd(),
+		NewCreateShareOfferCmd(),
+		NewAcceptShareOfferCmd(),
+		NewCancelShareOfferCmd(),
+		NewVoteWithTokenizeShareRecordCmd(),
 	)
 
 	return stakingTxCmd
@@ -78,6 +91,7 @@ func NewCreateValidatorCmd() *cobra.Command {
 	cmd.Flags().AddFlagSet(FlagSetAmount())
 	cmd.Flags().AddFlagSet(flagSetDescriptionCreate())
 	cmd.Flags().AddFlagSet(FlagSetCommissionCreate())
+	cmd.Flags().String(FlagMaxLiquidShareRatio, "", "The (optional) maximum fraction of the validator's delegator shares that may be liquid")
 
 	cmd.Flags().String(FlagIP, "", fmt.Sprintf("The node's public IP. It takes effect only when used in combination with --%s", flags.FlagGenerateOnly))
 	cmd.Flags().String(FlagNodeID, "", "The node's ID")
@@ -120,7 +134,21 @@ func NewEditValidatorCmd() *cobra.Command {
 				newRate = &rate
 			}
 
+			commissionLiquidRate, _ := cmd.Flags().GetString(FlagCommissionLiquidRate)
+			newLiquidRate, err := buildLiquidCommissionRate(commissionLiquidRate)
+			if err != nil {
+				return fmt.Errorf("invalid new liquid commission rate: %v", err)
+			}
+
+			maxLiquidShareRatio, _ := cmd.Flags().GetString(FlagMaxLiquidShareRatio)
+			newMaxLiquidShareRatio, err := buildMaxLiquidShareRatio(maxLiquidShareRatio)
+			if err != nil {
+				return fmt.Errorf("invalid new max liquid share ratio: %v", err)
+			}
+
 			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate)
+			msg.LiquidCommissionRate = newLiquidRate
+			msg.MaxLiquidShareRatio = newMaxLiquidShareRatio
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -128,6 +156,7 @@ func NewEditValidatorCmd() *cobra.Command {
 
 	cmd.Flags().AddFlagSet(flagSetDescriptionEdit())
 	cmd.Flags().AddFlagSet(flagSetCommissionUpdate())
+	cmd.Flags().String(FlagMaxLiquidShareRatio, "", "The new maximum fraction of the validator's delegator shares that may be liquid")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -339,11 +368,24 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 		return txf, nil, err
 	}
 
+	liquidRateStr, _ := fs.GetString(FlagCommissionLiquidRate)
+	commissionRates.LiquidCommissionRate, err = buildLiquidCommissionRate(liquidRateStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	maxLiquidShareRatioStr, _ := fs.GetString(FlagMaxLiquidShareRatio)
+	maxLiquidShareRatio, err := buildMaxLiquidShareRatio(maxLiquidShareRatioStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
 	msg, err := types.NewMsgCreateValidator(
 		sdk.ValAddress(valAddr), pk, amount, description, commissionRates)
 	if err != nil {
 		return txf, nil, err
 	}
+	msg.MaxLiquidShareRatio = maxLiquidShareRatio
 	if err := msg.ValidateBasic(); err != nil {
 		return txf, nil, err
 	}
@@ -397,6 +439,7 @@ type TxCreateValidatorConfig struct {
 	CommissionRate          string
 	CommissionMaxRate       string
 	CommissionMaxChangeRate string
+	CommissionLiquidRate    string
 
 	PubKey cryptotypes.PubKey
 
@@ -464,6 +507,11 @@ func PrepareConfigForTxCreateValidator(flagSet *flag.FlagSet, moniker, nodeID, c
 		return c, err
 	}
 
+	c.CommissionLiquidRate, err = flagSet.GetString(FlagCommissionLiquidRate)
+	if err != nil {
+		return c, err
+	}
+
 	c.NodeID = nodeID
 	c.PubKey = valPubKey
 	c.Website = website
@@ -518,6 +566,11 @@ func BuildCreateValidatorMsg(clientCtx client.Context, config TxCreateValidatorC
 		return txBldr, nil, err
 	}
 
+	commissionRates.LiquidCommissionRate, err = buildLiquidCommissionRate(config.CommissionLiquidRate)
+	if err != nil {
+		return txBldr, nil, err
+	}
+
 	msg, err := types.NewMsgCreateValidator(
 		sdk.ValAddress(valAddr), config.PubKey, amount, description, commissionRates,
 	)
@@ -714,3 +767,424 @@ $ %s tx staking validator-bond cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62jkq
 
 	return cmd
 }
+
+// NewAddValidatorBondAccountCmd defines a command to add an account to a validator's validator bond allowlist
+func NewAddValidatorBondAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-validator-bond-account [account]",
+		Short: "Allow an account to mark its delegations to your validator as validator bond",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add an account to your validator's validator bond allowlist.
+Adding an account opts the validator in to the allowlist: from then on, only the operator account and the listed accounts
+may validator bond to the validator, even once every account is removed, until the operator opts out with set-validator-bond-allowlist.
+
+Example:
+$ %s tx staking add-validator-bond-account cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddValidatorBondAccount(sdk.ValAddress(clientCtx.GetFromAddress()), accAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveValidatorBondAccountCmd defines a command to remove an account from a validator's validator bond allowlist
+func NewRemoveValidatorBondAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-validator-bond-account [account]",
+		Short: "Remove an account from your validator's validator bond allowlist",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove an account from your validator's validator bond allowlist.
+Delegations already marked as validator bond are not affected.
+
+Example:
+$ %s tx staking remove-validator-bond-account cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveValidatorBondAccount(sdk.ValAddress(clientCtx.GetFromAddress()), accAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetValidatorBondAllowlistCmd defines a command to opt in to or out of a validator bond allowlist
+func NewSetValidatorBondAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-bond-allowlist [enabled]",
+		Short: "Opt in to or out of restricting validator bonds to your validator's validator bond allowlist",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to or out of restricting validator bonds to your validator's validator bond allowlist.
+While opted in, only the operator account and the listed accounts may validator bond to the validator.
+
+Example:
+$ %s tx staking set-validator-bond-allowlist false --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetValidatorBondAllowlist(sdk.ValAddress(clientCtx.GetFromAddress()), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTransferValidatorBondCmd defines a command to move validator bond shares to another account
+func NewTransferValidatorBondCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-validator-bond [validator] [recipient] [shares]",
+		Short: "Transfer validator bond shares to another account without unbonding",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer validator bond shares from your validator bond delegation to another account's delegation on the same validator.
+The recipient's delegation, if any, must already be marked as validator bond.
+
+Example:
+$ %s tx staking transfer-validator-bond cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62jkqv3h cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000.0 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			shares, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferValidatorBond(clientCtx.GetFromAddress(), valAddr, recipient, shares)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateShareOfferCmd defines a command to offer share tokens and/or a tokenize share record for sale
+func NewCreateShareOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-share-offer [price] [expiration]",
+		Short: "Offer share tokens and/or a tokenize share record for sale",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Offer share tokens and/or a tokenize share record for sale at an asking price.
+The offered assets are held in escrow until the offer is accepted, cancelled or expires at the given RFC3339 time.
+
+Example:
+$ %s tx staking create-share-offer 1000stake 2024-01-02T15:04:05Z --share-tokens=1000cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62jkqv3h/1 --record-id=1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			shareTokensStr, err := cmd.Flags().GetString(FlagShareTokens)
+			if err != nil {
+				return err
+			}
+			shareTokens, err := sdk.ParseCoinsNormalized(shareTokensStr)
+			if err != nil {
+				return err
+			}
+
+			recordId, err := cmd.Flags().GetUint64(FlagRecordId)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateShareOffer(clientCtx.GetFromAddress(), shareTokens, recordId, price, expiration)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagShareTokens, "", "The share tokens to offer")
+	cmd.Flags().Uint64(FlagRecordId, 0, "The id of the tokenize share record to offer")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAcceptShareOfferCmd defines a command to buy the assets of a share offer
+func NewAcceptShareOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-share-offer [offer-id]",
+		Short: "Buy the assets of a share offer at its asking price",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy the share tokens and/or tokenize share record of a share offer by paying its asking price to the seller.
+
+Example:
+$ %s tx staking accept-share-offer 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptShareOffer(clientCtx.GetFromAddress(), offerId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelShareOfferCmd defines a command to cancel a share offer
+func NewCancelShareOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-share-offer [offer-id]",
+		Short: "Cancel a share offer and get the escrowed assets back",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel one of your share offers, returning the escrowed share tokens and tokenize share record.
+
+Example:
+$ %s tx staking cancel-share-offer 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelShareOffer(clientCtx.GetFromAddress(), offerId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewVoteWithTokenizeShareRecordCmd defines a command to vote on a proposal with a
+// tokenize share record
+func NewVoteWithTokenizeShareRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-with-tokenize-share-record [record-id] [proposal-id] [options]",
+		Short: "Vote on a proposal with the delegation of a tokenize share record",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on an active proposal on behalf of the module account of one of your
+tokenize share records, overriding the vote of the validator for the record's
+delegation. The options are given as weighted vote options.
+
+Example:
+$ %s tx staking vote-with-tokenize-share-record 1 3 yes --from mykey
+$ %s tx staking vote-with-tokenize-share-record 1 3 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			proposalId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			options, err := govv1.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[2]))
+			if err != nil {
+				return err
+			}
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteWithTokenizeShareRecord(clientCtx.GetFromAddress(), recordId, proposalId, options, metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitValidatorLiquidStakingPauseProposal implements a command handler for submitting a
+// validator liquid staking pause proposal transaction.
+func NewCmdSubmitValidatorLiquidStakingPauseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-liquid-staking-pause [validator-addr] [paused]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to pause or unpause liquid staking to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause or unpause liquid staking to a validator along with an initial deposit.
+While paused, shares delegated to the validator cannot be tokenized, but existing share tokens can still be redeemed.
+
+Example:
+$ %s tx gov submit-proposal validator-liquid-staking-pause %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --title="Pause liquid staking" --description="Validator under investigation" --deposit=1000stake --from=<key_or_address>
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewValidatorLiquidStakingPauseProposal(title, description, valAddr, paused)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
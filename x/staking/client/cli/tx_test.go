@@ -66,6 +66,16 @@ func TestPrepareConfigForTxCreateValidator(t *testing.T) {
 				fs.Set(FlagCommissionMaxChangeRate, "0.55")
 			},
 			expectedCfg: mkTxValCfg(defaultAmount, "0.1", "0.2", "0.55"),
+		}, {
+			name: "Custom commission liquid rate",
+			fsModify: func(fs *pflag.FlagSet) {
+				fs.Set(FlagCommissionLiquidRate, "0.15")
+			},
+			expectedCfg: func() TxCreateValidatorConfig {
+				cfg := mkTxValCfg(defaultAmount, "0.1", "0.2", "0.01")
+				cfg.CommissionLiquidRate = "0.15"
+				return cfg
+			}(),
 		},
 	}
 
@@ -31,3 +31,29 @@ func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commiss
 
 	return commission, nil
 }
+
+func buildLiquidCommissionRate(liquidRateStr string) (*sdk.Dec, error) {
+	if liquidRateStr == "" {
+		return nil, nil
+	}
+
+	liquidRate, err := sdk.NewDecFromStr(liquidRateStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &liquidRate, nil
+}
+
+func buildMaxLiquidShareRatio(ratioStr string) (*sdk.Dec, error) {
+	if ratioStr == "" {
+		return nil, nil
+	}
+
+	ratio, err := sdk.NewDecFromStr(ratioStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ratio, nil
+}
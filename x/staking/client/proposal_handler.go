@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/client/cli"
+)
+
+// ProposalHandler is the validator liquid staking pause proposal handler.
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitValidatorLiquidStakingPauseProposal)
+)
@@ -44,6 +44,41 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	for _, valAddr := range data.LiquidStakingPausedValidators {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return fmt.Errorf("invalid liquid staking paused validator %s: %w", valAddr, err)
+		}
+	}
+
+	for _, account := range data.ValidatorBondAccounts {
+		if _, err := sdk.ValAddressFromBech32(account.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator bond account validator %s: %w", account.ValidatorAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(account.AccountAddress); err != nil {
+			return fmt.Errorf("invalid validator bond account %s: %w", account.AccountAddress, err)
+		}
+	}
+
+	for _, valAddr := range data.ValidatorBondAllowlistValidators {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return fmt.Errorf("invalid validator bond allowlist validator %s: %w", valAddr, err)
+		}
+	}
+
+	offerIds := make(map[uint64]bool, len(data.ShareOffers))
+	for _, offer := range data.ShareOffers {
+		if err := offer.Validate(); err != nil {
+			return fmt.Errorf("invalid share offer %d: %w", offer.Id, err)
+		}
+		if offer.Id > data.LastShareOfferId {
+			return fmt.Errorf("share offer id %d is greater than the last share offer id %d", offer.Id, data.LastShareOfferId)
+		}
+		if offerIds[offer.Id] {
+			return fmt.Errorf("duplicate share offer id %d", offer.Id)
+		}
+		offerIds[offer.Id] = true
+	}
+
 	return data.Params.Validate()
 }
 
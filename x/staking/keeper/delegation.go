@@ -700,12 +700,19 @@ func (k Keeper) Delegate(
 		}
 	}
 
-	_, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	// shares added to a validator bond delegation count towards the validator bond
+	if delegation.ValidatorBond {
+		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+	}
+	k.updateValidatorTokenizedShares(ctx, delegatorAddress, delegation.GetValidatorAddr(), newShares)
+
 	// Call the after-modification hook
 	if err := k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
 		return newShares, err
@@ -748,6 +755,8 @@ func (k Keeper) Unbond(
 		return amount, err
 	}
 
+	k.updateValidatorTokenizedShares(ctx, delegatorAddress, valAddr, shares.Neg())
+
 	if delegation.Shares.IsZero() {
 		err = k.RemoveDelegation(ctx, delegation)
 	} else {
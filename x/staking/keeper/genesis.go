@@ -26,7 +26,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	// genesis.json are in block 0.
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
-	k.SetParams(ctx, data.Params)
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
@@ -101,6 +103,39 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, valAddrStr := range data.LiquidStakingPausedValidators {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorLiquidStakingPaused(ctx, valAddr)
+	}
+
+	for _, account := range data.ValidatorBondAccounts {
+		valAddr, err := sdk.ValAddressFromBech32(account.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		accAddr, err := sdk.AccAddressFromBech32(account.AccountAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorBondAccount(ctx, valAddr, accAddr)
+	}
+
+	for _, valAddrStr := range data.ValidatorBondAllowlistValidators {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			panic(err)
+		}
+		k.EnableValidatorBondAllowlist(ctx, valAddr)
+	}
+
+	for _, offer := range data.ShareOffers {
+		k.SetShareOffer(ctx, offer)
+	}
+	k.SetLastShareOfferId(ctx, data.LastShareOfferId)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -202,5 +237,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		LiquidStakingPausedValidators:    k.GetAllLiquidStakingPausedValidators(ctx),
+		ValidatorBondAccounts:            k.GetAllValidatorBondAccounts(ctx),
+		ValidatorBondAllowlistValidators: k.GetAllValidatorBondAllowlistValidators(ctx),
+		ShareOffers:                      k.GetAllShareOffers(ctx),
+		LastShareOfferId:                 k.GetLastShareOfferId(ctx),
 	}
 }
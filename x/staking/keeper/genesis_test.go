@@ -129,12 +129,11 @@ func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 		Description:     types.NewDescription("bloop", "", "", "", ""),
 	}
 
-	params := types.Params{
-		UnbondingTime: 10000,
-		MaxValidators: 1,
-		MaxEntries:    10,
-		BondDenom:     "stake",
-	}
+	params := types.DefaultParams()
+	params.UnbondingTime = 10000
+	params.MaxValidators = 1
+	params.MaxEntries = 10
+	params.BondDenom = "stake"
 
 	require.Panics(t, func() {
 		// setting validator status to bonded so the balance counts towards bonded pool
@@ -159,6 +158,20 @@ func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 	)
 }
 
+func TestInitGenesis_InvalidParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	// a minimum validator bond requires a grace period
+	params := types.DefaultParams()
+	params.MinValidatorBond = sdk.OneDec()
+	params.MinValidatorBondGracePeriod = 0
+
+	require.Panics(t, func() {
+		app.StakingKeeper.InitGenesis(ctx, &types.GenesisState{Params: params})
+	})
+}
+
 func TestInitGenesisLargeValidatorSet(t *testing.T) {
 	size := 200
 	require.True(t, size > 100)
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -552,7 +554,7 @@ func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryToke
 	}
 
 	return &types.QueryTokenizeShareRecordByIdResponse{
-		Record: record,
+		Record: k.withCurrentOwner(ctx, record),
 	}, nil
 }
 
@@ -569,7 +571,7 @@ func (k Querier) TokenizeShareRecordByDenom(c context.Context, req *types.QueryT
 	}
 
 	return &types.QueryTokenizeShareRecordByDenomResponse{
-		Record: record,
+		Record: k.withCurrentOwner(ctx, record),
 	}, nil
 }
 
@@ -598,6 +600,9 @@ func (k Querier) AllTokenizeShareRecords(c context.Context, req *types.QueryAllT
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 	records := k.GetAllTokenizeShareRecords(ctx)
+	for i, record := range records {
+		records[i] = k.withCurrentOwner(ctx, record)
+	}
 
 	return &types.QueryAllTokenizeShareRecordsResponse{
 		Records: records,
@@ -649,3 +654,161 @@ func (k Querier) TotalTokenizeSharedAssets(c context.Context, req *types.QueryTo
 		Value: sdk.NewCoin(k.BondDenom(ctx), totalTokenizeShared),
 	}, nil
 }
+
+// Query for bonded validators below the minimum validator bond
+func (k Querier) ValidatorBondShortfalls(c context.Context, req *types.QueryValidatorBondShortfallsRequest) (*types.QueryValidatorBondShortfallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorBondShortfallsResponse{
+		Shortfalls: k.GetAllValidatorBondShortfalls(ctx),
+	}, nil
+}
+
+// LiquidStakingPausedValidators queries the validators for which liquid staking is paused
+func (k Querier) LiquidStakingPausedValidators(c context.Context, req *types.QueryLiquidStakingPausedValidatorsRequest) (*types.QueryLiquidStakingPausedValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryLiquidStakingPausedValidatorsResponse{
+		ValidatorAddresses: k.GetAllLiquidStakingPausedValidators(ctx),
+	}, nil
+}
+
+// ValidatorBondAccounts queries the accounts in a validator's validator bond allowlist
+func (k Querier) ValidatorBondAccounts(c context.Context, req *types.QueryValidatorBondAccountsRequest) (*types.QueryValidatorBondAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorBondAccountsResponse{
+		AccountAddresses: k.GetValidatorBondAccounts(ctx, valAddr),
+		Enabled:          k.HasValidatorBondAllowlist(ctx, valAddr),
+	}, nil
+}
+
+// ShareOffer queries a share offer by id
+func (k Querier) ShareOffer(c context.Context, req *types.QueryShareOfferRequest) (*types.QueryShareOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offer, found := k.GetShareOffer(ctx, req.OfferId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "share offer %d not found", req.OfferId)
+	}
+
+	return &types.QueryShareOfferResponse{Offer: offer}, nil
+}
+
+// ShareOffers queries all open share offers
+func (k Querier) ShareOffers(c context.Context, req *types.QueryShareOffersRequest) (*types.QueryShareOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	offerStore := prefix.NewStore(store, types.ShareOfferKey)
+
+	var offers []types.ShareOffer
+	pageRes, err := query.Paginate(offerStore, req.Pagination, func(key []byte, value []byte) error {
+		var offer types.ShareOffer
+		if err := k.cdc.Unmarshal(value, &offer); err != nil {
+			return err
+		}
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShareOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// ShareOffersByValidator queries the open share offers of shares delegated to a validator
+func (k Querier) ShareOffersByValidator(c context.Context, req *types.QueryShareOffersByValidatorRequest) (*types.QueryShareOffersByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	valStore := prefix.NewStore(store, types.GetShareOffersByValidatorPrefix(valAddr))
+
+	// an offer has one key per denom delegated to the validator, only the first
+	// one is counted
+	var offers []types.ShareOffer
+	pageRes, err := query.FilteredPaginate(valStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		offerId := sdk.BigEndianToUint64(key[:8])
+		iter := sdk.KVStorePrefixIterator(valStore, key[:8])
+		first := bytes.Equal(iter.Key(), key)
+		iter.Close()
+		if !first {
+			return false, nil
+		}
+
+		if accumulate {
+			offer, found := k.GetShareOffer(ctx, offerId)
+			if !found {
+				return false, fmt.Errorf("share offer %d not found", offerId)
+			}
+			offers = append(offers, offer)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShareOffersByValidatorResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// ShareOffersByDenom queries the open share offers of a share token denom
+func (k Querier) ShareOffersByDenom(c context.Context, req *types.QueryShareOffersByDenomRequest) (*types.QueryShareOffersByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	denomStore := prefix.NewStore(store, types.GetShareOffersByDenomPrefix(req.Denom))
+
+	var offers []types.ShareOffer
+	pageRes, err := query.Paginate(denomStore, req.Pagination, func(key []byte, _ []byte) error {
+		offerId := sdk.BigEndianToUint64(key)
+		offer, found := k.GetShareOffer(ctx, offerId)
+		if !found {
+			return fmt.Errorf("share offer %d not found", offerId)
+		}
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShareOffersByDenomResponse{Offers: offers, Pagination: pageRes}, nil
+}
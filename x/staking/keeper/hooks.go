@@ -94,3 +94,51 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 	}
 	return nil
 }
+
+// AfterTokenizeShareRecordsSlashed - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordsSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec, recordIds []uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordsSlashed(ctx, valAddr, fraction, recordIds)
+	}
+	return nil
+}
+
+// AfterTokenizeShareRecordsTombstoned - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordsTombstoned(ctx sdk.Context, valAddr sdk.ValAddress, recordIds []uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordsTombstoned(ctx, valAddr, recordIds)
+	}
+	return nil
+}
+
+// AfterTokenizeShareRecordCreated - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordCreated(ctx sdk.Context, recordId uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordCreated(ctx, recordId)
+	}
+	return nil
+}
+
+// AfterTokenizeSharesRedeemed - call hook if registered
+func (k Keeper) AfterTokenizeSharesRedeemed(ctx sdk.Context, recordId uint64, delAddr sdk.AccAddress, shares sdk.Dec) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeSharesRedeemed(ctx, recordId, delAddr, shares)
+	}
+	return nil
+}
+
+// AfterTokenizeShareRecordTransferred - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordId uint64, from, to sdk.AccAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordTransferred(ctx, recordId, from, to)
+	}
+	return nil
+}
+
+// AfterValidatorBondChanged - call hook if registered
+func (k Keeper) AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterValidatorBondChanged(ctx, delAddr, valAddr)
+	}
+	return nil
+}
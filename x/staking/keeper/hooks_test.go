@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// hookRecorder records the calls of the liquid staking hooks, together with the
+// delegation hooks they are ordered against. The embedded empty MultiStakingHooks
+// makes every other hook a no-op. Validator bond changes are recorded with the
+// validator's total validator bond shares at the time of the call, and fail
+// with bondErr if it is set.
+type hookRecorder struct {
+	types.MultiStakingHooks
+	keeper  *keeper.Keeper
+	bondErr error
+	calls   []string
+}
+
+func (h *hookRecorder) record(format string, args ...interface{}) error {
+	h.calls = append(h.calls, fmt.Sprintf(format, args...))
+	return nil
+}
+
+func (h *hookRecorder) reset() []string {
+	calls := h.calls
+	h.calls = nil
+	return calls
+}
+
+func (h *hookRecorder) AfterDelegationModified(_ sdk.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.record("AfterDelegationModified %s", delAddr)
+}
+
+func (h *hookRecorder) BeforeTokenizeShareRecordRemoved(_ sdk.Context, recordId uint64) error {
+	return h.record("BeforeTokenizeShareRecordRemoved %d", recordId)
+}
+
+func (h *hookRecorder) AfterTokenizeShareRecordCreated(_ sdk.Context, recordId uint64) error {
+	return h.record("AfterTokenizeShareRecordCreated %d", recordId)
+}
+
+func (h *hookRecorder) AfterTokenizeSharesRedeemed(_ sdk.Context, recordId uint64, delAddr sdk.AccAddress, _ sdk.Dec) error {
+	return h.record("AfterTokenizeSharesRedeemed %d %s", recordId, delAddr)
+}
+
+func (h *hookRecorder) AfterTokenizeShareRecordTransferred(_ sdk.Context, recordId uint64, from, to sdk.AccAddress) error {
+	return h.record("AfterTokenizeShareRecordTransferred %d %s %s", recordId, from, to)
+}
+
+func (h *hookRecorder) AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	validator, _ := h.keeper.GetLiquidValidator(ctx, valAddr)
+	if err := h.record("AfterValidatorBondChanged %s %s", delAddr, validator.TotalValidatorBondShares); err != nil {
+		return err
+	}
+	return h.bondErr
+}
+
+func TestLiquidStakingHooksCallOrder(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	recorder := &hookRecorder{keeper: &app.StakingKeeper}
+	app.StakingKeeper.SetHooks(types.NewMultiStakingHooks(recorder))
+	app.StakingKeeper.SetNFTKeeper(app.NFTKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	operator, delegator, newOwner := addrs[0], addrs[1], addrs[2]
+	valAddr := sdk.ValAddress(operator)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, simapp.CreateTestPubKeys(1)[0], 100, true)
+	tstaking.Delegate(delegator, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	recorder.reset()
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	bondShares := func() sdk.Dec {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		require.True(t, found)
+		return validator.TotalValidatorBondShares
+	}
+
+	// validator bond changes are reported once the validator's total is written
+	_, err := msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(operator, valAddr))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("AfterValidatorBondChanged %s %s", operator, bondShares()),
+	}, recorder.reset())
+
+	// delegations to a validator bond delegation leave the total unchanged
+	tstaking.Delegate(operator, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	require.Equal(t, []string{
+		"AfterDelegationModified " + operator.String(),
+	}, recorder.reset())
+
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(operator, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 1))))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"AfterDelegationModified " + operator.String(),
+		fmt.Sprintf("AfterValidatorBondChanged %s %s", operator, bondShares()),
+	}, recorder.reset())
+
+	// slashed validator bond delegations are reported once the total is written
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondSlashMultiplier = sdk.NewDec(3)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+	consAddr := sdk.ConsAddress(simapp.CreateTestPubKeys(1)[0].Address())
+	require.True(t, app.StakingKeeper.SlashValidatorBondDelegations(ctx, consAddr, sdk.NewDecWithPrec(1, 1)).IsPositive())
+	require.Equal(t, []string{
+		"AfterDelegationModified " + operator.String(),
+		fmt.Sprintf("AfterValidatorBondChanged %s %s", operator, bondShares()),
+	}, recorder.reset())
+
+	// a failing hook does not halt the slash
+	recorder.bondErr = errors.New("hook failed")
+	require.NotPanics(t, func() {
+		require.True(t, app.StakingKeeper.SlashValidatorBondDelegations(ctx, consAddr, sdk.NewDecWithPrec(1, 1)).IsPositive())
+	})
+	recorder.bondErr = nil
+	recorder.reset()
+
+	// records are created once the record's delegation exists
+	tokenizeRes, err := msgServer.TokenizeShares(goCtx, &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5)),
+		TokenizedShareOwner: delegator.String(),
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, tokenizeRes.Amount.Denom)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"AfterDelegationModified " + delegator.String(),
+		"AfterDelegationModified " + record.GetModuleAddress().String(),
+		fmt.Sprintf("AfterTokenizeShareRecordCreated %d", record.Id),
+	}, recorder.reset())
+
+	_, err = msgServer.TransferTokenizeShareRecord(goCtx, &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                delegator.String(),
+		NewOwner:              newOwner.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("AfterTokenizeShareRecordTransferred %d %s %s", record.Id, delegator, newOwner),
+	}, recorder.reset())
+
+	// an nft send of the record's NFT is reported as a transfer
+	_, err = keeper.NewNFTMsgServerImpl(app.StakingKeeper, app.NFTKeeper).Send(goCtx, &nft.MsgSend{
+		ClassId:  types.TokenizeShareRecordNFTClassId,
+		Id:       record.GetNFTId(),
+		Sender:   newOwner.String(),
+		Receiver: operator.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("AfterTokenizeShareRecordTransferred %d %s %s", record.Id, newOwner, operator),
+	}, recorder.reset())
+
+	_, err = msgServer.TransferTokenizeShareRecord(goCtx, &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                operator.String(),
+		NewOwner:              delegator.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("AfterTokenizeShareRecordTransferred %d %s %s", record.Id, operator, delegator),
+	}, recorder.reset())
+
+	// redeeming all share tokens removes the record before the redemption is reported
+	_, err = msgServer.RedeemTokens(goCtx, &types.MsgRedeemTokensforShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           tokenizeRes.Amount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("BeforeTokenizeShareRecordRemoved %d", record.Id),
+		"AfterDelegationModified " + delegator.String(),
+		fmt.Sprintf("AfterTokenizeSharesRedeemed %d %s", record.Id, delegator),
+	}, recorder.reset())
+}
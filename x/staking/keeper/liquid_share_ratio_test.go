@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestMaxLiquidShareRatio() {
+	app, ctx := suite.app, suite.ctx
+	delAddr, valAddr := suite.addrs[0], suite.vals[0].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	tokenize := func(power int64) error {
+		_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, power)),
+			TokenizedShareOwner: delAddr.String(),
+		})
+		return err
+	}
+
+	// the operator allows at most half of the delegator shares to be liquid
+	ratio := sdk.NewDecWithPrec(5, 1)
+	_, err := msgServer.EditValidator(sdk.WrapSDKContext(ctx), &types.MsgEditValidator{
+		Description:         types.NewDescription(types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc),
+		ValidatorAddress:    valAddr.String(),
+		MaxLiquidShareRatio: &ratio,
+	})
+	suite.Require().NoError(err)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	suite.Require().True(found)
+	suite.Require().NotNil(validator.MaxLiquidShareRatio)
+	suite.Equal(ratio, *validator.MaxLiquidShareRatio)
+
+	// the validator has 9 power, so 4 can be tokenized but not 5
+	suite.Require().NoError(tokenize(2))
+	suite.ErrorIs(tokenize(3), types.ErrValidatorLiquidShareRatioExceeded)
+	suite.Require().NoError(tokenize(2))
+
+	// editing the validator without a ratio keeps the current one
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), &types.MsgEditValidator{
+		Description:      types.NewDescription(types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	suite.Equal(ratio, *validator.MaxLiquidShareRatio)
+	suite.ErrorIs(tokenize(1), types.ErrValidatorLiquidShareRatioExceeded)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// DelegatorIsLiquidStaker returns true if the delegator is a liquid staking
+// provider. Interchain accounts, like other accounts derived from a module
+// address, have 32 byte addresses, whereas user accounts and the module accounts
+// of tokenize share records have 20 byte addresses.
+func DelegatorIsLiquidStaker(delegator sdk.AccAddress) bool {
+	return len(delegator) == address.Len
+}
+
+// CheckLiquidShareLimits returns an error if liquid staking to the validator is
+// paused, or if adding the liquid shares to the validator would exceed the
+// validator bond cap or the validator's max liquid share ratio
+func (k Keeper) CheckLiquidShareLimits(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	if k.IsValidatorLiquidStakingPaused(ctx, validator.GetOperator()) {
+		return types.ErrValidatorLiquidStakingPaused
+	}
+
+	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	if !validatorBondFactor.IsNegative() {
+		maxValTotalShare := validator.TotalValidatorBondShares.Mul(validatorBondFactor)
+		if validator.TotalLiquidShares.Add(shares).GT(maxValTotalShare) {
+			return types.ErrInsufficientValidatorBondShares
+		}
+	}
+
+	if validator.ExceedsMaxLiquidShareRatio(shares) {
+		return types.ErrValidatorLiquidShareRatioExceeded
+	}
+
+	return nil
+}
+
+// SafelyIncreaseValidatorLiquidShares adds liquid shares to the validator if
+// they are within the liquid share limits of the validator
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator := k.mustGetLiquidValidator(ctx, valAddr)
+	if err := k.CheckLiquidShareLimits(ctx, validator, shares); err != nil {
+		return err
+	}
+
+	validator.TotalLiquidShares = validator.TotalLiquidShares.Add(shares)
+	k.SetValidator(ctx, validator)
+	return nil
+}
+
+// DecreaseValidatorLiquidShares removes liquid shares from the validator, returning
+// an error if more shares are removed than the validator's total liquid shares. A
+// validator removed by the unbonding of its last shares has nothing left to track.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil
+	}
+
+	if shares.GT(validator.TotalLiquidShares) {
+		return errorsmod.Wrapf(types.ErrValidatorLiquidSharesUnderflow, "%s > %s", shares, validator.TotalLiquidShares)
+	}
+
+	validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+	return nil
+}
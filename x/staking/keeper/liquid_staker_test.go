@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestLiquidStakerDelegations() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	valAddr1, valAddr2 := suite.vals[0].GetOperator(), suite.vals[1].GetOperator()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// interchain accounts have 32 byte addresses
+	liquidStaker := sdk.AccAddress(address.Module("icahost", []byte("owner")))
+	suite.True(keeper.DelegatorIsLiquidStaker(liquidStaker))
+	suite.False(keeper.DelegatorIsLiquidStaker(suite.addrs[0]))
+
+	tokens := func(power int64) sdk.Coin {
+		return sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, liquidStaker, sdk.NewCoins(tokens(10))))
+
+	liquidShares := func(valAddr sdk.ValAddress) sdk.Dec {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		suite.Require().True(found)
+		return validator.TotalLiquidShares
+	}
+	delegationShares := func(valAddr sdk.ValAddress) sdk.Dec {
+		delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, liquidStaker, valAddr)
+		if !found {
+			return sdk.ZeroDec()
+		}
+		return delegation.Shares
+	}
+
+	// delegations of regular accounts are not liquid
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(suite.addrs[0], valAddr1, tokens(1)))
+	suite.Require().NoError(err)
+	suite.True(liquidShares(valAddr1).IsZero())
+
+	// delegations of liquid staking providers are
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(liquidStaker, valAddr1, tokens(5)))
+	suite.Require().NoError(err)
+	suite.Equal(delegationShares(valAddr1), liquidShares(valAddr1))
+
+	// the liquid shares move with a redelegation
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegate(liquidStaker, valAddr1, valAddr2, tokens(2)))
+	suite.Require().NoError(err)
+	suite.Equal(delegationShares(valAddr1), liquidShares(valAddr1))
+	suite.Equal(delegationShares(valAddr2), liquidShares(valAddr2))
+
+	// and are removed on undelegation
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(liquidStaker, valAddr2, tokens(2)))
+	suite.Require().NoError(err)
+	suite.True(liquidShares(valAddr2).IsZero())
+
+	// liquid staking providers cannot validator bond
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(liquidStaker, valAddr1))
+	suite.ErrorIs(err, types.ErrValidatorBondNotAllowedFromLiquidStaker)
+
+	// and their delegations are subject to the liquid share limits
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr1)
+	suite.Require().True(found)
+	maxRatio := liquidShares(valAddr1).Quo(validator.DelegatorShares)
+	validator.MaxLiquidShareRatio = &maxRatio
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(liquidStaker, valAddr1, tokens(1)))
+	suite.ErrorIs(err, types.ErrValidatorLiquidShareRatioExceeded)
+}
+
+func (suite *KeeperTestSuite) TestMigrate9to10() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	valAddr := suite.vals[0].GetOperator()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	amount := sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5))
+
+	liquidStaker := sdk.AccAddress(address.Module("icahost", []byte("owner")))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, liquidStaker, sdk.NewCoins(amount)))
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(liquidStaker, valAddr, amount))
+	suite.Require().NoError(err)
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, liquidStaker, valAddr)
+	suite.Require().True(found)
+
+	// a liquid staking provider delegation made before it was counted as liquid
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	suite.Require().True(found)
+	validator.TotalLiquidShares = sdk.ZeroDec()
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// cannot be undelegated without underflowing the liquid shares
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(cacheCtx), types.NewMsgUndelegate(liquidStaker, valAddr, amount))
+	suite.ErrorIs(err, types.ErrValidatorLiquidSharesUnderflow)
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, app.GetSubspace(types.ModuleName)).Migrate9to10(ctx))
+
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(delegation.Shares, validator.TotalLiquidShares)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(liquidStaker, valAddr, amount))
+	suite.Require().NoError(err)
+}
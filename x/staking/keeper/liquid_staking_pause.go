@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// IsValidatorLiquidStakingPaused returns true if liquid staking to a validator
+// is paused by governance
+func (k Keeper) IsValidatorLiquidStakingPaused(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetLiquidStakingPausedValidatorKey(valAddr))
+}
+
+// SetValidatorLiquidStakingPaused pauses liquid staking to a validator
+func (k Keeper) SetValidatorLiquidStakingPaused(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLiquidStakingPausedValidatorKey(valAddr), []byte{})
+}
+
+// DeleteValidatorLiquidStakingPaused unpauses liquid staking to a validator
+func (k Keeper) DeleteValidatorLiquidStakingPaused(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLiquidStakingPausedValidatorKey(valAddr))
+}
+
+// IterateLiquidStakingPausedValidators iterates over the validators for which
+// liquid staking is paused
+func (k Keeper) IterateLiquidStakingPausedValidators(ctx sdk.Context, handler func(valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.LiquidStakingPausedValidatorKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromLiquidStakingPausedValidatorKey(iter.Key()))
+		if handler(valAddr) {
+			break
+		}
+	}
+}
+
+// GetAllLiquidStakingPausedValidators returns the operator addresses of the
+// validators for which liquid staking is paused
+func (k Keeper) GetAllLiquidStakingPausedValidators(ctx sdk.Context) (valAddrs []string) {
+	k.IterateLiquidStakingPausedValidators(ctx, func(valAddr sdk.ValAddress) bool {
+		valAddrs = append(valAddrs, valAddr.String())
+		return false
+	})
+	return valAddrs
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestValidatorLiquidStakingPause() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	delAddr, valAddr := suite.addrs[0], suite.vals[0].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	tokenize := func(amount int64) (*types.MsgTokenizeSharesResponse, error) {
+		return msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, amount)),
+			TokenizedShareOwner: delAddr.String(),
+		})
+	}
+
+	// tokenize shares before the validator is paused
+	tokenizeResp, err := tokenize(1)
+	suite.Require().NoError(err)
+
+	// pause liquid staking to the validator
+	proposal := types.NewValidatorLiquidStakingPauseProposal("title", "description", valAddr, true)
+	suite.Require().NoError(proposal.ValidateBasic())
+	suite.Require().NoError(keeper.HandleValidatorLiquidStakingPauseProposal(ctx, app.StakingKeeper, proposal))
+	suite.True(app.StakingKeeper.IsValidatorLiquidStakingPaused(ctx, valAddr))
+	suite.False(app.StakingKeeper.IsValidatorLiquidStakingPaused(ctx, suite.vals[1].GetOperator()))
+
+	res, err := queryClient.LiquidStakingPausedValidators(gocontext.Background(), &types.QueryLiquidStakingPausedValidatorsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal([]string{valAddr.String()}, res.ValidatorAddresses)
+	suite.Equal(res.ValidatorAddresses, app.StakingKeeper.ExportGenesis(ctx).LiquidStakingPausedValidators)
+
+	// shares of a paused validator can no longer be tokenized, but can be redeemed
+	_, err = tokenize(1)
+	suite.ErrorIs(err, types.ErrValidatorLiquidStakingPaused)
+
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: delAddr.String(),
+		Amount:           tokenizeResp.Amount,
+	})
+	suite.Require().NoError(err)
+
+	// unpause liquid staking to the validator
+	proposal.Paused = false
+	suite.Require().NoError(keeper.HandleValidatorLiquidStakingPauseProposal(ctx, app.StakingKeeper, proposal))
+	suite.False(app.StakingKeeper.IsValidatorLiquidStakingPaused(ctx, valAddr))
+	suite.Empty(app.StakingKeeper.GetAllLiquidStakingPausedValidators(ctx))
+
+	_, err = tokenize(1)
+	suite.Require().NoError(err)
+
+	// a proposal for an unknown validator fails
+	proposal = types.NewValidatorLiquidStakingPauseProposal("title", "description", sdk.ValAddress("unknown"), true)
+	suite.Error(keeper.HandleValidatorLiquidStakingPauseProposal(ctx, app.StakingKeeper, proposal))
+}
+
+func (suite *KeeperTestSuite) TestValidatorLiquidStakingPauseLiquidStaker() {
+	app, ctx := suite.app, suite.ctx
+	valAddr, otherValAddr := suite.vals[0].GetOperator(), suite.vals[1].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	tokens := func(power int64) sdk.Coin {
+		return sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+
+	// an interchain account delegates to both validators and starts unbonding
+	// from the validator to pause
+	liquidStaker := sdk.AccAddress(address.Module("icahost", []byte("owner")))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, liquidStaker, sdk.NewCoins(tokens(10))))
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(liquidStaker, valAddr, tokens(3)))
+	suite.Require().NoError(err)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(liquidStaker, otherValAddr, tokens(3)))
+	suite.Require().NoError(err)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(liquidStaker, valAddr, tokens(1)))
+	suite.Require().NoError(err)
+
+	proposal := types.NewValidatorLiquidStakingPauseProposal("title", "description", valAddr, true)
+	suite.Require().NoError(keeper.HandleValidatorLiquidStakingPauseProposal(ctx, app.StakingKeeper, proposal))
+
+	// the liquid staking provider can no longer add shares to the paused validator
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(liquidStaker, valAddr, tokens(1)))
+	suite.ErrorIs(err, types.ErrValidatorLiquidStakingPaused)
+
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegate(liquidStaker, otherValAddr, valAddr, tokens(1)))
+	suite.ErrorIs(err, types.ErrValidatorLiquidStakingPaused)
+
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), types.NewMsgCancelUnbondingDelegation(liquidStaker, valAddr, ctx.BlockHeight(), tokens(1)))
+	suite.ErrorIs(err, types.ErrValidatorLiquidStakingPaused)
+
+	// but can still leave it, while regular accounts can still delegate to it
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(liquidStaker, valAddr, tokens(1)))
+	suite.Require().NoError(err)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(suite.addrs[0], valAddr, tokens(1)))
+	suite.Require().NoError(err)
+}
@@ -1 +1,140 @@
 package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/exported"
+	v047 "github.com/iqlusioninc/liquidity-staking-module/x/staking/migrations/v047"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{
+		keeper:         keeper,
+		legacySubspace: legacySubspace,
+	}
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// The migration indexes the existing tokenize share records by module account
+// and sums the shares of each validator held by their module accounts.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	for _, record := range m.keeper.GetAllTokenizeShareRecords(ctx) {
+		m.keeper.setTokenizeShareRecordWithModuleAccount(ctx, record.GetModuleAddress(), record.Id)
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return err
+		}
+		delegation, found := m.keeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			continue
+		}
+		shares := m.keeper.GetValidatorTokenizedShares(ctx, valAddr).Add(delegation.Shares)
+		m.keeper.setValidatorTokenizedShares(ctx, valAddr, shares)
+	}
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// The migration sets the minimum validator bond param to its default value.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.legacySubspace.Set(ctx, types.KeyMinValidatorBond, types.DefaultMinValidatorBond)
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+// The migration sets the minimum validator bond grace period param to its default value.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.legacySubspace.Set(ctx, types.KeyMinValidatorBondGracePeriod, types.DefaultMinValidatorBondGracePeriod)
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+// The migration sets the validator bond slash multiplier param to its default value.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.legacySubspace.Set(ctx, types.KeyValidatorBondSlashMultiplier, types.DefaultValidatorBondSlashMultiplier)
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+// The migration sets the tombstone fallback validator param to its default value,
+// fixes the share token denom of the existing tokenize share records and indexes
+// them by validator.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.legacySubspace.Set(ctx, types.KeyTombstoneFallbackValidator, types.DefaultTombstoneFallbackValidator)
+
+	for _, record := range m.keeper.GetAllTokenizeShareRecords(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return err
+		}
+		record.Denom = record.GetShareTokenDenom()
+		m.keeper.setTokenizeShareRecord(ctx, record)
+		m.keeper.setTokenizeShareRecordWithValidator(ctx, valAddr, record.Id)
+	}
+	return nil
+}
+
+// Migrate8to9 migrates from version 8 to 9.
+// The migration mints the NFTs of the existing tokenize share records when the
+// records are represented by NFTs.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	for _, record := range m.keeper.GetAllTokenizeShareRecords(ctx) {
+		if err := m.keeper.mintTokenizeShareRecordNFT(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate9to10 migrates from version 9 to 10.
+// The migration sets the liquid shares of each validator to its tokenized shares
+// plus the shares delegated by liquid staking providers, whose delegations made
+// before they were counted as liquid would otherwise underflow the total when
+// they are undelegated or redelegated.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	liquidStakerShares := make(map[string]sdk.Dec)
+	m.keeper.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+		if !DelegatorIsLiquidStaker(delegation.GetDelegatorAddr()) {
+			return false
+		}
+		shares, found := liquidStakerShares[delegation.ValidatorAddress]
+		if !found {
+			shares = sdk.ZeroDec()
+		}
+		liquidStakerShares[delegation.ValidatorAddress] = shares.Add(delegation.Shares)
+		return false
+	})
+
+	for _, validator := range m.keeper.GetAllValidators(ctx) {
+		liquidShares := m.keeper.GetValidatorTokenizedShares(ctx, validator.GetOperator())
+		if shares, found := liquidStakerShares[validator.OperatorAddress]; found {
+			liquidShares = liquidShares.Add(shares)
+		}
+		validator.TotalLiquidShares = liquidShares
+		m.keeper.SetValidator(ctx, validator)
+	}
+	return nil
+}
+
+// Migrate10to11 migrates from version 10 to 11.
+// The migration sets the tokenize share record voting enabled param to its default value.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	m.legacySubspace.Set(ctx, types.KeyTokenizeShareRecordVotingEnabled, types.DefaultTokenizeShareRecordVotingEnabled)
+	return nil
+}
+
+// Migrate11to12 migrates from version 11 to 12.
+// The migration moves the params from the legacy x/params subspace to the
+// x/staking store.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.legacySubspace)
+}
@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -99,11 +100,13 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		msg.Commission.Rate, msg.Commission.MaxRate,
 		msg.Commission.MaxChangeRate, ctx.BlockHeader().Time,
 	)
+	commission.LiquidCommissionRate = msg.Commission.LiquidCommissionRate
 
 	validator, err = validator.SetInitialCommission(commission)
 	if err != nil {
 		return nil, err
 	}
+	validator.MaxLiquidShareRatio = msg.MaxLiquidShareRatio
 
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
@@ -164,10 +167,26 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 
 	validator.Description = description
 
-	if msg.CommissionRate != nil {
-		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
-		if err != nil {
-			return nil, err
+	if msg.CommissionRate != nil || msg.LiquidCommissionRate != nil {
+		commission := validator.Commission
+
+		if msg.CommissionRate != nil {
+			commission, err = k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// both rates are validated against the commission prior to this edit so
+		// that they can be updated within the same message
+		if msg.LiquidCommissionRate != nil {
+			liquidCommission, err := k.UpdateValidatorLiquidCommission(ctx, validator, *msg.LiquidCommissionRate)
+			if err != nil {
+				return nil, err
+			}
+
+			commission.LiquidCommissionRate = liquidCommission.LiquidCommissionRate
+			commission.UpdateTime = liquidCommission.UpdateTime
 		}
 
 		// call the before-modification hook since we're about to update the commission
@@ -178,6 +197,11 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		validator.Commission = commission
 	}
 
+	// a stricter max liquid share ratio only blocks future tokenizations
+	if msg.MaxLiquidShareRatio != nil {
+		validator.MaxLiquidShareRatio = msg.MaxLiquidShareRatio
+	}
+
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -226,6 +250,13 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		return nil, err
 	}
 
+	// delegations from liquid staking providers count as liquid shares
+	if DelegatorIsLiquidStaker(delegatorAddress) {
+		if err := k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+			return nil, err
+		}
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "delegate")
@@ -283,21 +314,26 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 	}
 
 	// tokenize share vs validator bond delegation check if validator bond delegation
-	validatorBondFactor := k.ValidatorBondFactor(ctx)
-	if delegation.ValidatorBond && !validatorBondFactor.IsNegative() {
+	if delegation.ValidatorBond {
 		validator, found := k.GetLiquidValidator(ctx, valSrcAddr)
 		if !found {
 			return nil, sdkstaking.ErrNoValidatorFound
 		}
 
-		maxTokenizeShareAfter := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
-		if maxTokenizeShareAfter.LT(validator.TotalLiquidShares) {
-			return nil, types.ErrInsufficientValidatorBondShares
+		validatorBondFactor := k.ValidatorBondFactor(ctx)
+		if !validatorBondFactor.IsNegative() {
+			maxTokenizeShareAfter := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
+			if maxTokenizeShareAfter.LT(validator.TotalLiquidShares) {
+				return nil, types.ErrInsufficientValidatorBondShares
+			}
 		}
 
 		// reduce validator bond delegation on redelegation
 		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(shares)
 		k.SetValidator(ctx, validator)
+
+		// warn if the withdrawal drops the validator below the minimum validator bond
+		k.CheckValidatorBondShortfall(ctx, validator)
 	}
 
 	bondDenom := k.BondDenom(ctx)
@@ -312,6 +348,14 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	isLiquidStaker := DelegatorIsLiquidStaker(delegatorAddress)
+	dstSharesBefore := sdk.ZeroDec()
+	if isLiquidStaker {
+		if dstDelegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr); found {
+			dstSharesBefore = dstDelegation.Shares
+		}
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -319,6 +363,28 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// the validator bond shares and the validator's total are both written by now
+	if delegation.ValidatorBond {
+		if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, valSrcAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	// the liquid shares of a liquid staking provider move with the redelegation
+	if isLiquidStaker {
+		if err := k.DecreaseValidatorLiquidShares(ctx, valSrcAddr, shares); err != nil {
+			return nil, err
+		}
+
+		dstDelegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr)
+		if !found {
+			return nil, sdkstaking.ErrNoDelegation
+		}
+		if err := k.SafelyIncreaseValidatorLiquidShares(ctx, valDstAddr, dstDelegation.Shares.Sub(dstSharesBefore)); err != nil {
+			return nil, err
+		}
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "redelegate")
@@ -384,16 +450,21 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 	}
 
 	// tokenize share vs validator-bond delegation check if validator-bond delegation
-	validatorBondFactor := k.ValidatorBondFactor(ctx)
-	if delegation.ValidatorBond && !validatorBondFactor.IsNegative() {
-		maxTokenizeShareAfter := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
-		if maxTokenizeShareAfter.LT(validator.TotalLiquidShares) {
-			return nil, types.ErrInsufficientValidatorBondShares
+	if delegation.ValidatorBond {
+		validatorBondFactor := k.ValidatorBondFactor(ctx)
+		if !validatorBondFactor.IsNegative() {
+			maxTokenizeShareAfter := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
+			if maxTokenizeShareAfter.LT(validator.TotalLiquidShares) {
+				return nil, types.ErrInsufficientValidatorBondShares
+			}
 		}
 
 		// reduce total validator-bond delegation on unbond
 		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(shares)
 		k.SetValidator(ctx, validator)
+
+		// warn if the withdrawal drops the validator below the minimum validator bond
+		k.CheckValidatorBondShortfall(ctx, validator)
 	}
 
 	bondDenom := k.BondDenom(ctx)
@@ -408,6 +479,19 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		return nil, err
 	}
 
+	// the validator bond shares and the validator's total are both written by now
+	if delegation.ValidatorBond {
+		if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	if DelegatorIsLiquidStaker(delegatorAddress) {
+		if err := k.DecreaseValidatorLiquidShares(ctx, addr, shares); err != nil {
+			return nil, err
+		}
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "undelegate")
@@ -510,11 +594,17 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 	}
 
 	// delegate back the unbonding delegation amount to the validator
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, sdkstaking.Unbonding, validator, false)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, sdkstaking.Unbonding, validator, false)
 	if err != nil {
 		return nil, err
 	}
 
+	if DelegatorIsLiquidStaker(delegatorAddress) {
+		if err := k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+			return nil, err
+		}
+	}
+
 	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
 	if amount.IsZero() {
 		ubd.RemoveEntry(unbondEntryIndex)
@@ -576,6 +666,10 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, sdkstaking.ErrNoValidatorFound
 	}
 
+	if k.IsValidatorLiquidStakingPaused(ctx, valAddr) {
+		return nil, types.ErrValidatorLiquidStakingPaused
+	}
+
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, err
@@ -621,12 +715,12 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, err
 	}
 
-	// validator bond shares check before tokenize operation
-	validatorBondFactor := k.ValidatorBondFactor(ctx)
-	if !validatorBondFactor.IsNegative() {
-		maxValTotalShare := validator.TotalValidatorBondShares.Mul(validatorBondFactor)
-		if validator.TotalLiquidShares.Add(shares).GT(maxValTotalShare) {
-			return nil, types.ErrInsufficientValidatorBondShares
+	// the shares of a liquid staking provider already count as liquid shares,
+	// otherwise check the validator bond cap and max liquid share ratio
+	isLiquidStaker := DelegatorIsLiquidStaker(delegatorAddress)
+	if !isLiquidStaker {
+		if err := k.CheckLiquidShareLimits(ctx, validator, shares); err != nil {
+			return nil, err
 		}
 	}
 
@@ -668,7 +762,9 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 	}
 
 	// create reward ownership record
-	k.AddTokenizeShareRecord(ctx, record)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
 
 	// send coins to module account
 	err = k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), sdk.Coins{msg.Amount})
@@ -688,9 +784,15 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, err
 	}
 
-	validator, _ = k.GetLiquidValidator(ctx, valAddr)
-	validator.TotalLiquidShares = validator.TotalLiquidShares.Add(shares)
-	k.SetValidator(ctx, validator)
+	if !isLiquidStaker {
+		validator, _ = k.GetLiquidValidator(ctx, valAddr)
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(shares)
+		k.SetValidator(ctx, validator)
+	}
+
+	if err := k.AfterTokenizeShareRecordCreated(ctx, record.Id); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -794,9 +896,17 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 		return nil, err
 	}
 
-	validator, _ = k.GetLiquidValidator(ctx, valAddr)
-	validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
-	k.SetValidator(ctx, validator)
+	// shares redeemed by a liquid staking provider remain liquid
+	if !DelegatorIsLiquidStaker(delegatorAddress) {
+		validator, _ = k.GetLiquidValidator(ctx, valAddr)
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
+		k.SetValidator(ctx, validator)
+	}
+
+	// the record has already been removed if all of its shares were redeemed
+	if err := k.AfterTokenizeSharesRedeemed(ctx, record.Id, delegatorAddress, shares); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -820,26 +930,21 @@ func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types
 		return nil, types.ErrTokenizeShareRecordNotExists
 	}
 
-	if record.Owner != msg.Sender {
+	owner, err := k.getTokenizeShareRecordOwner(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	if owner.String() != msg.Sender {
 		return nil, types.ErrNotTokenizeShareRecordOwner
 	}
 
-	// Remove old account reference
-	oldOwner, err := sdk.AccAddressFromBech32(record.Owner)
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress
 	}
-	k.deleteTokenizeShareRecordWithOwner(ctx, oldOwner, record.Id)
-
-	record.Owner = msg.NewOwner
-	k.setTokenizeShareRecord(ctx, record)
-
-	// Set new account reference
-	newOwner, err := sdk.AccAddressFromBech32(record.Owner)
-	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress
+	if err := k.transferTokenizeShareRecord(ctx, record, newOwner); err != nil {
+		return nil, err
 	}
-	k.setTokenizeShareRecordWithOwner(ctx, newOwner, record.Id)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -876,11 +981,22 @@ func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorB
 		return nil, sdkstaking.ErrNoDelegation
 	}
 
+	if DelegatorIsLiquidStaker(delAddr) {
+		return nil, types.ErrValidatorBondNotAllowedFromLiquidStaker
+	}
+
+	if !delegation.ValidatorBond && !k.IsValidatorBondAllowed(ctx, valAddr, delAddr) {
+		return nil, types.ErrValidatorBondAccountNotAllowed
+	}
+
 	if !delegation.ValidatorBond {
 		delegation.ValidatorBond = true
 		k.SetDelegation(ctx, delegation)
 		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(delegation.Shares)
 		k.SetValidator(ctx, validator)
+		if err := k.AfterValidatorBondChanged(ctx, delAddr, valAddr); err != nil {
+			return nil, err
+		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -893,3 +1009,256 @@ func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorB
 
 	return &types.MsgValidatorBondResponse{}, nil
 }
+
+func (k msgServer) AddValidatorBondAccount(goCtx context.Context, msg *types.MsgAddValidatorBondAccount) (*types.MsgAddValidatorBondAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(msg.AccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetLiquidValidator(ctx, valAddr); !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+
+	if k.HasValidatorBondAccount(ctx, valAddr, accAddr) {
+		return nil, types.ErrValidatorBondAccountAlreadyExists
+	}
+
+	// adding an account opts the validator in to the allowlist
+	k.SetValidatorBondAccount(ctx, valAddr, accAddr)
+	k.EnableValidatorBondAllowlist(ctx, valAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddValidatorBondAccount,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+		),
+	)
+
+	return &types.MsgAddValidatorBondAccountResponse{}, nil
+}
+
+func (k msgServer) RemoveValidatorBondAccount(goCtx context.Context, msg *types.MsgRemoveValidatorBondAccount) (*types.MsgRemoveValidatorBondAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(msg.AccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.HasValidatorBondAccount(ctx, valAddr, accAddr) {
+		return nil, types.ErrValidatorBondAccountNotFound
+	}
+
+	k.DeleteValidatorBondAccount(ctx, valAddr, accAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveValidatorBondAccount,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+		),
+	)
+
+	return &types.MsgRemoveValidatorBondAccountResponse{}, nil
+}
+
+func (k msgServer) SetValidatorBondAllowlist(goCtx context.Context, msg *types.MsgSetValidatorBondAllowlist) (*types.MsgSetValidatorBondAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	if _, found := k.GetLiquidValidator(ctx, valAddr); !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+
+	// the listed accounts are kept when the operator opts out, so that they
+	// apply again if the operator opts back in
+	if msg.Enabled {
+		k.EnableValidatorBondAllowlist(ctx, valAddr)
+	} else {
+		k.DisableValidatorBondAllowlist(ctx, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetValidatorBondAllowlist,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetValidatorBondAllowlistResponse{}, nil
+}
+
+func (k msgServer) TransferValidatorBond(goCtx context.Context, msg *types.MsgTransferValidatorBond) (*types.MsgTransferValidatorBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.RecipientAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if delAddr.Equals(recipient) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("delegator and recipient cannot be the same")
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+
+	if DelegatorIsLiquidStaker(recipient) {
+		return nil, types.ErrValidatorBondNotAllowedFromLiquidStaker
+	}
+
+	if !k.IsValidatorBondAllowed(ctx, valAddr, recipient) {
+		return nil, types.ErrValidatorBondAccountNotAllowed
+	}
+
+	// a vesting recipient would not track the received shares as a free delegation
+	if _, ok := k.authKeeper.GetAccount(ctx, recipient).(vesting.VestingAccount); ok {
+		return nil, types.ErrValidatorBondTransferToVestingAccount
+	}
+
+	// as with tokenize share, only free (non-vesting) delegations may be handed
+	// over to another account
+	amount := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), validator.TokensFromShares(msg.Shares).Ceil().TruncateInt()))
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vesting.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(k.BondDenom(ctx)).LT(amount.AmountOf(k.BondDenom(ctx))) {
+			return nil, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	if err := k.Keeper.TransferValidatorBond(ctx, delAddr, recipient, valAddr, msg.Shares); err != nil {
+		return nil, err
+	}
+
+	// the transferred delegation no longer counts towards the free delegations of a vesting sender
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vesting.VestingAccount); ok && !amount.IsZero() {
+		acc.TrackUndelegation(amount)
+		k.authKeeper.SetAccount(ctx, acc)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferValidatorBond,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+			sdk.NewAttribute(types.AttributeKeyShares, msg.Shares.String()),
+		),
+	)
+
+	return &types.MsgTransferValidatorBondResponse{}, nil
+}
+
+// CreateShareOffer defines a method for offering share tokens and/or a tokenize
+// share record for sale, holding them in escrow
+func (k msgServer) CreateShareOffer(goCtx context.Context, msg *types.MsgCreateShareOffer) (*types.MsgCreateShareOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	offerId, err := k.Keeper.CreateShareOffer(ctx, seller, msg.ShareTokens, msg.TokenizeShareRecordId, msg.Price, msg.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateShareOfferResponse{OfferId: offerId}, nil
+}
+
+// AcceptShareOffer defines a method for buying the assets of a share offer at its asking price
+func (k msgServer) AcceptShareOffer(goCtx context.Context, msg *types.MsgAcceptShareOffer) (*types.MsgAcceptShareOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AcceptShareOffer(ctx, buyer, msg.OfferId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptShareOfferResponse{}, nil
+}
+
+// CancelShareOffer defines a method for the seller to cancel a share offer
+func (k msgServer) CancelShareOffer(goCtx context.Context, msg *types.MsgCancelShareOffer) (*types.MsgCancelShareOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelShareOffer(ctx, seller, msg.OfferId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelShareOfferResponse{}, nil
+}
+
+// VoteWithTokenizeShareRecord defines a method for the owner of a tokenize share
+// record to vote on a proposal with the delegation of the record
+func (k msgServer) VoteWithTokenizeShareRecord(goCtx context.Context, msg *types.MsgVoteWithTokenizeShareRecord) (*types.MsgVoteWithTokenizeShareRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.VoteWithTokenizeShareRecord(
+		ctx, owner, msg.TokenizeShareRecordId, msg.ProposalId, msg.Options, msg.Metadata,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVoteWithTokenizeShareRecordResponse{}, nil
+}
+
+// UpdateParams defines a method for updating the x/staking module params through
+// the module authority
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -9,6 +9,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
@@ -396,7 +397,7 @@ func TestTransferTokenizeShareRecord(t *testing.T) {
 		Id:            1,
 		Owner:         addrAcc1.String(),
 		ModuleAccount: "module_account",
-		Validator:     val.String(),
+		Validator:     val.OperatorAddress,
 	})
 	require.NoError(t, err)
 
@@ -527,3 +528,32 @@ func TestUnbondValidator(t *testing.T) {
 	require.True(t, found)
 	require.True(t, validator.Jailed)
 }
+
+func TestUpdateParams(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Equal(t, authority.String(), app.StakingKeeper.GetAuthority())
+
+	params := types.DefaultParams()
+	params.MinValidatorBond = sdk.NewDec(10)
+	params.MinValidatorBondGracePeriod = 100
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(sdk.AccAddress("invalid"), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// invalid params are rejected
+	invalid := params
+	invalid.MinCommissionRate = sdk.NewDec(2)
+	msg := types.NewMsgUpdateParams(authority, invalid)
+	require.Error(t, msg.ValidateBasic())
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	msg = types.NewMsgUpdateParams(authority, params)
+	require.NoError(t, msg.ValidateBasic())
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+type nftMsgServer struct {
+	nft.MsgServer
+	keeper Keeper
+}
+
+// NewNFTMsgServerImpl wraps the message server of the nft module so that sends of
+// tokenize share record NFTs move the record ownership through staking, which keeps
+// the record owner and the owner index in sync with the NFT and reports the transfer
+// to the hooks. Sends of any other class are handled by the nft module.
+func NewNFTMsgServerImpl(keeper Keeper, server nft.MsgServer) nft.MsgServer {
+	return nftMsgServer{MsgServer: server, keeper: keeper}
+}
+
+var _ nft.MsgServer = nftMsgServer{}
+
+// Send transfers an NFT, moving the ownership of its record if it represents a
+// tokenize share record
+func (s nftMsgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+	if msg.ClassId != types.TokenizeShareRecordNFTClassId {
+		return s.MsgServer.Send(goCtx, msg)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.keeper.GetTokenizeShareRecordByDenom(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := s.keeper.getTokenizeShareRecordOwner(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the owner of nft %s", sender, msg.Id)
+	}
+
+	if err := s.keeper.transferTokenizeShareRecord(ctx, record, receiver); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventSend{
+		ClassId:  msg.ClassId,
+		Id:       msg.Id,
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgSendResponse{}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// HandleValidatorLiquidStakingPauseProposal is a handler for executing a passed
+// validator liquid staking pause proposal
+func HandleValidatorLiquidStakingPauseProposal(ctx sdk.Context, k Keeper, p *types.ValidatorLiquidStakingPauseProposal) error {
+	valAddr, err := sdk.ValAddressFromBech32(p.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetLiquidValidator(ctx, valAddr); !found {
+		return sdkstaking.ErrNoValidatorFound
+	}
+
+	eventType := types.EventTypeValidatorLiquidStakingUnpaused
+	if p.Paused {
+		k.SetValidatorLiquidStakingPaused(ctx, valAddr)
+		eventType = types.EventTypeValidatorLiquidStakingPaused
+	} else {
+		k.DeleteValidatorLiquidStakingPaused(ctx, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyValidator, p.ValidatorAddress),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info("updated validator liquid staking pause", "validator", p.ValidatorAddress, "paused", p.Paused)
+
+	return nil
+}
@@ -0,0 +1,367 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// GetLastShareOfferId returns the id of the last created share offer
+func (k Keeper) GetLastShareOfferId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastShareOfferIdKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastShareOfferId sets the id of the last created share offer
+func (k Keeper) SetLastShareOfferId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastShareOfferIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetShareOffer returns a share offer by id
+func (k Keeper) GetShareOffer(ctx sdk.Context, id uint64) (offer types.ShareOffer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetShareOfferKey(id))
+	if bz == nil {
+		return offer, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &offer)
+	return offer, true
+}
+
+// SetShareOffer stores a share offer, adds it to the expiration queue and indexes it
+// by its share token denoms and their validators
+func (k Keeper) SetShareOffer(ctx sdk.Context, offer types.ShareOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetShareOfferKey(offer.Id), k.cdc.MustMarshal(&offer))
+	store.Set(types.GetShareOfferQueueKey(offer.Expiration, offer.Id), []byte{})
+	k.setShareOfferIndexes(ctx, offer)
+}
+
+// deleteShareOffer removes a share offer, its expiration queue entry and its indexes
+func (k Keeper) deleteShareOffer(ctx sdk.Context, offer types.ShareOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetShareOfferKey(offer.Id))
+	store.Delete(types.GetShareOfferQueueKey(offer.Expiration, offer.Id))
+	for _, denom := range k.ShareOfferDenoms(ctx, offer) {
+		store.Delete(types.GetShareOfferByDenomKey(denom, offer.Id))
+		if valAddr, found := k.getShareTokenValidator(ctx, denom); found {
+			store.Delete(types.GetShareOfferByValidatorKey(valAddr, offer.Id, denom))
+		}
+	}
+}
+
+// setShareOfferIndexes indexes an offer by its share token denoms and by the
+// validators the shares of these denoms are delegated to
+func (k Keeper) setShareOfferIndexes(ctx sdk.Context, offer types.ShareOffer) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range k.ShareOfferDenoms(ctx, offer) {
+		store.Set(types.GetShareOfferByDenomKey(denom, offer.Id), []byte{})
+		if valAddr, found := k.getShareTokenValidator(ctx, denom); found {
+			store.Set(types.GetShareOfferByValidatorKey(valAddr, offer.Id, denom), []byte{})
+		}
+	}
+}
+
+// getShareTokenValidator returns the validator of the tokenize share record of a share token denom
+func (k Keeper) getShareTokenValidator(ctx sdk.Context, denom string) (sdk.ValAddress, bool) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, denom)
+	if err != nil {
+		return nil, false
+	}
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, false
+	}
+	return valAddr, true
+}
+
+// getShareOfferIdsByDenom returns the ids of the open offers including a share token denom
+func (k Keeper) getShareOfferIdsByDenom(ctx sdk.Context, denom string) (offerIds []uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetShareOffersByDenomPrefix(denom))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		offerIds = append(offerIds, sdk.BigEndianToUint64(iter.Key()[len(iter.Key())-8:]))
+	}
+	return offerIds
+}
+
+// moveShareOfferValidatorIndexes moves the validator index entries of the open offers
+// including the share tokens of a record that was redelegated
+func (k Keeper) moveShareOfferValidatorIndexes(ctx sdk.Context, denom string, srcValAddr, dstValAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, offerId := range k.getShareOfferIdsByDenom(ctx, denom) {
+		store.Delete(types.GetShareOfferByValidatorKey(srcValAddr, offerId, denom))
+		store.Set(types.GetShareOfferByValidatorKey(dstValAddr, offerId, denom), []byte{})
+	}
+}
+
+// deleteShareOfferIndexesOfRecord removes the index entries of the open offers for
+// the share token denom of a record being deleted, which can no longer be resolved
+// once the record is gone
+func (k Keeper) deleteShareOfferIndexesOfRecord(ctx sdk.Context, record types.TokenizeShareRecord, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	denom := record.GetShareTokenDenom()
+	for _, offerId := range k.getShareOfferIdsByDenom(ctx, denom) {
+		store.Delete(types.GetShareOfferByDenomKey(denom, offerId))
+		store.Delete(types.GetShareOfferByValidatorKey(valAddr, offerId, denom))
+	}
+}
+
+// IterateShareOffers iterates over all open share offers in id order
+func (k Keeper) IterateShareOffers(ctx sdk.Context, handler func(offer types.ShareOffer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ShareOfferKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var offer types.ShareOffer
+		k.cdc.MustUnmarshal(iter.Value(), &offer)
+		if handler(offer) {
+			break
+		}
+	}
+}
+
+// GetAllShareOffers returns all open share offers
+func (k Keeper) GetAllShareOffers(ctx sdk.Context) (offers []types.ShareOffer) {
+	k.IterateShareOffers(ctx, func(offer types.ShareOffer) bool {
+		offers = append(offers, offer)
+		return false
+	})
+	return offers
+}
+
+// ShareOfferDenoms returns the share token denoms of an offer, including the
+// denom of its tokenize share record if the record still exists
+func (k Keeper) ShareOfferDenoms(ctx sdk.Context, offer types.ShareOffer) (denoms []string) {
+	for _, token := range offer.ShareTokens {
+		denoms = append(denoms, token.Denom)
+	}
+	if offer.HasTokenizeShareRecord() {
+		if record, err := k.GetTokenizeShareRecord(ctx, offer.TokenizeShareRecordId); err == nil {
+			denoms = append(denoms, record.GetShareTokenDenom())
+		}
+	}
+	return denoms
+}
+
+// CreateShareOffer moves the offered share tokens and tokenize share record of the
+// seller into the escrow of a new share offer and returns the offer id
+func (k Keeper) CreateShareOffer(
+	ctx sdk.Context, seller sdk.AccAddress, shareTokens sdk.Coins, recordId uint64, price sdk.Coin, expiration time.Time,
+) (uint64, error) {
+	if !expiration.After(ctx.BlockTime()) {
+		return 0, types.ErrShareOfferExpired.Wrap("expiration must be after the block time")
+	}
+
+	for _, token := range shareTokens {
+		if _, err := k.GetTokenizeShareRecordByDenom(ctx, token.Denom); err != nil {
+			return 0, types.ErrInvalidShareOfferAsset.Wrapf("%s is not a share token", token.Denom)
+		}
+	}
+
+	offer := types.ShareOffer{
+		Id:                    k.GetLastShareOfferId(ctx) + 1,
+		Seller:                seller.String(),
+		ShareTokens:           shareTokens,
+		TokenizeShareRecordId: recordId,
+		Price:                 price,
+		Expiration:            expiration,
+	}
+
+	if !shareTokens.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, seller, offer.GetEscrowAddress(), shareTokens); err != nil {
+			return 0, err
+		}
+	}
+
+	if offer.HasTokenizeShareRecord() {
+		record, err := k.GetTokenizeShareRecord(ctx, recordId)
+		if err != nil {
+			return 0, err
+		}
+		owner, err := k.getTokenizeShareRecordOwner(ctx, record)
+		if err != nil {
+			return 0, err
+		}
+		if !owner.Equals(seller) {
+			return 0, types.ErrNotTokenizeShareRecordOwner
+		}
+		if err := k.transferTokenizeShareRecord(ctx, record, offer.GetEscrowAddress()); err != nil {
+			return 0, err
+		}
+	}
+
+	k.SetLastShareOfferId(ctx, offer.Id)
+	k.SetShareOffer(ctx, offer)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateShareOffer,
+			sdk.NewAttribute(types.AttributeKeyShareOfferId, fmt.Sprintf("%d", offer.Id)),
+			sdk.NewAttribute(types.AttributeKeySeller, offer.Seller),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.ShareTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprintf("%d", offer.TokenizeShareRecordId)),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+		),
+	)
+
+	return offer.Id, nil
+}
+
+// AcceptShareOffer pays the asking price of an open offer to the seller and
+// releases the escrowed assets to the buyer
+func (k Keeper) AcceptShareOffer(ctx sdk.Context, buyer sdk.AccAddress, offerId uint64) error {
+	offer, found := k.GetShareOffer(ctx, offerId)
+	if !found {
+		return types.ErrShareOfferNotFound
+	}
+	if !offer.Expiration.After(ctx.BlockTime()) {
+		return types.ErrShareOfferExpired
+	}
+	if offer.Seller == buyer.String() {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller cannot accept its own share offer")
+	}
+
+	seller, err := sdk.AccAddressFromBech32(offer.Seller)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, buyer, seller, sdk.NewCoins(offer.Price)); err != nil {
+		return err
+	}
+
+	if err := k.releaseShareOffer(ctx, offer, buyer); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptShareOffer,
+			sdk.NewAttribute(types.AttributeKeyShareOfferId, fmt.Sprintf("%d", offer.Id)),
+			sdk.NewAttribute(types.AttributeKeySeller, offer.Seller),
+			sdk.NewAttribute(types.AttributeKeyBuyer, buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+		),
+	)
+
+	return nil
+}
+
+// CancelShareOffer returns the escrowed assets of an open offer to its seller
+func (k Keeper) CancelShareOffer(ctx sdk.Context, seller sdk.AccAddress, offerId uint64) error {
+	offer, found := k.GetShareOffer(ctx, offerId)
+	if !found {
+		return types.ErrShareOfferNotFound
+	}
+	if offer.Seller != seller.String() {
+		return types.ErrNotShareOfferSeller
+	}
+
+	if err := k.releaseShareOffer(ctx, offer, seller); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelShareOffer,
+			sdk.NewAttribute(types.AttributeKeyShareOfferId, fmt.Sprintf("%d", offer.Id)),
+			sdk.NewAttribute(types.AttributeKeySeller, offer.Seller),
+		),
+	)
+
+	return nil
+}
+
+// ExpireMatureShareOffers returns the escrowed assets of the offers that expired by
+// the block time to their sellers, expiring at most MaxShareOfferExpirationsPerBlock
+// offers so that the remaining ones are expired in the following blocks.
+// An offer that fails to expire is removed from the queue, so that it is not retried
+// in every block, and stays open until its seller cancels it.
+func (k Keeper) ExpireMatureShareOffers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(types.ShareOfferQueueKey, sdk.PrefixEndBytes(types.GetShareOfferQueueTimeKey(ctx.BlockTime())))
+	var queueKeys [][]byte
+	for ; iter.Valid() && len(queueKeys) < types.MaxShareOfferExpirationsPerBlock; iter.Next() {
+		queueKeys = append(queueKeys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	for _, queueKey := range queueKeys {
+		offer, found := k.GetShareOffer(ctx, types.ParseShareOfferQueueKey(queueKey))
+		if !found {
+			store.Delete(queueKey)
+			continue
+		}
+
+		if err := k.expireShareOffer(ctx, offer); err != nil {
+			k.Logger(ctx).Error("failed to expire share offer, removing it from the queue", "offer_id", offer.Id, "err", err)
+			store.Delete(queueKey)
+		}
+	}
+}
+
+// expireShareOffer returns the assets of an expired offer to its seller, discarding
+// all state changes if the release fails
+func (k Keeper) expireShareOffer(ctx sdk.Context, offer types.ShareOffer) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	seller, err := sdk.AccAddressFromBech32(offer.Seller)
+	if err != nil {
+		return err
+	}
+	if err := k.releaseShareOffer(cacheCtx, offer, seller); err != nil {
+		return err
+	}
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpireShareOffer,
+			sdk.NewAttribute(types.AttributeKeyShareOfferId, fmt.Sprintf("%d", offer.Id)),
+			sdk.NewAttribute(types.AttributeKeySeller, offer.Seller),
+		),
+	)
+
+	write()
+	return nil
+}
+
+// releaseShareOffer sends the full balance of an offer's escrow, which includes any
+// record rewards paid out while the offer was open, and its tokenize share record,
+// if it still exists, to the recipient, and removes the offer
+func (k Keeper) releaseShareOffer(ctx sdk.Context, offer types.ShareOffer, recipient sdk.AccAddress) error {
+	escrow := offer.GetEscrowAddress()
+
+	if balances := k.bankKeeper.GetAllBalances(ctx, escrow); !balances.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, escrow, recipient, balances); err != nil {
+			return err
+		}
+	}
+
+	if offer.HasTokenizeShareRecord() {
+		// the record may have been pruned once all of its shares were redeemed
+		if record, err := k.GetTokenizeShareRecord(ctx, offer.TokenizeShareRecordId); err == nil {
+			if err := k.transferTokenizeShareRecord(ctx, record, recipient); err != nil {
+				return err
+			}
+		}
+	}
+
+	k.deleteShareOffer(ctx, offer)
+	return nil
+}
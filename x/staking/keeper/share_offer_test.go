@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestShareOffers() {
+	app, queryClient := suite.app, suite.queryClient
+	ctx := suite.ctx.WithBlockTime(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	seller, buyer := suite.addrs[0], suite.addrs[1]
+	valAddr := suite.vals[0].GetOperator()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         seller.String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     valAddr.String(),
+	}
+	suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	shareDenom := record.GetShareTokenDenom()
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, seller, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100))))
+
+	price := sdk.NewInt64Coin(bondDenom, 500)
+	expiration := ctx.BlockTime().Add(time.Hour)
+	createOffer := func(shareTokens sdk.Coins, recordId uint64) (uint64, error) {
+		res, err := msgServer.CreateShareOffer(sdk.WrapSDKContext(ctx), types.NewMsgCreateShareOffer(seller, shareTokens, recordId, price, expiration))
+		if err != nil {
+			return 0, err
+		}
+		return res.OfferId, nil
+	}
+
+	// only share tokens and records owned by the seller can be offered
+	_, err := createOffer(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10)), 0)
+	suite.ErrorIs(err, types.ErrInvalidShareOfferAsset)
+	_, err = msgServer.CreateShareOffer(sdk.WrapSDKContext(ctx), types.NewMsgCreateShareOffer(buyer, nil, record.Id, price, expiration))
+	suite.ErrorIs(err, types.ErrNotTokenizeShareRecordOwner)
+
+	// the offered assets are held in escrow
+	offerId, err := createOffer(sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 60)), record.Id)
+	suite.Require().NoError(err)
+	offer, found := app.StakingKeeper.GetShareOffer(ctx, offerId)
+	suite.Require().True(found)
+	suite.Equal(int64(40), app.BankKeeper.GetBalance(ctx, seller, shareDenom).Amount.Int64())
+	suite.Equal(int64(60), app.BankKeeper.GetBalance(ctx, offer.GetEscrowAddress(), shareDenom).Amount.Int64())
+	owner, err := app.StakingKeeper.GetTokenizeShareRecordOwner(ctx, record.Id)
+	suite.Require().NoError(err)
+	suite.Equal(offer.GetEscrowAddress(), owner)
+
+	byValidator, err := queryClient.ShareOffersByValidator(gocontext.Background(), &types.QueryShareOffersByValidatorRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Len(byValidator.Offers, 1)
+	byValidator, err = queryClient.ShareOffersByValidator(gocontext.Background(), &types.QueryShareOffersByValidatorRequest{
+		ValidatorAddress: suite.vals[1].GetOperator().String(),
+	})
+	suite.Require().NoError(err)
+	suite.Empty(byValidator.Offers)
+	byDenom, err := queryClient.ShareOffersByDenom(gocontext.Background(), &types.QueryShareOffersByDenomRequest{Denom: shareDenom})
+	suite.Require().NoError(err)
+	suite.Len(byDenom.Offers, 1)
+
+	// the buyer pays the seller and receives the escrowed assets
+	_, err = msgServer.CancelShareOffer(sdk.WrapSDKContext(ctx), types.NewMsgCancelShareOffer(buyer, offerId))
+	suite.ErrorIs(err, types.ErrNotShareOfferSeller)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, buyer, sdk.NewCoins(price)))
+	sellerBalance := app.BankKeeper.GetBalance(ctx, seller, bondDenom)
+	_, err = msgServer.AcceptShareOffer(sdk.WrapSDKContext(ctx), types.NewMsgAcceptShareOffer(buyer, offerId))
+	suite.Require().NoError(err)
+
+	suite.Equal(sellerBalance.Add(price), app.BankKeeper.GetBalance(ctx, seller, bondDenom))
+	suite.Equal(int64(60), app.BankKeeper.GetBalance(ctx, buyer, shareDenom).Amount.Int64())
+	owner, err = app.StakingKeeper.GetTokenizeShareRecordOwner(ctx, record.Id)
+	suite.Require().NoError(err)
+	suite.Equal(buyer, owner)
+	_, found = app.StakingKeeper.GetShareOffer(ctx, offerId)
+	suite.False(found)
+	_, err = msgServer.AcceptShareOffer(sdk.WrapSDKContext(ctx), types.NewMsgAcceptShareOffer(buyer, offerId))
+	suite.ErrorIs(err, types.ErrShareOfferNotFound)
+	byValidator, err = queryClient.ShareOffersByValidator(gocontext.Background(), &types.QueryShareOffersByValidatorRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Empty(byValidator.Offers)
+	byDenom, err = queryClient.ShareOffersByDenom(gocontext.Background(), &types.QueryShareOffersByDenomRequest{Denom: shareDenom})
+	suite.Require().NoError(err)
+	suite.Empty(byDenom.Offers)
+
+	// cancelled offers return the escrowed assets to the seller
+	offerId, err = createOffer(sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 40)), 0)
+	suite.Require().NoError(err)
+	suite.True(app.BankKeeper.GetBalance(ctx, seller, shareDenom).IsZero())
+	_, err = msgServer.CancelShareOffer(sdk.WrapSDKContext(ctx), types.NewMsgCancelShareOffer(seller, offerId))
+	suite.Require().NoError(err)
+	suite.Equal(int64(40), app.BankKeeper.GetBalance(ctx, seller, shareDenom).Amount.Int64())
+
+	// expired offers cannot be accepted and are returned to the seller at the end of the block
+	offerId, err = createOffer(sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 40)), 0)
+	suite.Require().NoError(err)
+	suite.Len(app.StakingKeeper.ExportGenesis(ctx).ShareOffers, 1)
+
+	ctx = ctx.WithBlockTime(expiration)
+	_, err = msgServer.AcceptShareOffer(sdk.WrapSDKContext(ctx), types.NewMsgAcceptShareOffer(buyer, offerId))
+	suite.ErrorIs(err, types.ErrShareOfferExpired)
+
+	app.StakingKeeper.ExpireMatureShareOffers(ctx)
+	_, found = app.StakingKeeper.GetShareOffer(ctx, offerId)
+	suite.False(found)
+	suite.Equal(int64(40), app.BankKeeper.GetBalance(ctx, seller, shareDenom).Amount.Int64())
+	suite.Equal(offerId, app.StakingKeeper.GetLastShareOfferId(ctx))
+}
+
+func (suite *KeeperTestSuite) TestExpireMatureShareOffers() {
+	app, queryClient := suite.app, suite.queryClient
+	ctx := suite.ctx.WithBlockTime(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	seller := suite.addrs[0]
+	valAddr := suite.vals[0].GetOperator()
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         seller.String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     valAddr.String(),
+	}
+	suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	shareDenom := record.GetShareTokenDenom()
+
+	// an offer whose assets cannot be returned to its seller
+	failing := types.ShareOffer{
+		Id:          1,
+		Seller:      "invalid",
+		ShareTokens: sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 1)),
+		Price:       sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 1),
+		Expiration:  ctx.BlockTime(),
+	}
+	app.StakingKeeper.SetShareOffer(ctx, failing)
+	for id := uint64(2); id <= types.MaxShareOfferExpirationsPerBlock+1; id++ {
+		offer := failing
+		offer.Id = id
+		offer.Seller = seller.String()
+		app.StakingKeeper.SetShareOffer(ctx, offer)
+	}
+	app.StakingKeeper.SetLastShareOfferId(ctx, types.MaxShareOfferExpirationsPerBlock+1)
+
+	byDenom, err := queryClient.ShareOffersByDenom(gocontext.Background(), &types.QueryShareOffersByDenomRequest{
+		Denom:      shareDenom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(uint64(types.MaxShareOfferExpirationsPerBlock+1), byDenom.Pagination.Total)
+	byValidator, err := queryClient.ShareOffersByValidator(gocontext.Background(), &types.QueryShareOffersByValidatorRequest{
+		ValidatorAddress: valAddr.String(),
+		Pagination:       &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(uint64(types.MaxShareOfferExpirationsPerBlock+1), byValidator.Pagination.Total)
+
+	// the failing offer stays open but leaves the queue, and the last offer is left for the next block
+	app.StakingKeeper.ExpireMatureShareOffers(ctx)
+	suite.Len(app.StakingKeeper.GetAllShareOffers(ctx), 2)
+	_, found := app.StakingKeeper.GetShareOffer(ctx, failing.Id)
+	suite.True(found)
+
+	app.StakingKeeper.ExpireMatureShareOffers(ctx)
+	offers := app.StakingKeeper.GetAllShareOffers(ctx)
+	suite.Require().Len(offers, 1)
+	suite.Equal(failing.Id, offers[0].Id)
+
+	// the offers of a deleted record can no longer be found by its denom or validator
+	suite.Require().NoError(app.StakingKeeper.DeleteTokenizeShareRecord(ctx, record.Id))
+	byDenom, err = queryClient.ShareOffersByDenom(gocontext.Background(), &types.QueryShareOffersByDenomRequest{Denom: shareDenom})
+	suite.Require().NoError(err)
+	suite.Empty(byDenom.Offers)
+	byValidator, err = queryClient.ShareOffersByValidator(gocontext.Background(), &types.QueryShareOffersByValidatorRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Empty(byValidator.Offers)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -149,9 +150,321 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		"slash_factor", slashFactor.String(),
 		"burned", tokensToBurn,
 	)
+
+	if tokensToBurn.IsPositive() {
+		k.notifyTokenizeShareRecordsSlashed(ctx, operatorAddress, slashFactor)
+	}
+
 	return tokensToBurn
 }
 
+// notifyTokenizeShareRecordsSlashed emits an event and calls the slashed hook
+// with every tokenize share record delegated to a slashed validator
+func (k Keeper) notifyTokenizeShareRecordsSlashed(ctx sdk.Context, valAddr sdk.ValAddress, slashFactor sdk.Dec) {
+	records := k.GetTokenizeShareRecordsByValidator(ctx, valAddr)
+	if len(records) == 0 {
+		return
+	}
+
+	recordIds := tokenizeShareRecordIds(records)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShareRecordsSlashed,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, slashFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyShareRecordIds, joinTokenizeShareRecordIds(recordIds)),
+		),
+	)
+
+	if err := k.AfterTokenizeShareRecordsSlashed(ctx, valAddr, slashFactor, recordIds); err != nil {
+		k.Logger(ctx).Error("failed to call tokenize share records slashed hook", "validator", valAddr.String(), "error", err)
+	}
+}
+
+// HandleValidatorTombstoned emits an event and calls the tombstoned hook with
+// every tokenize share record delegated to a tombstoned validator. When a
+// tombstone fallback validator is set, the validator is queued so that its
+// records are redelegated to the fallback validator over the next blocks.
+func (k Keeper) HandleValidatorTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return
+	}
+	valAddr := validator.GetOperator()
+
+	records := k.GetTokenizeShareRecordsByValidator(ctx, valAddr)
+	if len(records) == 0 {
+		return
+	}
+
+	recordIds := tokenizeShareRecordIds(records)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShareRecordsTombstoned,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyShareRecordIds, joinTokenizeShareRecordIds(recordIds)),
+		),
+	)
+
+	if err := k.AfterTokenizeShareRecordsTombstoned(ctx, valAddr, recordIds); err != nil {
+		k.Logger(ctx).Error("failed to call tokenize share records tombstoned hook", "validator", valAddr.String(), "error", err)
+	}
+
+	if k.TombstoneFallbackValidator(ctx) == "" {
+		return
+	}
+	k.SetTombstonedValidatorQueueCursor(ctx, valAddr, 0)
+}
+
+// GetTombstonedValidatorQueueCursor returns the id of the next tokenize share record of a
+// queued tombstoned validator to redelegate, and whether the validator is queued
+func (k Keeper) GetTombstonedValidatorQueueCursor(ctx sdk.Context, valAddr sdk.ValAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTombstonedValidatorQueueKey(valAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetTombstonedValidatorQueueCursor queues a tombstoned validator with the id of its next
+// tokenize share record to redelegate
+func (k Keeper) SetTombstonedValidatorQueueCursor(ctx sdk.Context, valAddr sdk.ValAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTombstonedValidatorQueueKey(valAddr), sdk.Uint64ToBigEndian(id))
+}
+
+// DeleteTombstonedValidatorQueueCursor removes a tombstoned validator from the queue
+func (k Keeper) DeleteTombstonedValidatorQueueCursor(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTombstonedValidatorQueueKey(valAddr))
+}
+
+// GetTombstonedValidatorQueue returns the operator addresses of the queued tombstoned validators
+func (k Keeper) GetTombstonedValidatorQueue(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.TombstonedValidatorQueueKey)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		valAddrs = append(valAddrs, types.AddressFromTombstonedValidatorQueueKey(it.Key()))
+	}
+	return valAddrs
+}
+
+// RedelegateTombstonedTokenizeShareRecords redelegates the tokenize share records of the
+// queued tombstoned validators to the tombstone fallback validator, at most
+// MaxTombstoneRedelegationsPerBlock records per block. A record that fails to be
+// redelegated is skipped and stays delegated to the tombstoned validator.
+func (k Keeper) RedelegateTombstonedTokenizeShareRecords(ctx sdk.Context) {
+	limit := uint64(types.MaxTombstoneRedelegationsPerBlock)
+
+	for _, valAddr := range k.GetTombstonedValidatorQueue(ctx) {
+		if limit == 0 {
+			return
+		}
+
+		dstValAddr, err := k.tombstoneFallbackValidator(ctx, valAddr)
+		if err != nil {
+			k.Logger(ctx).Error("tombstone fallback validator is not available", "validator", valAddr.String(), "error", err)
+			k.DeleteTombstonedValidatorQueueCursor(ctx, valAddr)
+			continue
+		}
+
+		cursor, _ := k.GetTombstonedValidatorQueueCursor(ctx, valAddr)
+		records, nextId := k.GetTokenizeShareRecordsByValidatorFrom(ctx, valAddr, cursor, limit)
+		limit -= uint64(len(records))
+
+		for _, record := range records {
+			// each redelegation is applied on a cached context so that a failed
+			// record leaves no partial state behind
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.redelegateTokenizeShareRecord(cacheCtx, record, dstValAddr); err != nil {
+				k.Logger(ctx).Error(
+					"failed to redelegate tokenize share record to the tombstone fallback validator",
+					"record", record.Id, "error", err,
+				)
+				continue
+			}
+			write()
+		}
+
+		if nextId == 0 {
+			k.DeleteTombstonedValidatorQueueCursor(ctx, valAddr)
+		} else {
+			k.SetTombstonedValidatorQueueCursor(ctx, valAddr, nextId)
+		}
+	}
+}
+
+// tombstoneFallbackValidator returns the tombstone fallback validator the records of a
+// tombstoned validator are redelegated to, or an error if it is unset or cannot receive them
+func (k Keeper) tombstoneFallbackValidator(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.ValAddress, error) {
+	fallback := k.TombstoneFallbackValidator(ctx)
+	if fallback == "" {
+		return nil, fmt.Errorf("no tombstone fallback validator is set")
+	}
+	dstValAddr, err := sdk.ValAddressFromBech32(fallback)
+	if err != nil {
+		return nil, err
+	}
+	if dstValAddr.Equals(valAddr) {
+		return nil, fmt.Errorf("tombstone fallback validator %s is tombstoned", fallback)
+	}
+	dstValidator, found := k.GetLiquidValidator(ctx, dstValAddr)
+	if !found || dstValidator.IsJailed() || k.IsValidatorLiquidStakingPaused(ctx, dstValAddr) {
+		return nil, fmt.Errorf("tombstone fallback validator %s cannot receive delegations", fallback)
+	}
+	return dstValAddr, nil
+}
+
+// redelegateTokenizeShareRecord redelegates all the shares of a tokenize share
+// record to a destination validator, moving the liquid shares along with them.
+// The share token denom of the record does not change.
+func (k Keeper) redelegateTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord, dstValAddr sdk.ValAddress) error {
+	srcValAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+	moduleAddr := record.GetModuleAddress()
+
+	delegation, found := k.GetLiquidDelegation(ctx, moduleAddr, srcValAddr)
+	if !found {
+		return sdkstaking.ErrNoDelegation
+	}
+	shares := delegation.Shares
+
+	sharesBefore := sdk.ZeroDec()
+	if dstDelegation, found := k.GetLiquidDelegation(ctx, moduleAddr, dstValAddr); found {
+		sharesBefore = dstDelegation.Shares
+	}
+
+	completionTime, err := k.BeginRedelegation(ctx, moduleAddr, srcValAddr, dstValAddr, shares)
+	if err != nil {
+		return err
+	}
+
+	dstDelegation, found := k.GetLiquidDelegation(ctx, moduleAddr, dstValAddr)
+	if !found {
+		return sdkstaking.ErrNoDelegation
+	}
+	sharesCreated := dstDelegation.Shares.Sub(sharesBefore)
+
+	if err := k.SafelyIncreaseValidatorLiquidShares(ctx, dstValAddr, sharesCreated); err != nil {
+		return err
+	}
+	if err := k.DecreaseValidatorLiquidShares(ctx, srcValAddr, shares); err != nil {
+		return err
+	}
+
+	k.deleteTokenizeShareRecordWithValidator(ctx, srcValAddr, record.Id)
+	record.Denom = record.GetShareTokenDenom()
+	record.Validator = dstValAddr.String()
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithValidator(ctx, dstValAddr, record.Id)
+	k.moveShareOfferValidatorIndexes(ctx, record.Denom, srcValAddr, dstValAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedelegateTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprint(record.Id)),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, k.withCurrentOwner(ctx, record).Owner),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, srcValAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, dstValAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// SlashValidatorBondDelegations burns an extra slashFactor * (ValidatorBondSlashMultiplier - 1)
+// of the shares of each validator bond delegation to a validator, on top of the
+// exchange rate slash applied by Slash. It is intended for double-sign infractions
+// and returns the amount of tokens burned.
+func (k Keeper) SlashValidatorBondDelegations(ctx sdk.Context, consAddr sdk.ConsAddress, slashFactor sdk.Dec) math.Int {
+	totalBurned := sdk.ZeroInt()
+
+	multiplier := k.ValidatorBondSlashMultiplier(ctx)
+	if multiplier.LTE(sdk.OneDec()) || !slashFactor.IsPositive() {
+		return totalBurned
+	}
+
+	extraFactor := sdk.MinDec(slashFactor.Mul(multiplier.Sub(sdk.OneDec())), sdk.OneDec())
+
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return totalBurned
+	}
+	valAddr := validator.GetOperator()
+
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+		if !delegation.ValidatorBond {
+			continue
+		}
+
+		sharesToBurn := delegation.Shares.Mul(extraFactor)
+		if !sharesToBurn.IsPositive() {
+			continue
+		}
+
+		status := validator.GetStatus()
+		burned, err := k.Unbond(ctx, delegation.GetDelegatorAddr(), valAddr, sharesToBurn)
+		if err != nil {
+			panic(err)
+		}
+
+		// the validator is removed by Unbond once it is unbonded without shares
+		validator, found = k.GetLiquidValidator(ctx, valAddr)
+		if found {
+			validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(sharesToBurn)
+			k.SetValidator(ctx, validator)
+		}
+
+		// a failing hook must not halt the chain in BeginBlock
+		if err := k.AfterValidatorBondChanged(ctx, delegation.GetDelegatorAddr(), valAddr); err != nil {
+			k.Logger(ctx).Error("after validator bond changed hook failed", "delegator", delegation.DelegatorAddress, "validator", valAddr.String(), "err", err)
+		}
+
+		switch status {
+		case sdkstaking.Bonded:
+			if err := k.burnBondedTokens(ctx, burned); err != nil {
+				panic(err)
+			}
+		case sdkstaking.Unbonding, sdkstaking.Unbonded:
+			if err := k.burnNotBondedTokens(ctx, burned); err != nil {
+				panic(err)
+			}
+		default:
+			panic("invalid validator status")
+		}
+		totalBurned = totalBurned.Add(burned)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorBondSlash,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyBurnedShares, sharesToBurn.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+			),
+		)
+
+		if !found {
+			break
+		}
+	}
+
+	k.Logger(ctx).Info(
+		"validator bond delegations slashed",
+		"validator", valAddr.String(),
+		"slash_factor", extraFactor.String(),
+		"burned", totalBurned,
+	)
+	return totalBurned
+}
+
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
@@ -620,3 +620,69 @@ func TestSlashAmount(t *testing.T) {
 	noBurned := app.StakingKeeper.Slash(ctx, sdk.ConsAddress(addrVals[0]), ctx.BlockHeight(), 10, fraction)
 	require.True(t, sdk.NewInt(0).Equal(noBurned))
 }
+
+// tests the extra burn of validator bond delegations
+func TestSlashValidatorBondDelegations(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(1, 1)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	// fund the bonded pool for the delegations added below
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	delCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens.MulRaw(2)))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, bondedPool.GetName(), delCoins))
+
+	// add a validator bond delegation and a regular delegation
+	for _, addrDel := range addrDels[:2] {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+		require.True(t, found)
+		_, err := app.StakingKeeper.Delegate(ctx, addrDel, delTokens, sdkstaking.Bonded, validator, false)
+		require.NoError(t, err)
+	}
+	bondDel, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	bondDel.ValidatorBond = true
+	app.StakingKeeper.SetDelegation(ctx, bondDel)
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	validator.TotalValidatorBondShares = bondDel.Shares
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// no extra burn with the default multiplier
+	require.True(t, app.StakingKeeper.SlashValidatorBondDelegations(ctx, consAddr, fraction).IsZero())
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondSlashMultiplier = sdk.NewDec(3)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the validator bond delegation loses 0.1 * (3 - 1) of its shares
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	oldBondedPoolBalance := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+	burned := app.StakingKeeper.SlashValidatorBondDelegations(ctx, consAddr, fraction)
+	expBurned := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+	require.Equal(t, expBurned, burned)
+
+	bondDel, found = app.StakingKeeper.GetLiquidDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.Sub(expBurned)), bondDel.Shares)
+	del, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens), del.Shares)
+
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, bondDel.Shares, validator.TotalValidatorBondShares)
+	require.Equal(t, delTokens.MulRaw(3).Sub(expBurned), validator.Tokens)
+
+	newBondedPoolBalance := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+	require.Equal(t, expBurned, oldBondedPoolBalance.Sub(newBondedPoolBalance...).AmountOf(app.StakingKeeper.BondDenom(ctx)))
+
+	var slashEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorBondSlash {
+			slashEvents++
+		}
+	}
+	require.Equal(t, 1, slashEvents)
+}
@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -55,6 +58,53 @@ func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAdd
 	return
 }
 
+// GetTokenizeShareRecordsByOwnerFrom returns at most limit records of an owner in id order,
+// starting from the first record whose id is at least startId, along with the id of the
+// next record to process (zero once all are returned).
+func (k Keeper) GetTokenizeShareRecordsByOwnerFrom(ctx sdk.Context, owner sdk.AccAddress, startId uint64, limit uint64) (tokenizeShareRecords []types.TokenizeShareRecord, nextId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.GetTokenizeShareRecordIdsByOwnerPrefix(owner)
+	it := store.Iterator(types.GetTokenizeShareRecordIdByOwnerAndIdKey(owner, startId), sdk.PrefixEndBytes(prefix))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(it.Value(), &id)
+
+		if uint64(len(tokenizeShareRecords)) == limit {
+			return tokenizeShareRecords, id.Value
+		}
+
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, id.Value)
+		if err != nil {
+			continue
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+	return tokenizeShareRecords, 0
+}
+
+// GetTokenizeShareRecordsFrom returns at most limit records, starting from startId, along
+// with the id of the next record to process (zero once all are returned)
+func (k Keeper) GetTokenizeShareRecordsFrom(ctx sdk.Context, startId uint64, limit uint64) (tokenizeShareRecords []types.TokenizeShareRecord, nextId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(types.GetTokenizeShareRecordByIndexKey(startId), sdk.PrefixEndBytes(types.TokenizeShareRecordPrefix))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var tokenizeShareRecord types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(it.Value(), &tokenizeShareRecord)
+
+		if uint64(len(tokenizeShareRecords)) == limit {
+			return tokenizeShareRecords, tokenizeShareRecord.Id
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+	return tokenizeShareRecords, 0
+}
+
 func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetTokenizeShareRecordIdByDenomKey(denom))
@@ -68,6 +118,21 @@ func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (ty
 	return k.GetTokenizeShareRecord(ctx, id.Value)
 }
 
+// GetTokenizeShareRecordByModuleAccount returns the record whose module account holds
+// the delegation of the given address
+func (k Keeper) GetTokenizeShareRecordByModuleAccount(ctx sdk.Context, moduleAccount sdk.AccAddress) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIdByModuleAccountKey(moduleAccount))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, fmt.Errorf("tokenize share record not found from module account: %s", moduleAccount)
+	}
+
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &id)
+
+	return k.GetTokenizeShareRecord(ctx, id.Value)
+}
+
 func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (tokenizeShareRecords []types.TokenizeShareRecord) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -83,22 +148,96 @@ func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (tokenizeShareRecord
 	return
 }
 
+// GetTokenizeShareRecordsByValidator returns the tokenize share records delegated to a validator
+func (k Keeper) GetTokenizeShareRecordsByValidator(ctx sdk.Context, valAddr sdk.ValAddress) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIdsByValidatorPrefix(valAddr))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(it.Value(), &id)
+
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, id.Value)
+		if err != nil {
+			continue
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+	return
+}
+
+// GetTokenizeShareRecordsByValidatorFrom returns at most limit records delegated to a
+// validator, starting from startId, along with the id of the next record to process
+// (zero once all are returned)
+func (k Keeper) GetTokenizeShareRecordsByValidatorFrom(ctx sdk.Context, valAddr sdk.ValAddress, startId uint64, limit uint64) (tokenizeShareRecords []types.TokenizeShareRecord, nextId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.GetTokenizeShareRecordIdsByValidatorPrefix(valAddr)
+	it := store.Iterator(types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, startId), sdk.PrefixEndBytes(prefix))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(it.Value(), &id)
+
+		if uint64(len(tokenizeShareRecords)) == limit {
+			return tokenizeShareRecords, id.Value
+		}
+
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, id.Value)
+		if err != nil {
+			continue
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+	return tokenizeShareRecords, 0
+}
+
+// tokenizeShareRecordIds returns the ids of tokenize share records
+func tokenizeShareRecordIds(records []types.TokenizeShareRecord) []uint64 {
+	ids := make([]uint64, len(records))
+	for i, record := range records {
+		ids[i] = record.Id
+	}
+	return ids
+}
+
+// joinTokenizeShareRecordIds formats tokenize share record ids as an event attribute
+func joinTokenizeShareRecordIds(ids []uint64) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(strs, ",")
+}
+
 func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord types.TokenizeShareRecord) error {
 	if k.hasTokenizeShareRecord(ctx, tokenizeShareRecord.Id) {
 		return errorsmod.Wrapf(types.ErrTokenizeShareRecordAlreadyExists, "TokenizeShareRecord already exists: %d", tokenizeShareRecord.Id)
 	}
 
-	k.setTokenizeShareRecord(ctx, tokenizeShareRecord)
-
 	owner, err := sdk.AccAddressFromBech32(tokenizeShareRecord.Owner)
 	if err != nil {
 		return err
 	}
+	valAddr, err := sdk.ValAddressFromBech32(tokenizeShareRecord.Validator)
+	if err != nil {
+		return err
+	}
+
+	// the share token denom is fixed at creation so that it does not change
+	// when the record's delegation is redelegated
+	tokenizeShareRecord.Denom = tokenizeShareRecord.GetShareTokenDenom()
+	k.setTokenizeShareRecord(ctx, tokenizeShareRecord)
 
 	k.setTokenizeShareRecordWithOwner(ctx, owner, tokenizeShareRecord.Id)
+	k.setTokenizeShareRecordWithValidator(ctx, valAddr, tokenizeShareRecord.Id)
 	k.setTokenizeShareRecordWithDenom(ctx, tokenizeShareRecord.GetShareTokenDenom(), tokenizeShareRecord.Id)
+	k.setTokenizeShareRecordWithModuleAccount(ctx, tokenizeShareRecord.GetModuleAddress(), tokenizeShareRecord.Id)
 
-	return nil
+	return k.mintTokenizeShareRecordNFT(ctx, tokenizeShareRecord)
 }
 
 func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordId uint64) error {
@@ -110,12 +249,44 @@ func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordId uint64) erro
 	if err != nil {
 		return err
 	}
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetTokenizeShareRecordByIndexKey(recordId))
 	store.Delete(types.GetTokenizeShareRecordIdByOwnerAndIdKey(owner, recordId))
+	store.Delete(types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, recordId))
 	store.Delete(types.GetTokenizeShareRecordIdByDenomKey(record.GetShareTokenDenom()))
-	return nil
+	store.Delete(types.GetTokenizeShareRecordIdByModuleAccountKey(record.GetModuleAddress()))
+	k.deleteShareOfferIndexesOfRecord(ctx, record, valAddr)
+
+	return k.burnTokenizeShareRecordNFT(ctx, record)
+}
+
+// transferTokenizeShareRecord moves the ownership of a record, along with its NFT if any, to a new owner
+func (k Keeper) transferTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord, newOwner sdk.AccAddress) error {
+	oldOwner, err := k.getTokenizeShareRecordOwner(ctx, record)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	// the owner index is keyed by the owner last stored on the record, which is no
+	// longer the current owner once the NFT was sent through the nft module
+	storedOwner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	k.deleteTokenizeShareRecordWithOwner(ctx, storedOwner, record.Id)
+
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithOwner(ctx, newOwner, record.Id)
+
+	if err := k.transferTokenizeShareRecordNFT(ctx, record, newOwner); err != nil {
+		return err
+	}
+	return k.AfterTokenizeShareRecordTransferred(ctx, record.Id, oldOwner, newOwner)
 }
 
 func (k Keeper) hasTokenizeShareRecord(ctx sdk.Context, id uint64) bool {
@@ -142,9 +313,145 @@ func (k Keeper) deleteTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.Ac
 	store.Delete(types.GetTokenizeShareRecordIdByOwnerAndIdKey(owner, id))
 }
 
+func (k Keeper) setTokenizeShareRecordWithValidator(ctx sdk.Context, valAddr sdk.ValAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+
+	store.Set(types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, id), bz)
+}
+
+func (k Keeper) deleteTokenizeShareRecordWithValidator(ctx sdk.Context, valAddr sdk.ValAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, id))
+}
+
 func (k Keeper) setTokenizeShareRecordWithDenom(ctx sdk.Context, denom string, id uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
 
 	store.Set(types.GetTokenizeShareRecordIdByDenomKey(denom), bz)
 }
+
+func (k Keeper) setTokenizeShareRecordWithModuleAccount(ctx sdk.Context, moduleAccount sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+
+	store.Set(types.GetTokenizeShareRecordIdByModuleAccountKey(moduleAccount), bz)
+}
+
+func (k Keeper) hasTokenizeShareRecordWithModuleAccount(ctx sdk.Context, moduleAccount sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTokenizeShareRecordIdByModuleAccountKey(moduleAccount))
+}
+
+// GetValidatorTokenizedShares returns the delegator shares of a validator held by
+// tokenize share record module accounts
+func (k Keeper) GetValidatorTokenizedShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorTokenizedSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec
+}
+
+func (k Keeper) setValidatorTokenizedShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorTokenizedSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorTokenizedSharesKey(valAddr), bz)
+}
+
+// updateValidatorTokenizedShares applies a change in the shares of a delegation to
+// the tokenized shares of its validator when the delegator is a tokenize share
+// record module account
+func (k Keeper) updateValidatorTokenizedShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesDelta sdk.Dec) {
+	if !k.hasTokenizeShareRecordWithModuleAccount(ctx, delAddr) {
+		return
+	}
+	k.setValidatorTokenizedShares(ctx, valAddr, k.GetValidatorTokenizedShares(ctx, valAddr).Add(sharesDelta))
+}
+
+// GetTokenizeShareRecordPruneCursor returns the id of the next tokenize share record to inspect for pruning
+func (k Keeper) GetTokenizeShareRecordPruneCursor(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.TokenizeShareRecordPruneCursorKey)
+	if bytes == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bytes)
+}
+
+// SetTokenizeShareRecordPruneCursor sets the id of the next tokenize share record to inspect for pruning
+func (k Keeper) SetTokenizeShareRecordPruneCursor(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TokenizeShareRecordPruneCursorKey, sdk.Uint64ToBigEndian(id))
+}
+
+// IsTokenizeShareRecordEmpty returns true if the record's module account no longer
+// holds a delegation and none of its share tokens are outstanding
+func (k Keeper) IsTokenizeShareRecordEmpty(ctx sdk.Context, record types.TokenizeShareRecord) bool {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return false
+	}
+
+	if _, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr); found {
+		return false
+	}
+
+	return k.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero()
+}
+
+// PruneTokenizeShareRecords inspects the next batch of tokenize share records in
+// round-robin order and deletes the empty ones, flushing the remaining balance of
+// their module accounts to the owners through the BeforeTokenizeShareRecordRemoved hook
+func (k Keeper) PruneTokenizeShareRecords(ctx sdk.Context) {
+	records, nextId := k.GetTokenizeShareRecordsFrom(ctx, k.GetTokenizeShareRecordPruneCursor(ctx), types.MaxTokenizeShareRecordPrunesPerBlock)
+	k.SetTokenizeShareRecordPruneCursor(ctx, nextId)
+
+	for _, record := range records {
+		if !k.IsTokenizeShareRecordEmpty(ctx, record) {
+			continue
+		}
+
+		if err := k.pruneTokenizeShareRecord(ctx, record); err != nil {
+			k.Logger(ctx).Error("failed to prune tokenize share record", "record_id", record.Id, "err", err)
+		}
+	}
+}
+
+// pruneTokenizeShareRecord removes a record, discarding all state changes if the
+// removal hook fails
+func (k Keeper) pruneTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	cacheCtx, write := ctx.CacheContext()
+	record = k.withCurrentOwner(cacheCtx, record)
+
+	if k.hooks != nil {
+		if err := k.hooks.BeforeTokenizeShareRecordRemoved(cacheCtx, record.Id); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DeleteTokenizeShareRecord(cacheCtx, record.Id); err != nil {
+		return err
+	}
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePruneTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, record.Owner),
+		),
+	)
+
+	write()
+	return nil
+}
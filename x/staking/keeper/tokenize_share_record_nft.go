@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// mintTokenizeShareRecordNFT mints the NFT of a tokenize share record to the record
+// owner, saving the staking NFT class first if it does not exist yet
+func (k Keeper) mintTokenizeShareRecordNFT(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+
+	if !k.nftKeeper.HasClass(ctx, types.TokenizeShareRecordNFTClassId) {
+		err := k.nftKeeper.SaveClass(ctx, nft.Class{
+			Id:          types.TokenizeShareRecordNFTClassId,
+			Name:        "Tokenize Share Records",
+			Symbol:      "TSR",
+			Description: "Ownership of the rewards of tokenized staking shares",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	return k.nftKeeper.Mint(ctx, nft.NFT{
+		ClassId: types.TokenizeShareRecordNFTClassId,
+		Id:      record.GetNFTId(),
+	}, owner)
+}
+
+// burnTokenizeShareRecordNFT burns the NFT of a tokenize share record, if any
+func (k Keeper) burnTokenizeShareRecordNFT(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if k.nftKeeper == nil || !k.nftKeeper.HasNFT(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId()) {
+		return nil
+	}
+	return k.nftKeeper.Burn(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId())
+}
+
+// transferTokenizeShareRecordNFT moves the NFT of a tokenize share record, if any, to a new owner
+func (k Keeper) transferTokenizeShareRecordNFT(ctx sdk.Context, record types.TokenizeShareRecord, newOwner sdk.AccAddress) error {
+	if k.nftKeeper == nil || !k.nftKeeper.HasNFT(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId()) {
+		return nil
+	}
+	return k.nftKeeper.Transfer(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId(), newOwner)
+}
+
+// GetTokenizeShareRecordOwner returns the account entitled to the rewards of a
+// tokenize share record. When the record is represented by an NFT, the NFT owner
+// is the owner. Sends of record NFTs through the nft module are routed through
+// NewNFTMsgServerImpl, which keeps the record owner and owner index in sync.
+func (k Keeper) GetTokenizeShareRecordOwner(ctx sdk.Context, recordId uint64) (sdk.AccAddress, error) {
+	record, err := k.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return nil, err
+	}
+	return k.getTokenizeShareRecordOwner(ctx, record)
+}
+
+func (k Keeper) getTokenizeShareRecordOwner(ctx sdk.Context, record types.TokenizeShareRecord) (sdk.AccAddress, error) {
+	if k.nftKeeper != nil {
+		if owner := k.nftKeeper.GetOwner(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId()); owner != nil {
+			return owner, nil
+		}
+	}
+	return sdk.AccAddressFromBech32(record.Owner)
+}
+
+// withCurrentOwner returns the record with its owner field set to the current owner
+func (k Keeper) withCurrentOwner(ctx sdk.Context, record types.TokenizeShareRecord) types.TokenizeShareRecord {
+	if owner, err := k.getTokenizeShareRecordOwner(ctx, record); err == nil {
+		record.Owner = owner.String()
+	}
+	return record
+}
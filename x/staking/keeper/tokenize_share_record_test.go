@@ -1,6 +1,13 @@
 package keeper_test
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -21,24 +28,29 @@ func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 		Id:            0,
 		Owner:         owner1.String(),
 		ModuleAccount: "test-module-account-1",
-		Validator:     "test-validator",
+		Validator:     sdk.ValAddress("test-validator").String(),
 	}
 	tokenizeShareRecord2 := types.TokenizeShareRecord{
 		Id:            1,
 		Owner:         owner2.String(),
 		ModuleAccount: "test-module-account-2",
-		Validator:     "test-validator",
+		Validator:     sdk.ValAddress("test-validator").String(),
 	}
 	tokenizeShareRecord3 := types.TokenizeShareRecord{
 		Id:            2,
 		Owner:         owner1.String(),
 		ModuleAccount: "test-module-account-3",
-		Validator:     "test-validator",
+		Validator:     sdk.ValAddress("test-validator").String(),
 	}
 	app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord1)
 	app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord2)
 	app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord3)
 
+	// the share token denom is fixed when the record is added
+	tokenizeShareRecord1.Denom = tokenizeShareRecord1.GetShareTokenDenom()
+	tokenizeShareRecord2.Denom = tokenizeShareRecord2.GetShareTokenDenom()
+	tokenizeShareRecord3.Denom = tokenizeShareRecord3.GetShareTokenDenom()
+
 	tokenizeShareRecord, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 2)
 	suite.NoError(err)
 	suite.Equal(tokenizeShareRecord, tokenizeShareRecord3)
@@ -47,6 +59,13 @@ func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 	suite.NoError(err)
 	suite.Equal(tokenizeShareRecord, tokenizeShareRecord2)
 
+	tokenizeShareRecord, err = app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, tokenizeShareRecord1.GetModuleAddress())
+	suite.NoError(err)
+	suite.Equal(tokenizeShareRecord, tokenizeShareRecord1)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, owner1)
+	suite.Error(err)
+
 	tokenizeShareRecords := app.StakingKeeper.GetAllTokenizeShareRecords(ctx)
 	suite.Equal(len(tokenizeShareRecords), 3)
 
@@ -56,3 +75,349 @@ func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 	tokenizeShareRecords = app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner2)
 	suite.Equal(len(tokenizeShareRecords), 1)
 }
+
+func (suite *KeeperTestSuite) TestGetTokenizeShareRecordsByOwnerFrom() {
+	app, ctx := suite.app, suite.ctx
+	owner1, owner2 := suite.addrs[0], suite.addrs[1]
+
+	for id := uint64(1); id <= 5; id++ {
+		owner := owner1
+		if id == 3 {
+			owner = owner2
+		}
+		err := app.StakingKeeper.AddTokenizeShareRecord(ctx, types.TokenizeShareRecord{
+			Id:            id,
+			Owner:         owner.String(),
+			ModuleAccount: fmt.Sprintf("test-module-account-%d", id),
+			Validator:     sdk.ValAddress("test-validator").String(),
+		})
+		suite.NoError(err)
+	}
+
+	records, nextId := app.StakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, owner1, 0, 2)
+	suite.Require().Len(records, 2)
+	suite.Equal(uint64(1), records[0].Id)
+	suite.Equal(uint64(2), records[1].Id)
+	suite.Equal(uint64(4), nextId)
+
+	records, nextId = app.StakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, owner1, nextId, 2)
+	suite.Require().Len(records, 2)
+	suite.Equal(uint64(4), records[0].Id)
+	suite.Equal(uint64(5), records[1].Id)
+	suite.Equal(uint64(0), nextId)
+
+	records, nextId = app.StakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, owner2, 0, 10)
+	suite.Require().Len(records, 1)
+	suite.Equal(uint64(3), records[0].Id)
+	suite.Equal(uint64(0), nextId)
+	// a cursor whose record was deleted continues with the next record of the owner
+	suite.Require().NoError(app.StakingKeeper.DeleteTokenizeShareRecord(ctx, 4))
+	records, nextId = app.StakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, owner1, 4, 2)
+	suite.Require().Len(records, 1)
+	suite.Equal(uint64(5), records[0].Id)
+	suite.Equal(uint64(0), nextId)
+}
+
+func (suite *KeeperTestSuite) TestNFTSendOfTokenizeShareRecord() {
+	app, ctx := suite.app, suite.ctx
+	owner1, owner2 := suite.addrs[0], suite.addrs[1]
+	stakingKeeper := app.StakingKeeper
+	stakingKeeper.SetNFTKeeper(app.NFTKeeper)
+	nftMsgServer := keeper.NewNFTMsgServerImpl(stakingKeeper, app.NFTKeeper)
+
+	for id := uint64(1); id <= 12; id++ {
+		err := stakingKeeper.AddTokenizeShareRecord(ctx, types.TokenizeShareRecord{
+			Id:            id,
+			Owner:         owner1.String(),
+			ModuleAccount: fmt.Sprintf("test-module-account-%d", id),
+			Validator:     sdk.ValAddress("test-validator").String(),
+		})
+		suite.NoError(err)
+	}
+
+	// only the nft owner can send a record nft
+	record, err := stakingKeeper.GetTokenizeShareRecord(ctx, 2)
+	suite.Require().NoError(err)
+	send := &nft.MsgSend{
+		ClassId:  types.TokenizeShareRecordNFTClassId,
+		Id:       record.GetNFTId(),
+		Sender:   owner2.String(),
+		Receiver: owner2.String(),
+	}
+	_, err = nftMsgServer.Send(sdk.WrapSDKContext(ctx), send)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// an nft send moves the record owner and owner index along with the nft
+	send.Sender = owner1.String()
+	_, err = nftMsgServer.Send(sdk.WrapSDKContext(ctx), send)
+	suite.Require().NoError(err)
+	record, err = stakingKeeper.GetTokenizeShareRecord(ctx, 2)
+	suite.Require().NoError(err)
+	suite.Equal(owner2.String(), record.Owner)
+	suite.Equal(owner2, app.NFTKeeper.GetOwner(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId()))
+
+	// the records of an owner are listed in id order
+	records, nextId := stakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, owner1, 9, 2)
+	suite.Require().Len(records, 2)
+	suite.Equal(uint64(9), records[0].Id)
+	suite.Equal(uint64(10), records[1].Id)
+	suite.Equal(uint64(11), nextId)
+
+	records, nextId = stakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, owner1, 0, 2)
+	suite.Require().Len(records, 2)
+	suite.Equal(uint64(1), records[0].Id)
+	suite.Equal(uint64(3), records[1].Id)
+	suite.Equal(uint64(4), nextId)
+
+	records, nextId = stakingKeeper.GetTokenizeShareRecordsByOwnerFrom(ctx, owner2, 0, 10)
+	suite.Require().Len(records, 1)
+	suite.Equal(uint64(2), records[0].Id)
+	suite.Equal(uint64(0), nextId)
+}
+
+func (suite *KeeperTestSuite) TestGetTokenizeShareRecordsFrom() {
+	app, ctx := suite.app, suite.ctx
+
+	for id := uint64(1); id <= 5; id++ {
+		err := app.StakingKeeper.AddTokenizeShareRecord(ctx, types.TokenizeShareRecord{
+			Id:            id,
+			Owner:         suite.addrs[id%2].String(),
+			ModuleAccount: fmt.Sprintf("test-module-account-%d", id),
+			Validator:     sdk.ValAddress("test-validator").String(),
+		})
+		suite.NoError(err)
+	}
+
+	records, nextId := app.StakingKeeper.GetTokenizeShareRecordsFrom(ctx, 1, 3)
+	suite.Require().Len(records, 3)
+	suite.Equal(uint64(1), records[0].Id)
+	suite.Equal(uint64(3), records[2].Id)
+	suite.Equal(uint64(4), nextId)
+
+	records, nextId = app.StakingKeeper.GetTokenizeShareRecordsFrom(ctx, nextId, 3)
+	suite.Require().Len(records, 2)
+	suite.Equal(uint64(4), records[0].Id)
+	suite.Equal(uint64(5), records[1].Id)
+	suite.Equal(uint64(0), nextId)
+}
+
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	app, ctx := suite.app, suite.ctx
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         suite.addrs[0].String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     suite.vals[0].GetOperator().String(),
+	}
+	suite.NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	record.Denom = record.GetShareTokenDenom()
+
+	// remove the module account index as it did not exist prior to the migration
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(types.GetTokenizeShareRecordIdByModuleAccountKey(record.GetModuleAddress()))
+	_, err := app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, record.GetModuleAddress())
+	suite.Error(err)
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, app.GetSubspace(types.ModuleName)).Migrate3to4(ctx))
+
+	migrated, err := app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, record.GetModuleAddress())
+	suite.NoError(err)
+	suite.Equal(record, migrated)
+}
+
+func (suite *KeeperTestSuite) TestMigrate4to5() {
+	app, ctx := suite.app, suite.ctx
+
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyMinValidatorBond, sdk.NewDec(10))
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, subspace).Migrate4to5(ctx))
+	var minValidatorBond sdk.Dec
+	subspace.Get(ctx, types.KeyMinValidatorBond, &minValidatorBond)
+	suite.Equal(types.DefaultMinValidatorBond, minValidatorBond)
+}
+
+func (suite *KeeperTestSuite) TestMigrate5to6() {
+	app, ctx := suite.app, suite.ctx
+
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyMinValidatorBondGracePeriod, uint64(100))
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, subspace).Migrate5to6(ctx))
+	var minValidatorBondGracePeriod uint64
+	subspace.Get(ctx, types.KeyMinValidatorBondGracePeriod, &minValidatorBondGracePeriod)
+	suite.Equal(types.DefaultMinValidatorBondGracePeriod, minValidatorBondGracePeriod)
+}
+
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	app, ctx := suite.app, suite.ctx
+
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyValidatorBondSlashMultiplier, sdk.NewDec(2))
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, subspace).Migrate6to7(ctx))
+	var validatorBondSlashMultiplier sdk.Dec
+	subspace.Get(ctx, types.KeyValidatorBondSlashMultiplier, &validatorBondSlashMultiplier)
+	suite.Equal(types.DefaultValidatorBondSlashMultiplier, validatorBondSlashMultiplier)
+}
+
+func (suite *KeeperTestSuite) TestMigrate7to8() {
+	app, ctx := suite.app, suite.ctx
+	valAddr := sdk.ValAddress("test-validator")
+
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyTombstoneFallbackValidator, sdk.ValAddress("fallback").String())
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         suite.addrs[0].String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     valAddr.String(),
+	}
+	suite.NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+
+	// remove the denom and the validator index as they did not exist prior to the migration
+	stored, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	suite.Require().NoError(err)
+	suite.Equal(record.GetShareTokenDenom(), stored.Denom)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), app.AppCodec().MustMarshal(&record))
+	store.Delete(types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, record.Id))
+	suite.Empty(app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr))
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, subspace).Migrate7to8(ctx))
+	var tombstoneFallbackValidator string
+	subspace.Get(ctx, types.KeyTombstoneFallbackValidator, &tombstoneFallbackValidator)
+	suite.Equal(types.DefaultTombstoneFallbackValidator, tombstoneFallbackValidator)
+	suite.Equal([]types.TokenizeShareRecord{stored}, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr))
+}
+
+func (suite *KeeperTestSuite) TestValidatorTokenizedShares() {
+	app, ctx := suite.app, suite.ctx
+	owner, valAddr := suite.addrs[0], suite.vals[0].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	tokenizeResp, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    owner.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 2)),
+		TokenizedShareOwner: owner.String(),
+	})
+	suite.Require().NoError(err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, tokenizeResp.Amount.Denom)
+	suite.Require().NoError(err)
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	suite.Require().True(found)
+	suite.Equal(delegation.Shares, app.StakingKeeper.GetValidatorTokenizedShares(ctx, valAddr))
+
+	// delegations of regular accounts are not counted
+	suite.Equal(sdk.ZeroDec(), app.StakingKeeper.GetValidatorTokenizedShares(ctx, suite.vals[1].GetOperator()))
+
+	// redeeming half of the share tokens removes half of the tokenized shares
+	redeemAmount := tokenizeResp.Amount
+	redeemAmount.Amount = redeemAmount.Amount.QuoRaw(2)
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: owner.String(),
+		Amount:           redeemAmount,
+	})
+	suite.Require().NoError(err)
+	delegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	suite.Require().True(found)
+	suite.Equal(delegation.Shares, app.StakingKeeper.GetValidatorTokenizedShares(ctx, valAddr))
+
+	// the migration recomputes the tokenized shares from the records
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.GetValidatorTokenizedSharesKey(valAddr))
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, app.GetSubspace(types.ModuleName)).Migrate3to4(ctx))
+	suite.Equal(delegation.Shares, app.StakingKeeper.GetValidatorTokenizedShares(ctx, valAddr))
+
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: owner.String(),
+		Amount:           tokenizeResp.Amount.Sub(redeemAmount),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.ZeroDec(), app.StakingKeeper.GetValidatorTokenizedShares(ctx, valAddr))
+}
+
+func (suite *KeeperTestSuite) TestMigrate8to9() {
+	app, ctx := suite.app, suite.ctx
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         suite.addrs[0].String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     suite.vals[0].GetOperator().String(),
+	}
+	suite.NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	suite.False(app.NFTKeeper.HasNFT(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId()))
+
+	stakingKeeper := app.StakingKeeper
+	stakingKeeper.SetNFTKeeper(app.NFTKeeper)
+	suite.NoError(keeper.NewMigrator(stakingKeeper, app.GetSubspace(types.ModuleName)).Migrate8to9(ctx))
+	suite.Equal(suite.addrs[0], app.NFTKeeper.GetOwner(ctx, types.TokenizeShareRecordNFTClassId, record.GetNFTId()))
+}
+
+func (suite *KeeperTestSuite) TestMigrate10to11() {
+	app, ctx := suite.app, suite.ctx
+
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyTokenizeShareRecordVotingEnabled, true)
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, subspace).Migrate10to11(ctx))
+	var tokenizeShareRecordVotingEnabled bool
+	subspace.Get(ctx, types.KeyTokenizeShareRecordVotingEnabled, &tokenizeShareRecordVotingEnabled)
+	suite.Equal(types.DefaultTokenizeShareRecordVotingEnabled, tokenizeShareRecordVotingEnabled)
+}
+
+func (suite *KeeperTestSuite) TestMigrate11to12() {
+	app, ctx := suite.app, suite.ctx
+
+	params := types.DefaultParams()
+	params.MinValidatorBond = sdk.NewDec(10)
+	params.MinValidatorBondGracePeriod = 100
+	params.TokenizeShareRecordVotingEnabled = true
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(ctx, &params)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	suite.NoError(keeper.NewMigrator(app.StakingKeeper, subspace).Migrate11to12(ctx))
+	suite.Equal(params, app.StakingKeeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestPruneTokenizeShareRecords() {
+	app, ctx := suite.app, suite.ctx
+	valAddr := suite.vals[0].GetOperator()
+
+	for id := uint64(1); id <= 2; id++ {
+		err := app.StakingKeeper.AddTokenizeShareRecord(ctx, types.TokenizeShareRecord{
+			Id:            id,
+			Owner:         suite.addrs[0].String(),
+			ModuleAccount: fmt.Sprintf("tokenizeshare_%d", id),
+			Validator:     valAddr.String(),
+		})
+		suite.NoError(err)
+	}
+
+	// the first record no longer holds a delegation
+	emptyRecord, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	suite.Require().NoError(err)
+
+	// the second record still holds a delegation
+	activeRecord, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 2)
+	suite.Require().NoError(err)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(activeRecord.GetModuleAddress(), valAddr, sdk.NewDec(100), false))
+	suite.False(app.StakingKeeper.IsTokenizeShareRecordEmpty(ctx, activeRecord))
+	suite.True(app.StakingKeeper.IsTokenizeShareRecordEmpty(ctx, emptyRecord))
+
+	app.StakingKeeper.PruneTokenizeShareRecords(ctx)
+	suite.Equal(uint64(0), app.StakingKeeper.GetTokenizeShareRecordPruneCursor(ctx))
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	suite.Error(err)
+	_, err = app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, emptyRecord.GetModuleAddress())
+	suite.Error(err)
+	suite.Len(app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, suite.addrs[0]), 1)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, 2)
+	suite.NoError(err)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// VoteWithTokenizeShareRecord casts a governance vote of the record owner on behalf
+// of the record's module account. The vote is tallied like the vote of any other
+// delegator, overriding the vote of the validator for the record's delegation.
+func (k Keeper) VoteWithTokenizeShareRecord(
+	ctx sdk.Context, owner sdk.AccAddress, recordId, proposalId uint64, options govv1.WeightedVoteOptions, metadata string,
+) error {
+	if !k.TokenizeShareRecordVotingEnabled(ctx) {
+		return types.ErrTokenizeShareRecordVotingDisabled
+	}
+	if k.govKeeper == nil {
+		return types.ErrTokenizeShareRecordVotingDisabled.Wrap("gov keeper is not set")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+	recordOwner, err := k.getTokenizeShareRecordOwner(ctx, record)
+	if err != nil {
+		return err
+	}
+	if !recordOwner.Equals(owner) {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if err := k.govKeeper.AddVote(ctx, proposalId, record.GetModuleAddress(), options, metadata); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteWithTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalId, fmt.Sprintf("%d", proposalId)),
+			sdk.NewAttribute(types.AttributeKeyVoteOptions, options.String()),
+		),
+	)
+
+	return nil
+}
+
+// GovStakingKeeper is the staking keeper used by the gov module to tally votes.
+// While voting with tokenize share records is disabled, the delegations of record
+// module accounts are hidden from the tally, so that votes cast with records
+// before the param was turned off are ignored and the validator vote applies.
+type GovStakingKeeper struct {
+	*Keeper
+}
+
+// NewGovStakingKeeper returns the staking keeper to pass to the gov module
+func NewGovStakingKeeper(k *Keeper) GovStakingKeeper {
+	return GovStakingKeeper{Keeper: k}
+}
+
+// IterateDelegations iterates through the delegations of a voter, skipping the
+// delegations of record module accounts while voting with records is disabled
+func (k GovStakingKeeper) IterateDelegations(ctx sdk.Context, delAddr sdk.AccAddress,
+	fn func(index int64, del sdkstaking.DelegationI) (stop bool),
+) {
+	if !k.TokenizeShareRecordVotingEnabled(ctx) {
+		if _, err := k.GetTokenizeShareRecordByModuleAccount(ctx, delAddr); err == nil {
+			return
+		}
+	}
+
+	k.Keeper.IterateDelegations(ctx, delAddr, fn)
+}
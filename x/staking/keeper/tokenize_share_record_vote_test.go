@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestVoteWithTokenizeShareRecord() {
+	app, ctx := suite.app, suite.ctx
+	owner, valAddr := suite.addrs[0], suite.vals[0].GetOperator()
+
+	stakingKeeper := app.StakingKeeper
+	stakingKeeper.SetGovKeeper(app.GovKeeper)
+	msgServer := keeper.NewMsgServerImpl(stakingKeeper)
+
+	tokenizeResp, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    owner.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 1)),
+		TokenizedShareOwner: owner.String(),
+	})
+	suite.Require().NoError(err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, tokenizeResp.Amount.Denom)
+	suite.Require().NoError(err)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, nil, "")
+	suite.Require().NoError(err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, found := app.GovKeeper.GetProposal(ctx, proposal.Id)
+	suite.Require().True(found)
+
+	// the validator votes yes with all of its delegations
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, sdk.AccAddress(valAddr), govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+	vote := func(voter sdk.AccAddress) error {
+		msg := types.NewMsgVoteWithTokenizeShareRecord(voter, record.Id, proposal.Id, govv1.NewNonSplitVoteOption(govv1.OptionNo), "")
+		suite.Require().NoError(msg.ValidateBasic())
+		_, err := msgServer.VoteWithTokenizeShareRecord(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+	setVotingEnabled := func(enabled bool) {
+		params := app.StakingKeeper.GetParams(ctx)
+		params.TokenizeShareRecordVotingEnabled = enabled
+		app.StakingKeeper.SetParams(ctx, params)
+	}
+
+	// voting with records is disabled by default
+	suite.ErrorIs(vote(owner), types.ErrTokenizeShareRecordVotingDisabled)
+
+	// only the record owner can vote with the record
+	setVotingEnabled(true)
+	suite.ErrorIs(vote(suite.addrs[1]), types.ErrNotTokenizeShareRecordOwner)
+	suite.Require().NoError(vote(owner))
+	_, found = app.GovKeeper.GetVote(ctx, proposal.Id, record.GetModuleAddress())
+	suite.True(found)
+
+	// the vote of the record overrides the validator vote for the record's delegation
+	_, _, tally := app.GovKeeper.Tally(ctx, proposal)
+	suite.Equal(app.StakingKeeper.TokensFromConsensusPower(ctx, 1).String(), tally.NoCount)
+
+	// votes cast with records are ignored while voting with records is disabled
+	setVotingEnabled(false)
+	_, _, tally = app.GovKeeper.Tally(ctx, proposal)
+	suite.Equal(sdk.ZeroInt().String(), tally.NoCount)
+}
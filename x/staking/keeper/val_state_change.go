@@ -25,6 +25,11 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbonded after the Endblocker (go from Bonded -> Unbonding during
 	// ApplyAndReturnValidatorSetUpdates and then Unbonding -> Unbonded during
 	// UnbondAllMatureValidatorQueue).
+	//
+	// Validators that remained below the minimum validator bond past the grace
+	// period are jailed first so that they leave the bonded set in this block.
+	k.EnforceMinValidatorBond(ctx)
+
 	validatorUpdates, err := k.ApplyAndReturnValidatorSetUpdates(ctx)
 	if err != nil {
 		panic(err)
@@ -167,6 +167,24 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	return commission, nil
 }
 
+// UpdateValidatorLiquidCommission attempts to update a validator's liquid commission rate.
+// An error is returned if the new liquid commission rate is invalid.
+func (k Keeper) UpdateValidatorLiquidCommission(ctx sdk.Context,
+	validator types.Validator, newLiquidRate sdk.Dec,
+) (types.Commission, error) {
+	commission := validator.Commission
+	blockTime := ctx.BlockHeader().Time
+
+	if err := commission.ValidateNewLiquidRate(newLiquidRate, blockTime); err != nil {
+		return commission, err
+	}
+
+	commission.LiquidCommissionRate = &newLiquidRate
+	commission.UpdateTime = blockTime
+
+	return commission, nil
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// GetValidatorBondShortfall returns the height at which the validator bond of a
+// validator fell below the minimum validator bond
+func (k Keeper) GetValidatorBondShortfall(ctx sdk.Context, valAddr sdk.ValAddress) (startHeight int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorBondShortfallKey(valAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetValidatorBondShortfall sets the height at which the validator bond of a
+// validator fell below the minimum validator bond
+func (k Keeper) SetValidatorBondShortfall(ctx sdk.Context, valAddr sdk.ValAddress, startHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondShortfallKey(valAddr), sdk.Uint64ToBigEndian(uint64(startHeight)))
+}
+
+// DeleteValidatorBondShortfall removes the validator bond shortfall of a validator
+func (k Keeper) DeleteValidatorBondShortfall(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorBondShortfallKey(valAddr))
+}
+
+// IterateValidatorBondShortfalls iterates over the validator bond shortfalls
+func (k Keeper) IterateValidatorBondShortfalls(ctx sdk.Context, handler func(valAddr sdk.ValAddress, startHeight int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorBondShortfallKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromValidatorBondShortfallKey(iter.Key()))
+		if handler(valAddr, int64(sdk.BigEndianToUint64(iter.Value()))) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorBondShortfalls returns all validator bond shortfalls along with
+// the height at which each validator will be jailed
+func (k Keeper) GetAllValidatorBondShortfalls(ctx sdk.Context) (shortfalls []types.ValidatorBondShortfall) {
+	gracePeriod := int64(k.MinValidatorBondGracePeriod(ctx))
+	k.IterateValidatorBondShortfalls(ctx, func(valAddr sdk.ValAddress, startHeight int64) bool {
+		validator, found := k.GetLiquidValidator(ctx, valAddr)
+		if !found {
+			return false
+		}
+
+		shortfalls = append(shortfalls, types.ValidatorBondShortfall{
+			ValidatorAddress: valAddr.String(),
+			ValidatorBond:    validator.ValidatorBondTokens(),
+			StartHeight:      startHeight,
+			JailHeight:       startHeight + gracePeriod,
+		})
+		return false
+	})
+	return shortfalls
+}
+
+// IsBelowMinValidatorBond returns true if the tokens backing the validator bond
+// shares of a validator are below the minimum validator bond
+func (k Keeper) IsBelowMinValidatorBond(ctx sdk.Context, validator types.Validator) bool {
+	minValidatorBond := k.MinValidatorBond(ctx)
+	if !minValidatorBond.IsPositive() {
+		return false
+	}
+	return validator.ValidatorBondTokens().LT(minValidatorBond)
+}
+
+// CheckValidatorBondShortfall starts the grace period of a bonded validator whose
+// validator bond fell below the minimum validator bond, emitting a warning event
+func (k Keeper) CheckValidatorBondShortfall(ctx sdk.Context, validator types.Validator) {
+	if !validator.IsBonded() || validator.Jailed || !k.IsBelowMinValidatorBond(ctx, validator) {
+		return
+	}
+
+	valAddr := validator.GetOperator()
+	if _, found := k.GetValidatorBondShortfall(ctx, valAddr); found {
+		return
+	}
+
+	startHeight := ctx.BlockHeight()
+	k.SetValidatorBondShortfall(ctx, valAddr, startHeight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorBondShortfall,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorBond, validator.ValidatorBondTokens().String()),
+			sdk.NewAttribute(types.AttributeKeyMinBond, k.MinValidatorBond(ctx).String()),
+			sdk.NewAttribute(types.AttributeKeyJailHeight, fmt.Sprintf("%d", startHeight+int64(k.MinValidatorBondGracePeriod(ctx)))),
+		),
+	)
+}
+
+// EnforceMinValidatorBond jails the bonded validators that remained below the
+// minimum validator bond for longer than the grace period, so that they are
+// removed from the bonded set in the validator set updates of the same block
+func (k Keeper) EnforceMinValidatorBond(ctx sdk.Context) {
+	for _, validator := range k.GetLastValidators(ctx) {
+		k.CheckValidatorBondShortfall(ctx, validator)
+	}
+
+	type shortfall struct {
+		valAddr     sdk.ValAddress
+		startHeight int64
+	}
+	var shortfalls []shortfall
+	k.IterateValidatorBondShortfalls(ctx, func(valAddr sdk.ValAddress, startHeight int64) bool {
+		shortfalls = append(shortfalls, shortfall{valAddr, startHeight})
+		return false
+	})
+
+	gracePeriod := int64(k.MinValidatorBondGracePeriod(ctx))
+	for _, s := range shortfalls {
+		validator, found := k.GetLiquidValidator(ctx, s.valAddr)
+		if !found || validator.Jailed || !validator.IsBonded() {
+			k.DeleteValidatorBondShortfall(ctx, s.valAddr)
+			continue
+		}
+
+		if !k.IsBelowMinValidatorBond(ctx, validator) {
+			k.DeleteValidatorBondShortfall(ctx, s.valAddr)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeValidatorBondRestored,
+					sdk.NewAttribute(types.AttributeKeyValidator, s.valAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyValidatorBond, validator.ValidatorBondTokens().String()),
+				),
+			)
+			continue
+		}
+
+		if ctx.BlockHeight() < s.startHeight+gracePeriod {
+			continue
+		}
+
+		k.jailValidator(ctx, validator)
+		k.DeleteValidatorBondShortfall(ctx, s.valAddr)
+		k.Logger(ctx).Info("validator jailed for insufficient validator bond", "validator", s.valAddr.String())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorBondJail,
+				sdk.NewAttribute(types.AttributeKeyValidator, s.valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidatorBond, validator.ValidatorBondTokens().String()),
+				sdk.NewAttribute(types.AttributeKeyMinBond, k.MinValidatorBond(ctx).String()),
+			),
+		)
+	}
+}
+
+// TransferValidatorBond moves validator bond shares from a delegator's validator
+// bond delegation to the recipient's delegation on the same validator. The
+// recipient's delegation is created as a validator bond delegation if it does not
+// exist, so the validator's TotalValidatorBondShares is unchanged.
+func (k Keeper) TransferValidatorBond(
+	ctx sdk.Context, delAddr, recipient sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+	if delAddr.Equals(recipient) {
+		return sdkerrors.ErrInvalidRequest.Wrap("delegator and recipient cannot be the same")
+	}
+
+	delegation, found := k.GetLiquidDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdkstaking.ErrNoDelegation
+	}
+	if !delegation.ValidatorBond {
+		return errorsmod.Wrap(types.ErrNotValidatorBondDelegation, "delegator")
+	}
+	if delegation.Shares.LT(shares) {
+		return errorsmod.Wrap(sdkstaking.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	recipientDelegation, recipientFound := k.GetLiquidDelegation(ctx, recipient, valAddr)
+	if recipientFound && !recipientDelegation.ValidatorBond {
+		return errorsmod.Wrap(types.ErrNotValidatorBondDelegation, "recipient")
+	}
+
+	// settle the rewards of both delegations before their shares change
+	if err := k.BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	var err error
+	if recipientFound {
+		err = k.BeforeDelegationSharesModified(ctx, recipient, valAddr)
+	} else {
+		err = k.BeforeDelegationCreated(ctx, recipient, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		err = k.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	// as on unbonding, a bonded validator whose operator's self-delegation falls
+	// below the minimum self-delegation is jailed
+	if sdk.ValAddress(delAddr).Equals(valAddr) {
+		validator, found := k.GetLiquidValidator(ctx, valAddr)
+		if found && validator.IsBonded() && !validator.Jailed &&
+			validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.GetMinSelfDelegation()) {
+			k.jailValidator(ctx, validator)
+		}
+	}
+
+	// read the recipient delegation again so that it reflects the sender's write
+	if recipientDelegation, recipientFound = k.GetLiquidDelegation(ctx, recipient, valAddr); !recipientFound {
+		recipientDelegation = types.NewDelegation(recipient, valAddr, sdk.ZeroDec(), true)
+	}
+	recipientDelegation.Shares = recipientDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, recipientDelegation)
+	if err := k.AfterDelegationModified(ctx, recipient, valAddr); err != nil {
+		return err
+	}
+
+	if err := k.AfterValidatorBondChanged(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+	return k.AfterValidatorBondChanged(ctx, recipient, valAddr)
+}
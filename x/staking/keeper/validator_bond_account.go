@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// HasValidatorBondAccount returns true if the account is in the validator's
+// validator bond allowlist
+func (k Keeper) HasValidatorBondAccount(ctx sdk.Context, valAddr sdk.ValAddress, accAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorBondAccountKey(valAddr, accAddr))
+}
+
+// SetValidatorBondAccount adds an account to the validator's validator bond allowlist
+func (k Keeper) SetValidatorBondAccount(ctx sdk.Context, valAddr sdk.ValAddress, accAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondAccountKey(valAddr, accAddr), []byte{})
+}
+
+// DeleteValidatorBondAccount removes an account from the validator's validator bond allowlist
+func (k Keeper) DeleteValidatorBondAccount(ctx sdk.Context, valAddr sdk.ValAddress, accAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorBondAccountKey(valAddr, accAddr))
+}
+
+// HasValidatorBondAllowlist returns true if the validator operator has opted in
+// to restricting validator bonds to an allowlist of accounts. The opt-in stays
+// set when the allowlist becomes empty, until the operator clears it.
+func (k Keeper) HasValidatorBondAllowlist(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorBondAllowlistKey(valAddr))
+}
+
+// EnableValidatorBondAllowlist opts a validator in to restricting validator bonds to its allowlist
+func (k Keeper) EnableValidatorBondAllowlist(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondAllowlistKey(valAddr), []byte{})
+}
+
+// DisableValidatorBondAllowlist opts a validator out of restricting validator bonds to its allowlist
+func (k Keeper) DisableValidatorBondAllowlist(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorBondAllowlistKey(valAddr))
+}
+
+// GetAllValidatorBondAllowlistValidators returns the validators that opted in to
+// restricting validator bonds to their allowlist
+func (k Keeper) GetAllValidatorBondAllowlistValidators(ctx sdk.Context) (valAddrs []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorBondAllowlistKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(types.AddressFromValidatorBondAllowlistKey(iter.Key())).String())
+	}
+	return valAddrs
+}
+
+// IsValidatorBondAllowed returns true if the account may mark its delegation to
+// the validator as validator bond. Unless the operator opted in to an allowlist
+// any account is allowed, otherwise only the operator's own account and the
+// listed accounts are.
+func (k Keeper) IsValidatorBondAllowed(ctx sdk.Context, valAddr sdk.ValAddress, accAddr sdk.AccAddress) bool {
+	if accAddr.Equals(sdk.AccAddress(valAddr)) || !k.HasValidatorBondAllowlist(ctx, valAddr) {
+		return true
+	}
+	return k.HasValidatorBondAccount(ctx, valAddr, accAddr)
+}
+
+// IterateValidatorBondAccounts iterates over the validator bond allowlists of all validators
+func (k Keeper) IterateValidatorBondAccounts(ctx sdk.Context, handler func(valAddr sdk.ValAddress, accAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorBondAccountKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		valAddr, accAddr := types.ParseValidatorBondAccountKey(iter.Key())
+		if handler(valAddr, accAddr) {
+			break
+		}
+	}
+}
+
+// GetValidatorBondAccounts returns the accounts in the validator's validator bond allowlist
+func (k Keeper) GetValidatorBondAccounts(ctx sdk.Context, valAddr sdk.ValAddress) (accAddrs []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorBondAccountsKey(valAddr))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, accAddr := types.ParseValidatorBondAccountKey(iter.Key())
+		accAddrs = append(accAddrs, accAddr.String())
+	}
+	return accAddrs
+}
+
+// GetAllValidatorBondAccounts returns the validator bond allowlist entries of all validators
+func (k Keeper) GetAllValidatorBondAccounts(ctx sdk.Context) (accounts []types.ValidatorBondAccount) {
+	k.IterateValidatorBondAccounts(ctx, func(valAddr sdk.ValAddress, accAddr sdk.AccAddress) bool {
+		accounts = append(accounts, types.ValidatorBondAccount{
+			ValidatorAddress: valAddr.String(),
+			AccountAddress:   accAddr.String(),
+		})
+		return false
+	})
+	return accounts
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestValidatorBondAccounts() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	delAddr, otherAddr, valAddr := suite.addrs[0], suite.addrs[2], suite.vals[1].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	validatorBond := func() error {
+		_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(delAddr, valAddr))
+		return err
+	}
+
+	// without an allowlist any delegator may validator bond
+	suite.False(app.StakingKeeper.HasValidatorBondAllowlist(ctx, valAddr))
+	suite.True(app.StakingKeeper.IsValidatorBondAllowed(ctx, valAddr, delAddr))
+
+	// once the operator adds an account, only listed accounts may validator bond
+	_, err := msgServer.AddValidatorBondAccount(sdk.WrapSDKContext(ctx), types.NewMsgAddValidatorBondAccount(valAddr, otherAddr))
+	suite.Require().NoError(err)
+	_, err = msgServer.AddValidatorBondAccount(sdk.WrapSDKContext(ctx), types.NewMsgAddValidatorBondAccount(valAddr, otherAddr))
+	suite.ErrorIs(err, types.ErrValidatorBondAccountAlreadyExists)
+
+	suite.True(app.StakingKeeper.IsValidatorBondAllowed(ctx, valAddr, sdk.AccAddress(valAddr)))
+	suite.ErrorIs(validatorBond(), types.ErrValidatorBondAccountNotAllowed)
+
+	// the allowlist of another validator is unaffected
+	suite.True(app.StakingKeeper.IsValidatorBondAllowed(ctx, suite.vals[0].GetOperator(), otherAddr))
+
+	_, err = msgServer.AddValidatorBondAccount(sdk.WrapSDKContext(ctx), types.NewMsgAddValidatorBondAccount(valAddr, delAddr))
+	suite.Require().NoError(err)
+	suite.Require().NoError(validatorBond())
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.True(delegation.ValidatorBond)
+
+	res, err := queryClient.ValidatorBondAccounts(gocontext.Background(), &types.QueryValidatorBondAccountsRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.ElementsMatch([]string{delAddr.String(), otherAddr.String()}, res.AccountAddresses)
+	suite.Len(app.StakingKeeper.ExportGenesis(ctx).ValidatorBondAccounts, 2)
+
+	// removing an account does not affect delegations already marked as validator bond
+	_, err = msgServer.RemoveValidatorBondAccount(sdk.WrapSDKContext(ctx), types.NewMsgRemoveValidatorBondAccount(valAddr, delAddr))
+	suite.Require().NoError(err)
+	_, err = msgServer.RemoveValidatorBondAccount(sdk.WrapSDKContext(ctx), types.NewMsgRemoveValidatorBondAccount(valAddr, delAddr))
+	suite.ErrorIs(err, types.ErrValidatorBondAccountNotFound)
+
+	delegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.True(delegation.ValidatorBond)
+	suite.Equal([]string{otherAddr.String()}, app.StakingKeeper.GetValidatorBondAccounts(ctx, valAddr))
+
+	// removing the last account keeps the validator opted in to the allowlist
+	_, err = msgServer.RemoveValidatorBondAccount(sdk.WrapSDKContext(ctx), types.NewMsgRemoveValidatorBondAccount(valAddr, otherAddr))
+	suite.Require().NoError(err)
+	suite.True(app.StakingKeeper.HasValidatorBondAllowlist(ctx, valAddr))
+	suite.False(app.StakingKeeper.IsValidatorBondAllowed(ctx, valAddr, otherAddr))
+	suite.Equal([]string{valAddr.String()}, app.StakingKeeper.ExportGenesis(ctx).ValidatorBondAllowlistValidators)
+
+	res, err = queryClient.ValidatorBondAccounts(gocontext.Background(), &types.QueryValidatorBondAccountsRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Empty(res.AccountAddresses)
+	suite.True(res.Enabled)
+
+	// until the operator opts out
+	_, err = msgServer.SetValidatorBondAllowlist(sdk.WrapSDKContext(ctx), types.NewMsgSetValidatorBondAllowlist(valAddr, false))
+	suite.Require().NoError(err)
+	suite.False(app.StakingKeeper.HasValidatorBondAllowlist(ctx, valAddr))
+	suite.True(app.StakingKeeper.IsValidatorBondAllowed(ctx, valAddr, otherAddr))
+
+	// the operator can also opt in without listing any account
+	_, err = msgServer.SetValidatorBondAllowlist(sdk.WrapSDKContext(ctx), types.NewMsgSetValidatorBondAllowlist(valAddr, true))
+	suite.Require().NoError(err)
+	suite.False(app.StakingKeeper.IsValidatorBondAllowed(ctx, valAddr, otherAddr))
+	suite.True(app.StakingKeeper.IsValidatorBondAllowed(ctx, valAddr, sdk.AccAddress(valAddr)))
+
+	_, err = msgServer.SetValidatorBondAllowlist(sdk.WrapSDKContext(ctx), types.NewMsgSetValidatorBondAllowlist(sdk.ValAddress("unknown"), true))
+	suite.Error(err)
+}
@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestEnforceMinValidatorBond() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	valAddr1, valAddr2 := suite.vals[0].GetOperator(), suite.vals[1].GetOperator()
+
+	// the minimum validator bond is disabled by default
+	app.StakingKeeper.EnforceMinValidatorBond(ctx)
+	res, err := queryClient.ValidatorBondShortfalls(gocontext.Background(), &types.QueryValidatorBondShortfallsRequest{})
+	suite.Require().NoError(err)
+	suite.Empty(res.Shortfalls)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinValidatorBond = sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	params.MinValidatorBondGracePeriod = 10
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the shortfall of every bonded validator is detected and reported
+	ctx = ctx.WithBlockHeight(10)
+	app.StakingKeeper.EnforceMinValidatorBond(ctx)
+	res, err = queryClient.ValidatorBondShortfalls(gocontext.Background(), &types.QueryValidatorBondShortfallsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Shortfalls, len(app.StakingKeeper.GetLastValidators(ctx)))
+	for _, shortfall := range res.Shortfalls {
+		suite.True(shortfall.ValidatorBond.IsZero())
+		suite.Equal(int64(10), shortfall.StartHeight)
+		suite.Equal(int64(20), shortfall.JailHeight)
+	}
+
+	// no validator is jailed during the grace period
+	ctx = ctx.WithBlockHeight(19)
+	app.StakingKeeper.EnforceMinValidatorBond(ctx)
+	suite.False(app.StakingKeeper.Validator(ctx, valAddr1).IsJailed())
+
+	// the second validator restores its validator bond
+	validator2, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr2)
+	suite.Require().True(found)
+	validator2.TotalValidatorBondShares = validator2.DelegatorShares
+	app.StakingKeeper.SetValidator(ctx, validator2)
+
+	// the first validator is jailed once the grace period ends
+	ctx = ctx.WithBlockHeight(20)
+	app.StakingKeeper.EnforceMinValidatorBond(ctx)
+	suite.True(app.StakingKeeper.Validator(ctx, valAddr1).IsJailed())
+	suite.False(app.StakingKeeper.Validator(ctx, valAddr2).IsJailed())
+	_, found = app.StakingKeeper.GetValidatorBondShortfall(ctx, valAddr1)
+	suite.False(found)
+	_, found = app.StakingKeeper.GetValidatorBondShortfall(ctx, valAddr2)
+	suite.False(found)
+
+	// the jailed validator leaves the bonded set
+	applyValidatorSetUpdates(suite.T(), ctx, app.StakingKeeper, -1)
+	suite.Equal(sdkstaking.Unbonding, app.StakingKeeper.Validator(ctx, valAddr1).GetStatus())
+}
+
+func (suite *KeeperTestSuite) TestCheckValidatorBondShortfall() {
+	app, ctx := suite.app, suite.ctx
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, suite.vals[0].GetOperator())
+	suite.Require().True(found)
+	validator.TotalValidatorBondShares = validator.DelegatorShares
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinValidatorBond = validator.TokensFromShares(validator.DelegatorShares)
+	params.MinValidatorBondGracePeriod = 10
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// a validator meeting the minimum has no shortfall
+	app.StakingKeeper.CheckValidatorBondShortfall(ctx, validator)
+	_, found = app.StakingKeeper.GetValidatorBondShortfall(ctx, validator.GetOperator())
+	suite.False(found)
+
+	// a withdrawal of validator bond below the minimum starts the grace period
+	validator.TotalValidatorBondShares = validator.DelegatorShares.QuoInt64(2)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.StakingKeeper.CheckValidatorBondShortfall(ctx, validator)
+	startHeight, found := app.StakingKeeper.GetValidatorBondShortfall(ctx, validator.GetOperator())
+	suite.True(found)
+	suite.Equal(ctx.BlockHeight(), startHeight)
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+	suite.Equal(types.EventTypeValidatorBondShortfall, ctx.EventManager().Events()[0].Type)
+}
+
+func (suite *KeeperTestSuite) TestValidatorBondDelegationShares() {
+	app, ctx := suite.app, suite.ctx
+	delAddr, valAddr := suite.addrs[0], suite.vals[1].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	totalValidatorBondShares := func() sdk.Dec {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		suite.Require().True(found)
+		return validator.TotalValidatorBondShares
+	}
+
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(delAddr, valAddr))
+	suite.Require().NoError(err)
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Equal(delegation.Shares, totalValidatorBondShares())
+
+	// delegating into a validator bond delegation adds to the validator bond
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 2))
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, amount))
+	suite.Require().NoError(err)
+	delegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Equal(delegation.Shares, totalValidatorBondShares())
+
+	// undelegating reduces the validator bond even when the validator bond factor is disabled
+	suite.True(app.StakingKeeper.ValidatorBondFactor(ctx).IsNegative())
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinValidatorBond = sdk.NewDecFromInt(delegation.Shares.TruncateInt())
+	params.MinValidatorBondGracePeriod = 10
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, valAddr, amount))
+	suite.Require().NoError(err)
+	delegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Equal(delegation.Shares, totalValidatorBondShares())
+	_, found = app.StakingKeeper.GetValidatorBondShortfall(ctx, valAddr)
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestTransferValidatorBond() {
+	app, ctx := suite.app, suite.ctx
+	delAddr, recipient, valAddr := suite.addrs[0], suite.addrs[2], suite.vals[1].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	transfer := func(shares sdk.Dec) error {
+		_, err := msgServer.TransferValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgTransferValidatorBond(delAddr, valAddr, recipient, shares))
+		return err
+	}
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	totalShares := delegation.Shares
+	shares := totalShares.QuoInt64(3)
+
+	// only validator bond delegations can be transferred
+	suite.ErrorIs(transfer(shares), types.ErrNotValidatorBondDelegation)
+
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(delAddr, valAddr))
+	suite.Require().NoError(err)
+
+	suite.ErrorIs(transfer(totalShares.Add(sdk.OneDec())), sdkstaking.ErrNotEnoughDelegationShares)
+
+	// a self-transfer is rejected even when the recipient is written in upper case
+	selfTransfer := types.NewMsgTransferValidatorBond(delAddr, valAddr, delAddr, shares)
+	selfTransfer.RecipientAddress = strings.ToUpper(selfTransfer.RecipientAddress)
+	suite.ErrorIs(selfTransfer.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.TransferValidatorBond(sdk.WrapSDKContext(ctx), selfTransfer)
+	suite.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.ErrorIs(app.StakingKeeper.TransferValidatorBond(ctx, delAddr, delAddr, valAddr, shares), sdkerrors.ErrInvalidRequest)
+
+	delegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Equal(totalShares, delegation.Shares)
+
+	// a partial transfer creates a validator bond delegation for the recipient
+	suite.Require().NoError(transfer(shares))
+
+	delegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Equal(totalShares.Sub(shares), delegation.Shares)
+	suite.True(delegation.ValidatorBond)
+
+	recipientDelegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, recipient, valAddr)
+	suite.Require().True(found)
+	suite.Equal(shares, recipientDelegation.Shares)
+	suite.True(recipientDelegation.ValidatorBond)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(totalShares, validator.TotalValidatorBondShares)
+
+	// transferring the remaining shares removes the delegation
+	suite.Require().NoError(transfer(totalShares.Sub(shares)))
+
+	_, found = app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+	suite.False(found)
+
+	recipientDelegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, recipient, valAddr)
+	suite.Require().True(found)
+	suite.Equal(totalShares, recipientDelegation.Shares)
+
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(totalShares, validator.TotalValidatorBondShares)
+
+	// a recipient outside the validator bond allowlist is rejected
+	app.StakingKeeper.SetValidatorBondAccount(ctx, valAddr, suite.addrs[3])
+	app.StakingKeeper.EnableValidatorBondAllowlist(ctx, valAddr)
+	delAddr, recipient = recipient, suite.addrs[4]
+	suite.ErrorIs(transfer(shares), types.ErrValidatorBondAccountNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestTransferValidatorBondVesting() {
+	app, ctx := suite.app, suite.ctx
+	valAddr := suite.vals[1].GetOperator()
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	newVestingAccount := func(vestingAmount, balance sdk.Coins) sdk.AccAddress {
+		pubkey := secp256k1.GenPrivKey().PubKey()
+		addr := sdk.AccAddress(pubkey.Address())
+		baseAcc := authtypes.NewBaseAccount(addr, pubkey, app.AccountKeeper.GetNextAccountNumber(ctx), 0)
+		baseVesting := vestingtypes.NewBaseVestingAccount(baseAcc, vestingAmount, ctx.BlockTime().Unix()+86400*365)
+		app.AccountKeeper.SetAccount(ctx, vestingtypes.NewDelayedVestingAccountRaw(baseVesting))
+		suite.Require().NoError(banktestutil.FundAccount(app.BankKeeper, ctx, addr, balance))
+		return addr
+	}
+	tokens := func(power int64) sdk.Coin {
+		return sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+	transfer := func(delAddr, recipient sdk.AccAddress, amount sdk.Coin) error {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		suite.Require().True(found)
+		shares, err := validator.SharesFromTokens(amount.Amount)
+		suite.Require().NoError(err)
+		_, err = msgServer.TransferValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgTransferValidatorBond(delAddr, valAddr, recipient, shares))
+		return err
+	}
+	delegatedFree := func(addr sdk.AccAddress) math.Int {
+		return app.AccountKeeper.GetAccount(ctx, addr).(vesting.VestingAccount).GetDelegatedFree().AmountOf(bondDenom)
+	}
+
+	// half of the delegation of the vesting account is free
+	delAddr := newVestingAccount(sdk.NewCoins(tokens(10)), sdk.NewCoins(tokens(20)))
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, tokens(20)))
+	suite.Require().NoError(err)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Equal(tokens(10).Amount, delegatedFree(delAddr))
+
+	// only the free delegation can be transferred
+	recipient := suite.addrs[2]
+	suite.ErrorIs(transfer(delAddr, recipient, tokens(15)), types.ErrExceedingFreeVestingDelegations)
+
+	// the transferred tokens are no longer tracked as a free delegation of the sender
+	suite.Require().NoError(transfer(delAddr, recipient, tokens(6)))
+	suite.Equal(tokens(4).Amount, delegatedFree(delAddr))
+	suite.ErrorIs(transfer(delAddr, recipient, tokens(5)), types.ErrExceedingFreeVestingDelegations)
+
+	// a vesting account cannot receive validator bond
+	vestingRecipient := newVestingAccount(sdk.NewCoins(tokens(1)), sdk.NewCoins(tokens(1)))
+	suite.ErrorIs(transfer(recipient, vestingRecipient, tokens(1)), types.ErrValidatorBondTransferToVestingAccount)
+}
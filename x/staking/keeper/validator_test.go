@@ -1115,6 +1115,66 @@ func TestUpdateValidatorCommission(t *testing.T) {
 	}
 }
 
+func TestUpdateValidatorLiquidCommission(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapValidatorTest(t, 1000, 20)
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: time.Now().UTC()})
+
+	commission1 := types.NewCommissionWithTime(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(1, 1), time.Now().UTC().Add(time.Duration(-1)*time.Hour),
+	)
+	commission2 := types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+
+	val1 := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	val2 := teststaking.NewValidator(t, addrVals[1], PKs[1])
+
+	val1, _ = val1.SetInitialCommission(commission1)
+	val2, _ = val2.SetInitialCommission(commission2)
+
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidator(ctx, val2)
+
+	testCases := []struct {
+		validator     types.Validator
+		newLiquidRate sdk.Dec
+		expectedErr   bool
+	}{
+		{val1, sdk.NewDecWithPrec(2, 1), true},
+		{val2, sdk.NewDecWithPrec(-1, 1), true},
+		{val2, sdk.NewDecWithPrec(4, 1), true},
+		{val2, sdk.NewDecWithPrec(3, 1), true},
+		{val2, sdk.NewDecWithPrec(2, 1), false},
+	}
+
+	for i, tc := range testCases {
+		commission, err := app.StakingKeeper.UpdateValidatorLiquidCommission(ctx, tc.validator, tc.newLiquidRate)
+
+		if tc.expectedErr {
+			require.Error(t, err, "expected error for test case #%d with liquid rate: %s", i, tc.newLiquidRate)
+		} else {
+			require.NoError(t, err,
+				"unexpected error for test case #%d with liquid rate: %s", i, tc.newLiquidRate,
+			)
+			tc.validator.Commission = commission
+			app.StakingKeeper.SetValidator(ctx, tc.validator)
+			val, found := app.StakingKeeper.GetLiquidValidator(ctx, tc.validator.GetOperator())
+
+			require.True(t, found,
+				"expected to find validator for test case #%d with liquid rate: %s", i, tc.newLiquidRate,
+			)
+			require.Equal(t, tc.newLiquidRate, *val.Commission.LiquidCommissionRate,
+				"expected new validator liquid commission rate for test case #%d with liquid rate: %s", i, tc.newLiquidRate,
+			)
+			require.Equal(t, tc.validator.Commission.Rate, val.Commission.Rate,
+				"expected unchanged validator commission rate for test case #%d with liquid rate: %s", i, tc.newLiquidRate,
+			)
+			require.Equal(t, ctx.BlockHeader().Time, val.Commission.UpdateTime,
+				"expected new validator commission update time for test case #%d with liquid rate: %s", i, tc.newLiquidRate,
+			)
+		}
+	}
+}
+
 func applyValidatorSetUpdates(t *testing.T, ctx sdk.Context, k keeper.Keeper, expectedUpdatesLen int) []abci.ValidatorUpdate {
 	updates, err := k.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.NoError(t, err)
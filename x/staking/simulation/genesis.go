@@ -65,7 +65,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, validatorBondFactor)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, validatorBondFactor, types.DefaultMinValidatorBond, types.DefaultMinValidatorBondGracePeriod, types.DefaultValidatorBondSlashMultiplier, types.DefaultTombstoneFallbackValidator, types.DefaultTokenizeShareRecordVotingEnabled)
 
 	// validators & delegations
 	var (
@@ -55,7 +55,6 @@ func (a StakeAuthorization) ValidateBasic() error {
 	if a.AuthorizationType == AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unknown authorization type")
 	}
-
 	return nil
 }
 
@@ -74,6 +73,22 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 	case *MsgBeginRedelegate:
 		validatorAddress = msg.ValidatorDstAddress
 		amount = msg.Amount
+	case *MsgTokenizeShares:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgValidatorBond:
+		validatorAddress = msg.ValidatorAddress
+	case *MsgRedeemTokensforShares:
+		// share tokens are minted one per tokenized token, for the validator
+		// encoded in their denom
+		valAddr, err := ValidatorAddressFromShareDenom(msg.Amount.Denom)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		validatorAddress = valAddr
+		if a.MaxTokens != nil {
+			amount = sdk.NewCoin(a.MaxTokens.Denom, msg.Amount.Amount)
+		}
 	default:
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
@@ -107,6 +122,11 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 		}, nil
 	}
 
+	// validator bonds do not move any tokens
+	if amount.Amount.IsNil() {
+		return authz.AcceptResponse{Accept: true, Delete: false}, nil
+	}
+
 	limitLeft := a.MaxTokens.Sub(amount)
 	if limitLeft.IsZero() {
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
@@ -150,6 +170,12 @@ func normalizeAuthzType(authzType AuthorizationType) (string, error) {
 		return sdk.MsgTypeURL(&MsgUndelegate{}), nil
 	case AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
 		return sdk.MsgTypeURL(&MsgBeginRedelegate{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_TOKENIZE_SHARES:
+		return sdk.MsgTypeURL(&MsgTokenizeShares{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS:
+		return sdk.MsgTypeURL(&MsgRedeemTokensforShares{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND:
+		return sdk.MsgTypeURL(&MsgValidatorBond{}), nil
 	default:
 		return "", sdkerrors.ErrInvalidType.Wrapf("unknown authorization type %T", authzType)
 	}
@@ -37,6 +37,14 @@ const (
 	AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE AuthorizationType = 2
 	// AUTHORIZATION_TYPE_REDELEGATE defines an authorization type for Msg/BeginRedelegate
 	AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE AuthorizationType = 3
+	// AUTHORIZATION_TYPE_TOKENIZE_SHARES defines an authorization type for Msg/TokenizeShares
+	AuthorizationType_AUTHORIZATION_TYPE_TOKENIZE_SHARES AuthorizationType = 4
+	// AUTHORIZATION_TYPE_REDEEM_TOKENS defines an authorization type for Msg/RedeemTokensforShares.
+	// The validator lists apply to the validator encoded in the share token denom and
+	// each redeemed share token is charged as one token against max_tokens.
+	AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS AuthorizationType = 5
+	// AUTHORIZATION_TYPE_VALIDATOR_BOND defines an authorization type for Msg/ValidatorBond
+	AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND AuthorizationType = 6
 )
 
 var AuthorizationType_name = map[int32]string{
@@ -44,13 +52,19 @@ var AuthorizationType_name = map[int32]string{
 	1: "AUTHORIZATION_TYPE_DELEGATE",
 	2: "AUTHORIZATION_TYPE_UNDELEGATE",
 	3: "AUTHORIZATION_TYPE_REDELEGATE",
+	4: "AUTHORIZATION_TYPE_TOKENIZE_SHARES",
+	5: "AUTHORIZATION_TYPE_REDEEM_TOKENS",
+	6: "AUTHORIZATION_TYPE_VALIDATOR_BOND",
 }
 
 var AuthorizationType_value = map[string]int32{
-	"AUTHORIZATION_TYPE_UNSPECIFIED": 0,
-	"AUTHORIZATION_TYPE_DELEGATE":    1,
-	"AUTHORIZATION_TYPE_UNDELEGATE":  2,
-	"AUTHORIZATION_TYPE_REDELEGATE":  3,
+	"AUTHORIZATION_TYPE_UNSPECIFIED":     0,
+	"AUTHORIZATION_TYPE_DELEGATE":        1,
+	"AUTHORIZATION_TYPE_UNDELEGATE":      2,
+	"AUTHORIZATION_TYPE_REDELEGATE":      3,
+	"AUTHORIZATION_TYPE_TOKENIZE_SHARES": 4,
+	"AUTHORIZATION_TYPE_REDEEM_TOKENS":   5,
+	"AUTHORIZATION_TYPE_VALIDATOR_BOND":  6,
 }
 
 func (x AuthorizationType) String() string {
@@ -61,7 +75,8 @@ func (AuthorizationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_dbc817c76ffc2c21, []int{0}
 }
 
-// StakeAuthorization defines authorization for delegate/undelegate/redelegate.
+// StakeAuthorization defines authorization for delegate/undelegate/redelegate and
+// the liquid staking messages.
 type StakeAuthorization struct {
 	// max_tokens specifies the maximum amount of tokens can be delegate to a validator. If it is
 	// empty, there is no spend limit and any amount of coins can be delegated.
@@ -222,39 +237,42 @@ func init() {
 func init() { proto.RegisterFile("staking/v1beta1/authz.proto", fileDescriptor_dbc817c76ffc2c21) }
 
 var fileDescriptor_dbc817c76ffc2c21 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0xed, 0x06, 0x01, 0x39, 0x5c, 0xd4, 0x8c, 0xba, 0x48, 0x53, 0xd5, 0x2d, 0xdd, 0x10,
-	0x81, 0x6c, 0xd3, 0xb0, 0x43, 0x48, 0xe0, 0xb4, 0x86, 0x46, 0xaa, 0xda, 0xca, 0x71, 0x91, 0x5a,
-	0x84, 0xac, 0x49, 0x3c, 0x72, 0x46, 0xb1, 0x67, 0x52, 0xcf, 0xb8, 0x24, 0x7d, 0x0a, 0x9e, 0x80,
-	0x07, 0x60, 0xdd, 0x87, 0x40, 0xac, 0x2a, 0x56, 0xec, 0x40, 0xc9, 0x63, 0xb0, 0x41, 0xbe, 0x24,
-	0xb4, 0xb4, 0xc0, 0xa6, 0xab, 0xf1, 0xf8, 0x7c, 0xfe, 0xfe, 0xe3, 0xb9, 0xc0, 0x92, 0x90, 0xb8,
-	0x4f, 0x59, 0x60, 0x1e, 0xaf, 0x77, 0x88, 0xc4, 0xeb, 0x26, 0x4e, 0x64, 0xef, 0xc4, 0x18, 0xc4,
-	0x5c, 0x72, 0xb4, 0x1c, 0xd2, 0xa3, 0x84, 0xfa, 0x05, 0x62, 0x4c, 0xc7, 0x02, 0xad, 0x2d, 0x04,
-	0x3c, 0xe0, 0x19, 0x69, 0xa6, 0x4f, 0xf9, 0x47, 0xb5, 0xc5, 0x2e, 0x17, 0x11, 0x17, 0x5e, 0x5e,
-	0xc8, 0x27, 0x45, 0x49, 0xcb, 0x67, 0x66, 0x07, 0x0b, 0x32, 0x0b, 0xec, 0x72, 0xca, 0xf2, 0xfa,
-	0xda, 0xcf, 0x12, 0xa0, 0xb6, 0xc4, 0x7d, 0x62, 0x25, 0xb2, 0xc7, 0x63, 0x7a, 0x82, 0x25, 0xe5,
-	0x0c, 0x11, 0x80, 0x08, 0x0f, 0x3d, 0xc9, 0xfb, 0x84, 0x89, 0xaa, 0xba, 0xaa, 0xd6, 0xef, 0x34,
-	0x16, 0x8d, 0xc2, 0x9c, 0xba, 0xa6, 0x1d, 0x19, 0x1b, 0x9c, 0xb2, 0xe6, 0xe3, 0x4f, 0xdf, 0x57,
-	0x1e, 0x06, 0x54, 0xf6, 0x92, 0x8e, 0xd1, 0xe5, 0x51, 0xd1, 0x42, 0x31, 0xe8, 0xc2, 0xef, 0x9b,
-	0x72, 0x34, 0x20, 0x22, 0x83, 0x9d, 0x72, 0x84, 0x87, 0x6e, 0x26, 0x46, 0xef, 0x00, 0x70, 0x18,
-	0xf2, 0xf7, 0x5e, 0x48, 0x85, 0xac, 0xce, 0x65, 0x31, 0xcf, 0x8d, 0x7f, 0x2e, 0x81, 0x71, 0xb9,
-	0x5b, 0xe3, 0x0d, 0x0e, 0xa9, 0x8f, 0x25, 0x8f, 0xc5, 0x96, 0xe2, 0x94, 0x33, 0xe3, 0x36, 0x15,
-	0x12, 0xbd, 0x85, 0xb2, 0x4f, 0xd8, 0x28, 0xb7, 0x97, 0xae, 0xc5, 0x7e, 0x3b, 0x15, 0x66, 0x72,
-	0x0f, 0x10, 0x3e, 0xcf, 0x79, 0xe9, 0x2f, 0x56, 0x6f, 0xac, 0xaa, 0xf5, 0xfb, 0x8d, 0x27, 0xff,
-	0x49, 0xb9, 0x10, 0xe0, 0x8e, 0x06, 0xc4, 0xa9, 0xe0, 0x3f, 0x5f, 0xd5, 0x5e, 0x02, 0xfc, 0x8e,
-	0x46, 0x0d, 0xb8, 0x85, 0x7d, 0x3f, 0x26, 0x22, 0xdd, 0x8e, 0x52, 0xbd, 0xdc, 0xac, 0x7e, 0x3d,
-	0xd5, 0x17, 0x8a, 0x1d, 0xb1, 0xf2, 0x4a, 0x5b, 0xc6, 0x94, 0x05, 0xce, 0x14, 0x7c, 0x56, 0xf9,
-	0x72, 0xaa, 0xdf, 0xbb, 0x90, 0xd5, 0xbc, 0x0b, 0x70, 0x3c, 0x93, 0x3e, 0xfa, 0xa8, 0x42, 0xe5,
-	0x52, 0x2f, 0x68, 0x0d, 0x34, 0x6b, 0xdf, 0xdd, 0xda, 0x75, 0x5a, 0x87, 0x96, 0xdb, 0xda, 0xdd,
-	0xf1, 0xdc, 0x83, 0x3d, 0xdb, 0xdb, 0xdf, 0x69, 0xef, 0xd9, 0x1b, 0xad, 0x57, 0x2d, 0x7b, 0x73,
-	0x5e, 0x41, 0x2b, 0xb0, 0x74, 0x05, 0xb3, 0x69, 0x6f, 0xdb, 0xaf, 0x2d, 0xd7, 0x9e, 0x57, 0xd1,
-	0x03, 0x58, 0xbe, 0x52, 0x32, 0x43, 0xe6, 0xfe, 0x82, 0x38, 0xf6, 0x0c, 0x29, 0x35, 0x0f, 0x3e,
-	0x8f, 0x35, 0xf5, 0x6c, 0xac, 0xa9, 0x3f, 0xc6, 0x9a, 0xfa, 0x61, 0xa2, 0x29, 0x67, 0x13, 0x4d,
-	0xf9, 0x36, 0xd1, 0x94, 0xc3, 0x17, 0xe7, 0x8e, 0x1b, 0x3d, 0x0a, 0x13, 0x41, 0x39, 0xa3, 0xac,
-	0x6b, 0xe6, 0x0b, 0x4f, 0xe5, 0x48, 0x2f, 0x16, 0x5d, 0x8f, 0xb8, 0x9f, 0x84, 0xc4, 0x1c, 0x9a,
-	0xd3, 0x7b, 0x97, 0x9d, 0xc5, 0xce, 0xcd, 0xec, 0x02, 0x3c, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0x4b, 0x1a, 0x66, 0x8f, 0x03, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x5d, 0x6f, 0xd2, 0x50,
+	0x18, 0xc7, 0x29, 0xcc, 0x29, 0xc7, 0x97, 0xc0, 0xc9, 0x2e, 0x18, 0xcb, 0x3a, 0x46, 0x7c, 0x21,
+	0x1a, 0x5a, 0x87, 0x77, 0xc6, 0x44, 0xcb, 0x38, 0x4a, 0x23, 0xc2, 0xd2, 0x76, 0x4b, 0x86, 0x31,
+	0xcd, 0x81, 0x9e, 0xc0, 0x09, 0xa5, 0x87, 0x71, 0x4e, 0x27, 0xec, 0x53, 0x98, 0xf8, 0x2d, 0xbc,
+	0xde, 0x87, 0x30, 0x5e, 0x2d, 0x5e, 0x79, 0xa7, 0x81, 0x8f, 0xe1, 0x8d, 0xa1, 0x2d, 0xb8, 0x39,
+	0xa6, 0x37, 0x5e, 0x9d, 0x9e, 0x3e, 0xbf, 0xfe, 0xfe, 0x4f, 0xfb, 0xf4, 0x80, 0x0d, 0x2e, 0x70,
+	0x8f, 0x7a, 0x1d, 0xf5, 0x78, 0xa7, 0x45, 0x04, 0xde, 0x51, 0xb1, 0x2f, 0xba, 0x27, 0xca, 0x60,
+	0xc8, 0x04, 0x83, 0x9b, 0x2e, 0x3d, 0xf2, 0xa9, 0x13, 0x21, 0xca, 0x7c, 0x8d, 0xd0, 0xec, 0x5a,
+	0x87, 0x75, 0x58, 0x40, 0xaa, 0xb3, 0xab, 0xf0, 0xa1, 0xec, 0x7a, 0x9b, 0xf1, 0x3e, 0xe3, 0x76,
+	0x58, 0x08, 0x37, 0x51, 0x49, 0x0e, 0x77, 0x6a, 0x0b, 0x73, 0xb2, 0x08, 0x6c, 0x33, 0xea, 0x85,
+	0xf5, 0xfc, 0xcf, 0x04, 0x80, 0xa6, 0xc0, 0x3d, 0xa2, 0xf9, 0xa2, 0xcb, 0x86, 0xf4, 0x04, 0x0b,
+	0xca, 0x3c, 0x48, 0x00, 0xe8, 0xe3, 0x91, 0x2d, 0x58, 0x8f, 0x78, 0x3c, 0x23, 0xe5, 0xa4, 0xc2,
+	0xcd, 0xd2, 0xba, 0x12, 0x99, 0x67, 0xae, 0x79, 0x47, 0xca, 0x2e, 0xa3, 0x5e, 0xf9, 0xd1, 0xa7,
+	0xef, 0x5b, 0x0f, 0x3a, 0x54, 0x74, 0xfd, 0x96, 0xd2, 0x66, 0xfd, 0xa8, 0x85, 0x68, 0x29, 0x72,
+	0xa7, 0xa7, 0x8a, 0xf1, 0x80, 0xf0, 0x00, 0x36, 0x92, 0x7d, 0x3c, 0xb2, 0x02, 0x31, 0x7c, 0x07,
+	0x00, 0x76, 0x5d, 0xf6, 0xde, 0x76, 0x29, 0x17, 0x99, 0x78, 0x10, 0xf3, 0x4c, 0xf9, 0xeb, 0x27,
+	0x50, 0x2e, 0x77, 0xab, 0x1c, 0x60, 0x97, 0x3a, 0x58, 0xb0, 0x21, 0xaf, 0xc6, 0x8c, 0x64, 0x60,
+	0xac, 0x51, 0x2e, 0xe0, 0x5b, 0x90, 0x74, 0x88, 0x37, 0x0e, 0xed, 0x89, 0xff, 0x62, 0xbf, 0x31,
+	0x13, 0x06, 0x72, 0x1b, 0x40, 0x7c, 0x9e, 0xb3, 0x67, 0xaf, 0x98, 0x59, 0xc9, 0x49, 0x85, 0x3b,
+	0xa5, 0xc7, 0xff, 0x48, 0xb9, 0x10, 0x60, 0x8d, 0x07, 0xc4, 0x48, 0xe3, 0x3f, 0x6f, 0x65, 0x5f,
+	0x00, 0xf0, 0x3b, 0x1a, 0x96, 0xc0, 0x75, 0xec, 0x38, 0x43, 0xc2, 0x67, 0xe3, 0x48, 0x14, 0x92,
+	0xe5, 0xcc, 0xd7, 0xd3, 0xe2, 0x5a, 0x34, 0x11, 0x2d, 0xac, 0x98, 0x62, 0x48, 0xbd, 0x8e, 0x31,
+	0x07, 0x9f, 0xa6, 0xbf, 0x9c, 0x16, 0x6f, 0x5f, 0xc8, 0x2a, 0xdf, 0x02, 0xe0, 0x78, 0x21, 0x7d,
+	0xf8, 0x31, 0x0e, 0xd2, 0x97, 0x7a, 0x81, 0x79, 0x20, 0x6b, 0xfb, 0x56, 0xb5, 0x61, 0xe8, 0x4d,
+	0xcd, 0xd2, 0x1b, 0x75, 0xdb, 0x3a, 0xdc, 0x43, 0xf6, 0x7e, 0xdd, 0xdc, 0x43, 0xbb, 0xfa, 0x4b,
+	0x1d, 0x55, 0x52, 0x31, 0xb8, 0x05, 0x36, 0x96, 0x30, 0x15, 0x54, 0x43, 0xaf, 0x34, 0x0b, 0xa5,
+	0x24, 0xb8, 0x0d, 0x36, 0x97, 0x4a, 0x16, 0x48, 0xfc, 0x0a, 0xc4, 0x40, 0x0b, 0x24, 0x01, 0xef,
+	0x83, 0xfc, 0x12, 0xc4, 0x6a, 0xbc, 0x46, 0x75, 0xbd, 0x89, 0x6c, 0xb3, 0xaa, 0x19, 0xc8, 0x4c,
+	0xad, 0xc0, 0xbb, 0x20, 0x77, 0x85, 0x0a, 0xbd, 0x09, 0x71, 0x33, 0x75, 0x0d, 0xde, 0x03, 0xdb,
+	0x4b, 0xa8, 0x03, 0xad, 0xa6, 0x57, 0x34, 0xab, 0x61, 0xd8, 0xe5, 0x46, 0xbd, 0x92, 0x5a, 0x2d,
+	0x1f, 0x7e, 0x9e, 0xc8, 0xd2, 0xd9, 0x44, 0x96, 0x7e, 0x4c, 0x64, 0xe9, 0xc3, 0x54, 0x8e, 0x9d,
+	0x4d, 0xe5, 0xd8, 0xb7, 0xa9, 0x1c, 0x6b, 0x3e, 0x3f, 0xf7, 0x8f, 0xd3, 0x23, 0xd7, 0xe7, 0x94,
+	0x79, 0xd4, 0x6b, 0xab, 0xe1, 0xb4, 0xa9, 0x18, 0x17, 0xa3, 0x49, 0x17, 0xfb, 0xcc, 0xf1, 0x5d,
+	0xa2, 0x8e, 0xd4, 0xf9, 0x61, 0x0f, 0x0e, 0x40, 0x6b, 0x35, 0x38, 0x75, 0x4f, 0x7e, 0x0d, 0x00,
+	0xcb, 0xb7, 0x0d, 0xf1, 0x04, 0x04, 0x00, 0x00,
 }
 
 func (m *StakeAuthorization) Marshal() (dAtA []byte, err error) {
@@ -29,6 +29,12 @@ func TestAuthzAuthorizations(t *testing.T) {
 	require.NoError(t, err)
 	require.Error(t, delAuth.ValidateBasic())
 
+	// verify MethodName
+	redeemAuth, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS, &coin100)
+	require.NoError(t, err)
+	require.NoError(t, redeemAuth.ValidateBasic())
+	require.Equal(t, redeemAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgRedeemTokensforShares{}))
+
 	// verify MethodName
 	delAuth, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
 	require.NoError(t, err)
@@ -42,6 +48,10 @@ func TestAuthzAuthorizations(t *testing.T) {
 	undelAuth, _ := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, &coin100)
 	require.Equal(t, undelAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}))
 
+	// verify MethodName
+	tokenizeAuth, _ := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TOKENIZE_SHARES, &coin100)
+	require.Equal(t, tokenizeAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgTokenizeShares{}))
+
 	// verify MethodName
 	beginRedelAuth, _ := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, &coin100)
 	require.Equal(t, beginRedelAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}))
@@ -250,6 +260,128 @@ func TestAuthzAuthorizations(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"tokenize shares: expect 50 remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TOKENIZE_SHARES,
+			&coin100,
+			&stakingtypes.MsgTokenizeShares{DelegatorAddress: delAddr.String(), ValidatorAddress: val1.String(), Amount: coin50, TokenizedShareOwner: delAddr.String()},
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TOKENIZE_SHARES,
+			},
+		},
+		{
+			"tokenize shares: fail validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TOKENIZE_SHARES,
+			nil,
+			&stakingtypes.MsgTokenizeShares{DelegatorAddress: delAddr.String(), ValidatorAddress: val1.String(), Amount: coin50, TokenizedShareOwner: delAddr.String()},
+			true,
+			false,
+			nil,
+		},
+		{
+			"redeem tokens: limit is charged for the share tokens",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin100,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(val1.String()+"/1", 50)},
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: []string{val1.String(), val2.String()}},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			},
+		},
+		{
+			"redeem tokens: delete when the limit is used up",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin100,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(val2.String()+"/2", 100)},
+			false,
+			true,
+			nil,
+		},
+		{
+			"redeem tokens: without limit",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			nil,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(val1.String()+"/1", 150)},
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: []string{val1.String()}},
+				}, MaxTokens: nil, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			},
+		},
+		{
+			"redeem tokens: fail validator not allowed",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin100,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(val3.String()+"/3", 50)},
+			true,
+			false,
+			nil,
+		},
+		{
+			"redeem tokens: fail validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin100,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(val1.String()+"/1", 50)},
+			true,
+			false,
+			nil,
+		},
+		{
+			"redeem tokens: fail not a share token",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin100,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: coin50},
+			true,
+			false,
+			nil,
+		},
+		{
+			"validator bond: limit is not consumed",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND,
+			&coin100,
+			stakingtypes.NewMsgValidatorBond(delAddr, val1),
+			false,
+			false,
+			nil,
+		},
+		{
+			"validator bond: fail validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND,
+			&coin100,
+			stakingtypes.NewMsgValidatorBond(delAddr, val1),
+			true,
+			false,
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
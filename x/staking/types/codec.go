@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/staking interfaces and concrete types
@@ -20,6 +21,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares", nil)
 	cdc.RegisterConcrete(&MsgRedeemTokensforShares{}, "cosmos-sdk/MsgRedeemTokensforShares", nil)
 	cdc.RegisterConcrete(&MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgAddValidatorBondAccount{}, "cosmos-sdk/MsgAddValidatorBondAccount", nil)
+	cdc.RegisterConcrete(&MsgRemoveValidatorBondAccount{}, "cosmos-sdk/MsgRemoveValidatorBondAccount", nil)
+	cdc.RegisterConcrete(&MsgSetValidatorBondAllowlist{}, "cosmos-sdk/MsgSetValidatorBondAllowlist", nil)
+	cdc.RegisterConcrete(&MsgTransferValidatorBond{}, "cosmos-sdk/MsgTransferValidatorBond", nil)
+	cdc.RegisterConcrete(&MsgCreateShareOffer{}, "cosmos-sdk/MsgCreateShareOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptShareOffer{}, "cosmos-sdk/MsgAcceptShareOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelShareOffer{}, "cosmos-sdk/MsgCancelShareOffer", nil)
+	cdc.RegisterConcrete(&MsgVoteWithTokenizeShareRecord{}, "cosmos-sdk/MsgVoteWithTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&ValidatorLiquidStakingPauseProposal{}, "cosmos-sdk/ValidatorLiquidStakingPauseProposal", nil)
 
 	// cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	// cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -39,11 +50,24 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgTokenizeShares{},
 		&MsgRedeemTokensforShares{},
 		&MsgTransferTokenizeShareRecord{},
+		&MsgAddValidatorBondAccount{},
+		&MsgRemoveValidatorBondAccount{},
+		&MsgSetValidatorBondAllowlist{},
+		&MsgTransferValidatorBond{},
+		&MsgCreateShareOffer{},
+		&MsgAcceptShareOffer{},
+		&MsgCancelShareOffer{},
+		&MsgVoteWithTokenizeShareRecord{},
+		&MsgUpdateParams{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&StakeAuthorization{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ValidatorLiquidStakingPauseProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
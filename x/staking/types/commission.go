@@ -74,11 +74,29 @@ func (cr CommissionRates) Validate() error {
 	case cr.MaxChangeRate.GT(cr.MaxRate):
 		// change rate cannot be greater than the max rate
 		return sdkstaking.ErrCommissionChangeRateGTMaxRate
+
+	case cr.LiquidCommissionRate != nil && cr.LiquidCommissionRate.IsNegative():
+		// liquid rate cannot be negative
+		return sdkstaking.ErrCommissionNegative
+
+	case cr.LiquidCommissionRate != nil && cr.LiquidCommissionRate.GT(cr.MaxRate):
+		// liquid rate cannot be greater than the max rate
+		return sdkstaking.ErrCommissionGTMaxRate
 	}
 
 	return nil
 }
 
+// GetLiquidRate returns the commission rate charged to delegations held by
+// tokenize share record module accounts. The liquid commission rate applies
+// whenever it is set, whether it is lower or greater than the regular rate.
+func (cr CommissionRates) GetLiquidRate() sdk.Dec {
+	if cr.LiquidCommissionRate == nil {
+		return cr.Rate
+	}
+	return *cr.LiquidCommissionRate
+}
+
 // ValidateNewRate performs basic sanity validation checks of a new commission
 // rate. If validation fails, an SDK error is returned.
 func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error {
@@ -102,3 +120,27 @@ func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error
 
 	return nil
 }
+
+// ValidateNewLiquidRate performs basic sanity validation checks of a new
+// liquid commission rate. If validation fails, an SDK error is returned.
+func (c Commission) ValidateNewLiquidRate(newLiquidRate sdk.Dec, blockTime time.Time) error {
+	switch {
+	case blockTime.Sub(c.UpdateTime).Hours() < 24:
+		// new liquid rate cannot be changed more than once within 24 hours
+		return sdkstaking.ErrCommissionUpdateTime
+
+	case newLiquidRate.IsNegative():
+		// new liquid rate cannot be negative
+		return sdkstaking.ErrCommissionNegative
+
+	case newLiquidRate.GT(c.MaxRate):
+		// new liquid rate cannot be greater than the max rate
+		return sdkstaking.ErrCommissionGTMaxRate
+
+	case newLiquidRate.Sub(c.GetLiquidRate()).GT(c.MaxChangeRate):
+		// new liquid rate % points change cannot be greater than the max change rate
+		return sdkstaking.ErrCommissionGTMaxChangeRate
+	}
+
+	return nil
+}
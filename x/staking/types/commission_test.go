@@ -29,6 +29,14 @@ func TestCommissionValidate(t *testing.T) {
 		{types.NewCommission(sdk.OneDec(), sdk.MustNewDecFromStr("0.75"), sdk.MustNewDecFromStr("0.90")), true},
 		// valid commission
 		{types.NewCommission(sdk.MustNewDecFromStr("0.20"), sdk.OneDec(), sdk.MustNewDecFromStr("0.10")), false},
+		// invalid commission; liquid rate < 0%
+		{withLiquidRate(types.NewCommission(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()), sdk.MustNewDecFromStr("-0.10")), true},
+		// invalid commission; liquid rate > max rate
+		{withLiquidRate(types.NewCommission(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.50"), sdk.ZeroDec()), sdk.MustNewDecFromStr("0.75")), true},
+		// valid commission; liquid rate below rate
+		{withLiquidRate(types.NewCommission(sdk.MustNewDecFromStr("0.20"), sdk.OneDec(), sdk.ZeroDec()), sdk.MustNewDecFromStr("0.10")), false},
+		// valid commission
+		{withLiquidRate(types.NewCommission(sdk.MustNewDecFromStr("0.20"), sdk.OneDec(), sdk.ZeroDec()), sdk.MustNewDecFromStr("0.30")), false},
 	}
 
 	for i, tc := range testCases {
@@ -71,3 +79,57 @@ func TestCommissionValidateNewRate(t *testing.T) {
 		)
 	}
 }
+
+func withLiquidRate(c types.Commission, liquidRate sdk.Dec) types.Commission {
+	c.LiquidCommissionRate = &liquidRate
+	return c
+}
+
+func TestCommissionGetLiquidRate(t *testing.T) {
+	c := types.NewCommission(sdk.MustNewDecFromStr("0.20"), sdk.OneDec(), sdk.MustNewDecFromStr("0.10"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.20"), c.GetLiquidRate())
+
+	c = withLiquidRate(c, sdk.MustNewDecFromStr("0.10"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.10"), c.GetLiquidRate())
+
+	c = withLiquidRate(c, sdk.MustNewDecFromStr("0.30"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.30"), c.GetLiquidRate())
+}
+
+func TestCommissionValidateNewLiquidRate(t *testing.T) {
+	now := time.Now().UTC()
+	c1 := types.NewCommission(sdk.MustNewDecFromStr("0.40"), sdk.MustNewDecFromStr("0.80"), sdk.MustNewDecFromStr("0.10"))
+	c1.UpdateTime = now
+	c2 := withLiquidRate(c1, sdk.MustNewDecFromStr("0.50"))
+
+	testCases := []struct {
+		input         types.Commission
+		newLiquidRate sdk.Dec
+		blockTime     time.Time
+		expectErr     bool
+	}{
+		// invalid new liquid commission rate; last update < 24h ago
+		{c1, sdk.MustNewDecFromStr("0.50"), now, true},
+		// invalid new liquid commission rate; new rate < 0%
+		{c1, sdk.MustNewDecFromStr("-1.00"), now.Add(48 * time.Hour), true},
+		// invalid new liquid commission rate; new rate > max rate
+		{c2, sdk.MustNewDecFromStr("0.90"), now.Add(48 * time.Hour), true},
+		// invalid new liquid commission rate; new rate > max change rate from the rate
+		{c1, sdk.MustNewDecFromStr("0.60"), now.Add(48 * time.Hour), true},
+		// valid liquid commission
+		{c1, sdk.MustNewDecFromStr("0.50"), now.Add(48 * time.Hour), false},
+		// valid liquid commission; change is relative to the current liquid rate
+		{c2, sdk.MustNewDecFromStr("0.60"), now.Add(48 * time.Hour), false},
+		// valid liquid commission
+		{c2, sdk.MustNewDecFromStr("0.10"), now.Add(48 * time.Hour), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.input.ValidateNewLiquidRate(tc.newLiquidRate, tc.blockTime)
+		require.Equal(
+			t, tc.expectErr, err != nil,
+			"unexpected result; tc #%d, input: %v, newLiquidRate: %s, blockTime: %s",
+			i, tc.input, tc.newLiquidRate, tc.blockTime,
+		)
+	}
+}
@@ -59,4 +59,18 @@ var (
 	ErrInsufficientValidatorBondShares         = sdkerrors.Register(ModuleName, 47, "insufficient validator bond shares")
 	ErrRedelegationNotAllowedForValidatorBond  = sdkerrors.Register(ModuleName, 48, "redelegation is not allowed for validator bond delegation")
 	ErrValidatorBondNotAllowedForTokenizeShare = sdkerrors.Register(ModuleName, 49, "validator bond delegation is not allowed to tokenize share")
+	ErrValidatorLiquidStakingPaused            = sdkerrors.Register(ModuleName, 50, "liquid staking is paused for the validator")
+	ErrValidatorLiquidShareRatioExceeded       = sdkerrors.Register(ModuleName, 51, "validator max liquid share ratio exceeded")
+	ErrValidatorBondAccountNotAllowed          = sdkerrors.Register(ModuleName, 52, "account is not allowed to validator bond to the validator")
+	ErrValidatorBondAccountAlreadyExists       = sdkerrors.Register(ModuleName, 53, "account already exists in the validator bond allowlist")
+	ErrValidatorBondAccountNotFound            = sdkerrors.Register(ModuleName, 54, "account not found in the validator bond allowlist")
+	ErrNotValidatorBondDelegation              = sdkerrors.Register(ModuleName, 55, "delegation is not a validator bond delegation")
+	ErrValidatorBondTransferToVestingAccount   = sdkerrors.Register(ModuleName, 56, "validator bond cannot be transferred to a vesting account")
+	ErrValidatorBondNotAllowedFromLiquidStaker = sdkerrors.Register(ModuleName, 57, "validator bond is not allowed from a liquid staking provider")
+	ErrValidatorLiquidSharesUnderflow          = sdkerrors.Register(ModuleName, 58, "liquid shares removed exceed the validator's total liquid shares")
+	ErrShareOfferNotFound                      = sdkerrors.Register(ModuleName, 59, "share offer not found")
+	ErrNotShareOfferSeller                     = sdkerrors.Register(ModuleName, 60, "not the seller of the share offer")
+	ErrShareOfferExpired                       = sdkerrors.Register(ModuleName, 61, "share offer expired")
+	ErrInvalidShareOfferAsset                  = sdkerrors.Register(ModuleName, 62, "invalid share offer asset")
+	ErrTokenizeShareRecordVotingDisabled       = sdkerrors.Register(ModuleName, 63, "voting with tokenize share records is disabled")
 )
@@ -13,6 +13,30 @@ const (
 	EventTypeRedeemShares                = "redeem_shares"
 	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
 	EventTypeValidatorBondDelegation     = "validator_bond_delegation"
+	EventTypePruneTokenizeShareRecord    = "prune_tokenize_share_record"
+	EventTypeValidatorBondShortfall      = "validator_bond_shortfall"
+	EventTypeValidatorBondRestored       = "validator_bond_restored"
+	EventTypeValidatorBondJail           = "validator_bond_jail"
+	EventTypeValidatorBondSlash          = "validator_bond_slash"
+
+	EventTypeTokenizeShareRecordsSlashed    = "tokenize_share_records_slashed"
+	EventTypeTokenizeShareRecordsTombstoned = "tokenize_share_records_tombstoned"
+	EventTypeRedelegateTokenizeShareRecord  = "redelegate_tokenize_share_record"
+
+	EventTypeValidatorLiquidStakingPaused   = "validator_liquid_staking_paused"
+	EventTypeValidatorLiquidStakingUnpaused = "validator_liquid_staking_unpaused"
+
+	EventTypeAddValidatorBondAccount    = "add_validator_bond_account"
+	EventTypeRemoveValidatorBondAccount = "remove_validator_bond_account"
+	EventTypeSetValidatorBondAllowlist  = "set_validator_bond_allowlist"
+	EventTypeTransferValidatorBond      = "transfer_validator_bond"
+
+	EventTypeCreateShareOffer = "create_share_offer"
+	EventTypeAcceptShareOffer = "accept_share_offer"
+	EventTypeCancelShareOffer = "cancel_share_offer"
+	EventTypeExpireShareOffer = "expire_share_offer"
+
+	EventTypeVoteWithTokenizeShareRecord = "vote_with_tokenize_share_record"
 
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
@@ -23,6 +47,22 @@ const (
 	AttributeKeyNewShares      = "new_shares"
 	AttributeKeyShareOwner     = "share_owner"
 	AttributeKeyShareRecordId  = "share_record_id"
+	AttributeKeyShareRecordIds = "share_record_ids"
+	AttributeKeySlashFraction  = "slash_fraction"
 	AttributeKeyAmount         = "amount"
+	AttributeKeyValidatorBond  = "validator_bond"
+	AttributeKeyMinBond        = "min_validator_bond"
+	AttributeKeyJailHeight     = "jail_height"
+	AttributeKeyBurnedShares   = "burned_shares"
+	AttributeKeyAccount        = "account"
+	AttributeKeyEnabled        = "enabled"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyShares         = "shares"
+	AttributeKeyShareOfferId   = "share_offer_id"
+	AttributeKeySeller         = "seller"
+	AttributeKeyBuyer          = "buyer"
+	AttributeKeyPrice          = "price"
+	AttributeKeyProposalId     = "proposal_id"
+	AttributeKeyVoteOptions    = "options"
 	AttributeValueCategory     = ModuleName
 )
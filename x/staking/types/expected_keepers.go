@@ -4,6 +4,8 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -17,6 +19,7 @@ type DistributionKeeper interface {
 type AccountKeeper interface {
 	IterateAccounts(ctx sdk.Context, process func(authtypes.AccountI) (stop bool))
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI // only used for simulation
+	SetAccount(ctx sdk.Context, acc authtypes.AccountI)
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
@@ -45,6 +48,26 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// NFTKeeper defines the expected interface needed to represent tokenize share
+// records as NFTs
+type NFTKeeper interface {
+	SaveClass(ctx sdk.Context, class nft.Class) error
+	HasClass(ctx sdk.Context, classID string) bool
+
+	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID string, nftID string) error
+	Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error
+
+	HasNFT(ctx sdk.Context, classID, id string) bool
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+}
+
+// GovKeeper defines the expected interface needed to cast governance votes on
+// behalf of tokenize share record module accounts
+type GovKeeper interface {
+	AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options govv1.WeightedVoteOptions, metadata string) error
+}
+
 // ValidatorSet expected properties for the set of all validators (noalias)
 type ValidatorSet interface {
 	// iterate through validators by operator address, execute func for each validator
@@ -108,4 +131,12 @@ type StakingHooks interface {
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error
+
+	AfterTokenizeShareRecordsSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec, recordIds []uint64) error // Must be called when a validator with tokenize share records is slashed
+	AfterTokenizeShareRecordsTombstoned(ctx sdk.Context, valAddr sdk.ValAddress, recordIds []uint64) error                // Must be called when a validator with tokenize share records is tombstoned
+
+	AfterTokenizeShareRecordCreated(ctx sdk.Context, recordId uint64) error                                     // Must be called when a tokenize share record is created
+	AfterTokenizeSharesRedeemed(ctx sdk.Context, recordId uint64, delAddr sdk.AccAddress, shares sdk.Dec) error // Must be called when share tokens are redeemed for a delegation
+	AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordId uint64, from, to sdk.AccAddress) error        // Must be called when the owner of a tokenize share record changes
+	AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error            // Must be called when the validator bond shares of a delegation change
 }
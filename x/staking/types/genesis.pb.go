@@ -48,6 +48,16 @@ type GenesisState struct {
 	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
 	// last tokenize share record id, used for next share record id calculation
 	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// validators for which liquid staking is paused by governance
+	LiquidStakingPausedValidators []string `protobuf:"bytes,11,rep,name=liquid_staking_paused_validators,json=liquidStakingPausedValidators,proto3" json:"liquid_staking_paused_validators,omitempty"`
+	// accounts allowed by validator operators to mark delegations as validator bond
+	ValidatorBondAccounts []ValidatorBondAccount `protobuf:"bytes,12,rep,name=validator_bond_accounts,json=validatorBondAccounts,proto3" json:"validator_bond_accounts"`
+	// validators whose operators restricted validator bonds to their validator bond allowlist
+	ValidatorBondAllowlistValidators []string `protobuf:"bytes,13,rep,name=validator_bond_allowlist_validators,json=validatorBondAllowlistValidators,proto3" json:"validator_bond_allowlist_validators,omitempty"`
+	// open share offers
+	ShareOffers []ShareOffer `protobuf:"bytes,14,rep,name=share_offers,json=shareOffers,proto3" json:"share_offers"`
+	// last_share_offer_id is the id of the last created share offer
+	LastShareOfferId uint64 `protobuf:"varint,15,opt,name=last_share_offer_id,json=lastShareOfferId,proto3" json:"last_share_offer_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -146,6 +156,41 @@ func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLiquidStakingPausedValidators() []string {
+	if m != nil {
+		return m.LiquidStakingPausedValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBondAccounts() []ValidatorBondAccount {
+	if m != nil {
+		return m.ValidatorBondAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBondAllowlistValidators() []string {
+	if m != nil {
+		return m.ValidatorBondAllowlistValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetShareOffers() []ShareOffer {
+	if m != nil {
+		return m.ShareOffers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastShareOfferId() uint64 {
+	if m != nil {
+		return m.LastShareOfferId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -195,43 +240,52 @@ func init() {
 func init() { proto.RegisterFile("staking/v1beta1/genesis.proto", fileDescriptor_30376b0921a07e54) }
 
 var fileDescriptor_30376b0921a07e54 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x13, 0xb6, 0x75, 0x9d, 0x3b, 0x10, 0x32, 0x1d, 0xca, 0x2a, 0x35, 0xad, 0x26, 0x81,
-	0x82, 0x50, 0x13, 0xb5, 0xdc, 0xb8, 0x00, 0x05, 0x09, 0x4d, 0x42, 0x68, 0xa4, 0xe3, 0xef, 0x25,
-	0x72, 0x6b, 0x2b, 0xb5, 0x9a, 0xda, 0x5d, 0xec, 0x8c, 0x8d, 0x4f, 0xc0, 0x91, 0x8f, 0xb0, 0x0f,
-	0xc1, 0x87, 0xd8, 0x71, 0x82, 0x0b, 0xe2, 0x30, 0xa1, 0xf6, 0xc2, 0xc7, 0x40, 0x89, 0x9d, 0x12,
-	0x14, 0x44, 0x39, 0x25, 0xd6, 0xf3, 0x3e, 0xbf, 0xe7, 0xb5, 0xe5, 0xd7, 0xa0, 0x29, 0x24, 0x9a,
-	0x50, 0x16, 0x7a, 0xc7, 0xdd, 0x21, 0x91, 0xa8, 0xeb, 0x85, 0x84, 0x11, 0x41, 0x85, 0x3b, 0x8b,
-	0xb9, 0xe4, 0xb0, 0x19, 0xd1, 0xa3, 0x84, 0x62, 0x5d, 0xe4, 0xe6, 0x5f, 0x5d, 0xdc, 0xa8, 0x87,
-	0x3c, 0xe4, 0x59, 0xa5, 0x97, 0xfe, 0x29, 0x53, 0x63, 0x77, 0xc4, 0xc5, 0x94, 0x8b, 0x40, 0x09,
-	0x6a, 0xa1, 0xa5, 0x52, 0x5c, 0x4e, 0xcc, 0xe4, 0xbd, 0xaf, 0x15, 0xb0, 0xfd, 0x54, 0x35, 0x30,
-	0x90, 0x48, 0x12, 0xf8, 0x18, 0x54, 0x66, 0x28, 0x46, 0x53, 0x61, 0x99, 0x6d, 0xd3, 0xa9, 0xf5,
-	0x6e, 0xb9, 0xff, 0x6c, 0xc8, 0x3d, 0xc8, 0x8a, 0xfb, 0xeb, 0xe7, 0x97, 0x2d, 0xc3, 0xd7, 0x56,
-	0xf8, 0x06, 0x5c, 0x8f, 0x90, 0x90, 0x81, 0xe4, 0x12, 0x45, 0xc1, 0x8c, 0xbf, 0x27, 0xb1, 0x75,
-	0xa5, 0x6d, 0x3a, 0xdb, 0x7d, 0x37, 0xad, 0xfb, 0x7e, 0xd9, 0xba, 0x1d, 0x52, 0x39, 0x4e, 0x86,
-	0xee, 0x88, 0x4f, 0x75, 0xbf, 0xfa, 0xd3, 0x11, 0x78, 0xe2, 0xc9, 0xd3, 0x19, 0x11, 0xee, 0x3e,
-	0x93, 0xfe, 0xb5, 0x94, 0x73, 0x98, 0x62, 0x0e, 0x52, 0x0a, 0x9c, 0x80, 0x9d, 0x8c, 0x7c, 0x8c,
-	0x22, 0x8a, 0x91, 0xe4, 0xb1, 0xa2, 0x0b, 0x6b, 0xad, 0xbd, 0xe6, 0xd4, 0x7a, 0xdd, 0x15, 0xdd,
-	0x3e, 0x43, 0x42, 0xbe, 0xca, 0xad, 0x19, 0x51, 0x77, 0x7e, 0x23, 0x2a, 0x29, 0x02, 0x3e, 0x07,
-	0x60, 0x99, 0x23, 0xac, 0xf5, 0x2c, 0xc1, 0x59, 0x91, 0xb0, 0x64, 0x68, 0x70, 0x81, 0x00, 0x5f,
-	0x80, 0x1a, 0x26, 0x11, 0x09, 0x91, 0xa4, 0x9c, 0x09, 0x6b, 0x23, 0x03, 0xde, 0x59, 0x01, 0x7c,
-	0xb2, 0x74, 0x68, 0x62, 0x91, 0x01, 0xa7, 0x60, 0x27, 0x61, 0x43, 0xce, 0x30, 0x65, 0x61, 0x50,
-	0x84, 0x57, 0x32, 0x78, 0x6f, 0x05, 0xfc, 0x65, 0xee, 0x2d, 0xa5, 0xd4, 0x93, 0xb2, 0x24, 0xe0,
-	0x6b, 0x70, 0x35, 0x26, 0xc5, 0x98, 0xcd, 0x2c, 0xe6, 0xee, 0x8a, 0x18, 0xbf, 0xe0, 0xd1, 0xfc,
-	0x3f, 0x39, 0xb0, 0x01, 0xaa, 0xe4, 0x64, 0xc6, 0x63, 0x49, 0xb0, 0x55, 0x6d, 0x9b, 0x4e, 0xd5,
-	0x5f, 0xae, 0x21, 0x03, 0x37, 0x25, 0x9f, 0x10, 0x46, 0x3f, 0x90, 0x40, 0x8c, 0x51, 0x4c, 0x82,
-	0x98, 0x8c, 0x78, 0x8c, 0x85, 0xb5, 0xf5, 0x5f, 0x9b, 0x3c, 0xd4, 0xe6, 0x41, 0xea, 0xf5, 0x33,
-	0x6b, 0xbe, 0x49, 0x59, 0x96, 0x04, 0x7c, 0x08, 0x9a, 0xfa, 0xf6, 0xfe, 0x25, 0x34, 0xa0, 0xd8,
-	0x02, 0x6d, 0xd3, 0x59, 0xf7, 0x77, 0xd5, 0xd5, 0x2c, 0x01, 0xf6, 0xf1, 0xde, 0x18, 0xc0, 0xf2,
-	0x4d, 0x83, 0x3d, 0xb0, 0x89, 0x30, 0x8e, 0x89, 0x50, 0xb3, 0xb5, 0xd5, 0xb7, 0xbe, 0x7c, 0xee,
-	0xd4, 0xf5, 0xb4, 0x3e, 0x52, 0xca, 0x40, 0xc6, 0x94, 0x85, 0x7e, 0x5e, 0x08, 0xeb, 0x60, 0xe3,
-	0xf7, 0xf8, 0xac, 0xf9, 0x6a, 0x71, 0xbf, 0xfa, 0xf1, 0xac, 0x65, 0xfc, 0x3c, 0x6b, 0x19, 0xfd,
-	0xb7, 0xe7, 0x73, 0xdb, 0xbc, 0x98, 0xdb, 0xe6, 0x8f, 0xb9, 0x6d, 0x7e, 0x5a, 0xd8, 0xc6, 0xc5,
-	0xc2, 0x36, 0xbe, 0x2d, 0x6c, 0xe3, 0xdd, 0x83, 0xc2, 0x84, 0xd1, 0xa3, 0x28, 0x11, 0x94, 0x33,
-	0xca, 0x46, 0x9e, 0x3a, 0x2b, 0x2a, 0x4f, 0x3b, 0xfa, 0x9c, 0x3a, 0x53, 0x8e, 0x93, 0x88, 0x78,
-	0x27, 0xf9, 0xd3, 0xa0, 0xc6, 0x6f, 0x58, 0xc9, 0x5e, 0x88, 0x7b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x5e, 0x1f, 0x8a, 0x11, 0xb1, 0x04, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x4e, 0x1b, 0x3b,
+	0x14, 0xc7, 0x33, 0x37, 0x7c, 0x04, 0x27, 0x70, 0xaf, 0x7c, 0xc3, 0xbd, 0x03, 0x12, 0xc9, 0x88,
+	0xaa, 0x55, 0xaa, 0x2a, 0x89, 0x08, 0xbb, 0x6e, 0x5a, 0xd2, 0x4a, 0x15, 0x52, 0xd5, 0xd2, 0x09,
+	0xa5, 0x1f, 0x9b, 0x91, 0x13, 0x9b, 0xc1, 0xca, 0xc4, 0x0e, 0x63, 0x0f, 0x1f, 0x7d, 0x82, 0x2e,
+	0xbb, 0xe9, 0x9e, 0x87, 0xe8, 0x43, 0xb0, 0x44, 0x5d, 0x55, 0x5d, 0xa0, 0x0a, 0x36, 0x7d, 0x8c,
+	0x6a, 0x6c, 0x4f, 0x32, 0x30, 0x88, 0xb0, 0x9a, 0x38, 0xe7, 0xfc, 0x7f, 0xe7, 0x1c, 0xfb, 0x9c,
+	0x03, 0x56, 0x84, 0x44, 0x7d, 0xca, 0xfc, 0xe6, 0xc1, 0x5a, 0x97, 0x48, 0xb4, 0xd6, 0xf4, 0x09,
+	0x23, 0x82, 0x8a, 0xc6, 0x30, 0xe4, 0x92, 0xc3, 0x95, 0x80, 0xee, 0x47, 0x14, 0x1b, 0xa7, 0x46,
+	0xf2, 0x35, 0xce, 0xcb, 0x65, 0x9f, 0xfb, 0x5c, 0x79, 0x36, 0xe3, 0x5f, 0x5a, 0xb4, 0xbc, 0xd4,
+	0xe3, 0x62, 0xc0, 0x85, 0xa7, 0x0d, 0xfa, 0x60, 0x4c, 0x99, 0x70, 0x09, 0x51, 0x99, 0x57, 0xbf,
+	0x02, 0x50, 0x7a, 0xa1, 0x13, 0xe8, 0x48, 0x24, 0x09, 0x7c, 0x06, 0x66, 0x86, 0x28, 0x44, 0x03,
+	0x61, 0x5b, 0x8e, 0x55, 0x2b, 0xb6, 0xee, 0x37, 0x6e, 0x4d, 0xa8, 0xb1, 0xa5, 0x9c, 0xdb, 0x53,
+	0xa7, 0xe7, 0xd5, 0x9c, 0x6b, 0xa4, 0xf0, 0x3d, 0xf8, 0x27, 0x40, 0x42, 0x7a, 0x92, 0x4b, 0x14,
+	0x78, 0x43, 0x7e, 0x48, 0x42, 0xfb, 0x2f, 0xc7, 0xaa, 0x95, 0xda, 0x8d, 0xd8, 0xef, 0xe7, 0x79,
+	0xf5, 0x81, 0x4f, 0xe5, 0x5e, 0xd4, 0x6d, 0xf4, 0xf8, 0xc0, 0xe4, 0x6b, 0x3e, 0x75, 0x81, 0xfb,
+	0x4d, 0x79, 0x3c, 0x24, 0xa2, 0xb1, 0xc9, 0xa4, 0xbb, 0x10, 0x73, 0xb6, 0x63, 0xcc, 0x56, 0x4c,
+	0x81, 0x7d, 0xb0, 0xa8, 0xc8, 0x07, 0x28, 0xa0, 0x18, 0x49, 0x1e, 0x6a, 0xba, 0xb0, 0xf3, 0x4e,
+	0xbe, 0x56, 0x6c, 0xad, 0x4d, 0xc8, 0xf6, 0x25, 0x12, 0x72, 0x27, 0x91, 0x2a, 0xa2, 0xc9, 0xfc,
+	0xdf, 0x20, 0x63, 0x11, 0xf0, 0x15, 0x00, 0xa3, 0x38, 0xc2, 0x9e, 0x52, 0x11, 0x6a, 0x13, 0x22,
+	0x8c, 0x18, 0x06, 0x9c, 0x22, 0xc0, 0x37, 0xa0, 0x88, 0x49, 0x40, 0x7c, 0x24, 0x29, 0x67, 0xc2,
+	0x9e, 0x56, 0xc0, 0x87, 0x13, 0x80, 0xcf, 0x47, 0x0a, 0x43, 0x4c, 0x33, 0xe0, 0x00, 0x2c, 0x46,
+	0xac, 0xcb, 0x19, 0xa6, 0xcc, 0xf7, 0xd2, 0xf0, 0x19, 0x05, 0x6f, 0x4d, 0x80, 0xbf, 0x4d, 0xb4,
+	0x99, 0x28, 0xe5, 0x28, 0x6b, 0x12, 0xf0, 0x1d, 0x98, 0x0f, 0x49, 0x3a, 0xcc, 0xac, 0x0a, 0xf3,
+	0x68, 0x42, 0x18, 0x97, 0xe0, 0xeb, 0xfc, 0xab, 0x1c, 0xb8, 0x0c, 0x0a, 0xe4, 0x68, 0xc8, 0x43,
+	0x49, 0xb0, 0x5d, 0x70, 0xac, 0x5a, 0xc1, 0x1d, 0x9d, 0x21, 0x03, 0xff, 0x49, 0xde, 0x27, 0x8c,
+	0x7e, 0x22, 0x9e, 0xd8, 0x43, 0x21, 0xf1, 0x42, 0xd2, 0xe3, 0x21, 0x16, 0xf6, 0xdc, 0x9d, 0x8a,
+	0xdc, 0x36, 0xe2, 0x4e, 0xac, 0x75, 0x95, 0x34, 0x29, 0x52, 0x66, 0x4d, 0x02, 0x3e, 0x05, 0x2b,
+	0xa6, 0x7b, 0x6f, 0x08, 0xea, 0x51, 0x6c, 0x03, 0xc7, 0xaa, 0x4d, 0xb9, 0x4b, 0xba, 0x35, 0x33,
+	0x80, 0x4d, 0x0c, 0x11, 0x70, 0x74, 0x4a, 0x9e, 0xc9, 0xc5, 0x1b, 0xa2, 0x48, 0x10, 0xec, 0xa5,
+	0xda, 0xa9, 0xe8, 0xe4, 0x6b, 0x73, 0x6d, 0xfb, 0xfb, 0xb7, 0x7a, 0xd9, 0x0c, 0xec, 0x06, 0xc6,
+	0x21, 0x11, 0xa2, 0x23, 0x43, 0xca, 0x7c, 0xd7, 0x2c, 0x82, 0x8e, 0x06, 0x6c, 0x29, 0xfd, 0xce,
+	0xb8, 0x97, 0xf6, 0xc1, 0xff, 0xe3, 0x19, 0x88, 0x5f, 0xca, 0x43, 0xbd, 0x1e, 0x8f, 0x98, 0x14,
+	0x76, 0x49, 0xdd, 0xca, 0xfa, 0x9d, 0x1b, 0x95, 0x33, 0xbc, 0xa1, 0xb5, 0xe6, 0x5a, 0x16, 0x0f,
+	0x6e, 0xb0, 0x09, 0xe8, 0x83, 0x7b, 0xd7, 0x43, 0x06, 0x01, 0x3f, 0x0c, 0x68, 0x7a, 0x1e, 0x85,
+	0x3d, 0x3f, 0xa1, 0x30, 0xe7, 0x2a, 0x3d, 0x41, 0xa4, 0x6a, 0x73, 0x41, 0x49, 0x5f, 0x39, 0xdf,
+	0xdd, 0x8d, 0x67, 0x7b, 0xe1, 0x4e, 0x83, 0xa2, 0x9e, 0xe0, 0x75, 0xac, 0x48, 0x06, 0x45, 0x8c,
+	0xfe, 0x11, 0xb0, 0x0e, 0xd4, 0x88, 0x7b, 0x29, 0x70, 0xfc, 0x94, 0x7f, 0xab, 0xa7, 0x54, 0xdb,
+	0x6a, 0xac, 0xdf, 0xc4, 0xab, 0x7b, 0x00, 0x66, 0x77, 0x05, 0x6c, 0x81, 0x59, 0xa4, 0x6b, 0x51,
+	0xdb, 0xf1, 0xb6, 0x2a, 0x13, 0x47, 0x58, 0x06, 0xd3, 0xe3, 0x05, 0x98, 0x77, 0xf5, 0xe1, 0x71,
+	0xe1, 0xf3, 0x49, 0x35, 0xf7, 0xfb, 0xa4, 0x9a, 0x6b, 0x7f, 0x38, 0xbd, 0xa8, 0x58, 0x67, 0x17,
+	0x15, 0xeb, 0xd7, 0x45, 0xc5, 0xfa, 0x72, 0x59, 0xc9, 0x9d, 0x5d, 0x56, 0x72, 0x3f, 0x2e, 0x2b,
+	0xb9, 0x8f, 0x4f, 0x52, 0x3b, 0x92, 0xee, 0x07, 0x91, 0xa0, 0x9c, 0x51, 0xd6, 0x6b, 0xea, 0x6b,
+	0xa0, 0xf2, 0xb8, 0x6e, 0xae, 0xa0, 0x3e, 0xe0, 0x38, 0x0a, 0x48, 0xf3, 0x28, 0x59, 0xee, 0x7a,
+	0x81, 0x76, 0x67, 0xd4, 0x8e, 0x5f, 0xff, 0x33, 0x00, 0x57, 0xe3, 0xd1, 0x32, 0x73, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -254,6 +308,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastShareOfferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastShareOfferId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ShareOffers) > 0 {
+		for iNdEx := len(m.ShareOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ValidatorBondAllowlistValidators) > 0 {
+		for iNdEx := len(m.ValidatorBondAllowlistValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorBondAllowlistValidators[iNdEx])
+			copy(dAtA[i:], m.ValidatorBondAllowlistValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorBondAllowlistValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorBondAccounts) > 0 {
+		for iNdEx := len(m.ValidatorBondAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBondAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.LiquidStakingPausedValidators) > 0 {
+		for iNdEx := len(m.LiquidStakingPausedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LiquidStakingPausedValidators[iNdEx])
+			copy(dAtA[i:], m.LiquidStakingPausedValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LiquidStakingPausedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.LastTokenizeShareRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
 		i--
@@ -474,6 +579,33 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastTokenizeShareRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
 	}
+	if len(m.LiquidStakingPausedValidators) > 0 {
+		for _, s := range m.LiquidStakingPausedValidators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBondAccounts) > 0 {
+		for _, e := range m.ValidatorBondAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBondAllowlistValidators) > 0 {
+		for _, s := range m.ValidatorBondAllowlistValidators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ShareOffers) > 0 {
+		for _, e := range m.ShareOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastShareOfferId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastShareOfferId))
+	}
 	return n
 }
 
@@ -837,6 +969,157 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingPausedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidStakingPausedValidators = append(m.LiquidStakingPausedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBondAccounts = append(m.ValidatorBondAccounts, ValidatorBondAccount{})
+			if err := m.ValidatorBondAccounts[len(m.ValidatorBondAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondAllowlistValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBondAllowlistValidators = append(m.ValidatorBondAllowlistValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareOffers = append(m.ShareOffers, ShareOffer{})
+			if err := m.ShareOffers[len(m.ShareOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastShareOfferId", wireType)
+			}
+			m.LastShareOfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastShareOfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -112,3 +112,57 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterTokenizeShareRecordsSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec, recordIds []uint64) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordsSlashed(ctx, valAddr, fraction, recordIds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeShareRecordsTombstoned(ctx sdk.Context, valAddr sdk.ValAddress, recordIds []uint64) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordsTombstoned(ctx, valAddr, recordIds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeShareRecordCreated(ctx sdk.Context, recordId uint64) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordCreated(ctx, recordId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeSharesRedeemed(ctx sdk.Context, recordId uint64, delAddr sdk.AccAddress, shares sdk.Dec) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeSharesRedeemed(ctx, recordId, delAddr, shares); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordId uint64, from, to sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordTransferred(ctx, recordId, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorBondChanged(ctx, delAddr, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -49,11 +49,30 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
-
-	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenizeshare record prefix
-	TokenizeShareRecordIdByOwnerPrefix = []byte{0x62} // key for tokenizeshare record id by owner prefix
-	TokenizeShareRecordIdByDenomPrefix = []byte{0x63} // key for tokenizeshare record id by denom prefix
-	LastTokenizeShareRecordIdKey       = []byte{0x64} // key for last tokenize share record id
+	ParamsKey         = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix                  = []byte{0x61} // key for tokenizeshare record prefix
+	TokenizeShareRecordIdByOwnerPrefix         = []byte{0x62} // key for tokenizeshare record id by owner prefix
+	TokenizeShareRecordIdByDenomPrefix         = []byte{0x63} // key for tokenizeshare record id by denom prefix
+	LastTokenizeShareRecordIdKey               = []byte{0x64} // key for last tokenize share record id
+	TokenizeShareRecordIdByModuleAccountPrefix = []byte{0x65} // key for tokenizeshare record id by module account prefix
+	TokenizeShareRecordPruneCursorKey          = []byte{0x66} // key for the next tokenize share record id to inspect for pruning
+	ValidatorTokenizedSharesKey                = []byte{0x67} // prefix for the shares of a validator held by tokenize share record module accounts
+	TokenizeShareRecordIdByValidatorPrefix     = []byte{0x68} // key for tokenizeshare record id by validator prefix
+	TombstonedValidatorQueueKey                = []byte{0x69} // prefix for the tombstoned validators whose tokenize share records are being redelegated
+
+	ValidatorBondShortfallKey = []byte{0x71} // prefix for the height at which a validator bond fell below the minimum
+
+	LiquidStakingPausedValidatorKey = []byte{0x81} // prefix for the validators for which liquid staking is paused
+	ValidatorBondAccountKey         = []byte{0x82} // prefix for the accounts allowed to validator bond to a validator
+	ValidatorBondAllowlistKey       = []byte{0x83} // prefix for the validators whose validator bonds are restricted to their allowlist
+
+	ShareOfferKey       = []byte{0x91} // prefix for each key to a share offer
+	LastShareOfferIdKey = []byte{0x92} // key for the last share offer id
+	ShareOfferQueueKey  = []byte{0x93} // prefix for the share offer expiration queue
+
+	ShareOfferByDenomKey     = []byte{0x94} // prefix for the share offer ids by share token denom
+	ShareOfferByValidatorKey = []byte{0x95} // prefix for the share offer ids by validator of the offered shares
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -372,3 +391,143 @@ func GetTokenizeShareRecordIdByOwnerAndIdKey(owner sdk.AccAddress, id uint64) []
 func GetTokenizeShareRecordIdByDenomKey(denom string) []byte {
 	return append(TokenizeShareRecordIdByDenomPrefix, []byte(denom)...)
 }
+
+// GetTokenizeShareRecordIdByModuleAccountKey returns the key of the specified module account. Intended for
+// looking up the tokenizeShareRecord backing a delegation
+func GetTokenizeShareRecordIdByModuleAccountKey(moduleAccount sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIdByModuleAccountPrefix, address.MustLengthPrefix(moduleAccount)...)
+}
+
+// GetValidatorTokenizedSharesKey creates the key for the shares of a validator
+// held by tokenize share record module accounts
+func GetValidatorTokenizedSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorTokenizedSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetTokenizeShareRecordIdsByValidatorPrefix returns the key of the specified validator. Intended for querying all tokenizeShareRecords delegated to a validator
+func GetTokenizeShareRecordIdsByValidatorPrefix(valAddr sdk.ValAddress) []byte {
+	return append(TokenizeShareRecordIdByValidatorPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetTokenizeShareRecordIdByValidatorAndIdKey returns the key of the specified validator and id. Intended for setting tokenizeShareRecord of a validator
+func GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIdsByValidatorPrefix(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTombstonedValidatorQueueKey creates the key for a tombstoned validator whose
+// tokenize share records are being redelegated to the tombstone fallback validator
+// VALUE: id of the next record to redelegate (big endian uint64)
+func GetTombstonedValidatorQueueKey(valAddr sdk.ValAddress) []byte {
+	return append(TombstonedValidatorQueueKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// AddressFromTombstonedValidatorQueueKey returns the operator address from a tombstoned validator queue key
+func AddressFromTombstonedValidatorQueueKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetValidatorBondShortfallKey creates the key for the validator bond shortfall of a validator
+// VALUE: start height (big endian uint64)
+func GetValidatorBondShortfallKey(operator sdk.ValAddress) []byte {
+	return append(ValidatorBondShortfallKey, address.MustLengthPrefix(operator)...)
+}
+
+// AddressFromValidatorBondShortfallKey returns the operator address from a validator bond shortfall key
+func AddressFromValidatorBondShortfallKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetLiquidStakingPausedValidatorKey creates the key for a validator for which liquid staking is paused
+// VALUE: empty
+func GetLiquidStakingPausedValidatorKey(operator sdk.ValAddress) []byte {
+	return append(LiquidStakingPausedValidatorKey, address.MustLengthPrefix(operator)...)
+}
+
+// AddressFromLiquidStakingPausedValidatorKey returns the operator address from a liquid staking paused validator key
+func AddressFromLiquidStakingPausedValidatorKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetValidatorBondAccountKey creates the key for an account allowed to validator bond to a validator
+// VALUE: empty
+func GetValidatorBondAccountKey(valAddr sdk.ValAddress, accAddr sdk.AccAddress) []byte {
+	return append(GetValidatorBondAccountsKey(valAddr), address.MustLengthPrefix(accAddr)...)
+}
+
+// GetValidatorBondAccountsKey creates the prefix for all accounts allowed to validator bond to a validator
+func GetValidatorBondAccountsKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondAccountKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondAllowlistKey creates the key for a validator whose validator bonds are
+// restricted to its validator bond allowlist
+// VALUE: empty
+func GetValidatorBondAllowlistKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondAllowlistKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// AddressFromValidatorBondAllowlistKey returns the operator address from a validator bond allowlist key
+func AddressFromValidatorBondAllowlistKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// ParseValidatorBondAccountKey returns the validator and account addresses from a validator bond account key
+func ParseValidatorBondAccountKey(key []byte) (sdk.ValAddress, sdk.AccAddress) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	valAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+valAddrLen)
+	valAddr := sdk.ValAddress(key[2 : 2+valAddrLen])
+	accAddr := sdk.AccAddress(key[3+valAddrLen:])
+	return valAddr, accAddr
+}
+
+// GetShareOfferKey creates the key for a share offer
+// VALUE: staking/ShareOffer
+func GetShareOfferKey(id uint64) []byte {
+	return append(ShareOfferKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetShareOfferQueueTimeKey creates the prefix for the share offers expiring at a time
+func GetShareOfferQueueTimeKey(expiration time.Time) []byte {
+	return append(ShareOfferQueueKey, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetShareOfferQueueKey creates the key for a share offer in the expiration queue
+// VALUE: empty
+func GetShareOfferQueueKey(expiration time.Time, id uint64) []byte {
+	return append(GetShareOfferQueueTimeKey(expiration), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseShareOfferQueueKey returns the share offer id from a share offer queue key
+func ParseShareOfferQueueKey(key []byte) uint64 {
+	kv.AssertKeyAtLeastLength(key, 9)
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// GetShareOffersByDenomPrefix creates the prefix for the share offers including a share token denom
+func GetShareOffersByDenomPrefix(denom string) []byte {
+	return append(ShareOfferByDenomKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetShareOfferByDenomKey creates the key for a share offer including a share token denom
+// VALUE: empty
+func GetShareOfferByDenomKey(denom string, id uint64) []byte {
+	return append(GetShareOffersByDenomPrefix(denom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetShareOffersByValidatorPrefix creates the prefix for the share offers of shares delegated to a validator
+func GetShareOffersByValidatorPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ShareOfferByValidatorKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetShareOfferByValidatorKey creates the key for a share token denom of a share offer
+// whose shares are delegated to a validator. An offer has one key per denom so that
+// the entry of each denom can be moved when its record is redelegated.
+// VALUE: empty
+func GetShareOfferByValidatorKey(valAddr sdk.ValAddress, id uint64, denom string) []byte {
+	return append(append(GetShareOffersByValidatorPrefix(valAddr), sdk.Uint64ToBigEndian(id)...), denom...)
+}
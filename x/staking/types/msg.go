@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 
@@ -8,6 +10,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -24,6 +27,16 @@ const (
 	TypeMsgRedeemTokensforShares       = "redeem_tokens_for_shares"
 	TypeMsgTransferTokenizeShareRecord = "transfer_tokenize_share_record"
 	TypeMsgValidatorBond               = "validator_bond"
+	TypeMsgAddValidatorBondAccount     = "add_validator_bond_account"
+	TypeMsgRemoveValidatorBondAccount  = "remove_validator_bond_account"
+	TypeMsgSetValidatorBondAllowlist   = "set_validator_bond_allowlist"
+	TypeMsgTransferValidatorBond       = "transfer_validator_bond"
+	TypeMsgCreateShareOffer            = "create_share_offer"
+	TypeMsgAcceptShareOffer            = "accept_share_offer"
+	TypeMsgCancelShareOffer            = "cancel_share_offer"
+
+	TypeMsgVoteWithTokenizeShareRecord = "vote_with_tokenize_share_record"
+	TypeMsgUpdateParams                = "update_params"
 )
 
 var (
@@ -40,6 +53,15 @@ var (
 	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgValidatorBond{}
+	_ sdk.Msg                            = &MsgAddValidatorBondAccount{}
+	_ sdk.Msg                            = &MsgRemoveValidatorBondAccount{}
+	_ sdk.Msg                            = &MsgSetValidatorBondAllowlist{}
+	_ sdk.Msg                            = &MsgTransferValidatorBond{}
+	_ sdk.Msg                            = &MsgCreateShareOffer{}
+	_ sdk.Msg                            = &MsgAcceptShareOffer{}
+	_ sdk.Msg                            = &MsgCancelShareOffer{}
+	_ sdk.Msg                            = &MsgVoteWithTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgUpdateParams{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -126,9 +148,11 @@ func (msg MsgCreateValidator) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty commission")
 	}
 
-	err = msg.Commission.Validate()
+	if err := msg.Commission.Validate(); err != nil {
+		return err
+	}
 
-	return err
+	return validateMaxLiquidShareRatio(msg.MaxLiquidShareRatio)
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -182,6 +206,22 @@ func (msg MsgEditValidator) ValidateBasic() error {
 		}
 	}
 
+	if msg.LiquidCommissionRate != nil {
+		if msg.LiquidCommissionRate.GT(sdk.OneDec()) || msg.LiquidCommissionRate.IsNegative() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "liquid commission rate must be between 0 and 1 (inclusive)")
+		}
+	}
+
+	return validateMaxLiquidShareRatio(msg.MaxLiquidShareRatio)
+}
+
+// validateMaxLiquidShareRatio checks that an optional max liquid share ratio is
+// between 0 and 1 (inclusive)
+func validateMaxLiquidShareRatio(ratio *sdk.Dec) error {
+	if ratio != nil && (ratio.GT(sdk.OneDec()) || ratio.IsNegative()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max liquid share ratio must be between 0 and 1 (inclusive)")
+	}
+
 	return nil
 }
 
@@ -575,3 +615,413 @@ func (msg MsgValidatorBond) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgAddValidatorBondAccount creates a new MsgAddValidatorBondAccount instance.
+//
+//nolint:interfacer
+func NewMsgAddValidatorBondAccount(valAddr sdk.ValAddress, accAddr sdk.AccAddress) *MsgAddValidatorBondAccount {
+	return &MsgAddValidatorBondAccount{
+		ValidatorAddress: valAddr.String(),
+		AccountAddress:   accAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAddValidatorBondAccount) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAddValidatorBondAccount) Type() string { return TypeMsgAddValidatorBondAccount }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAddValidatorBondAccount) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAddValidatorBondAccount) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAddValidatorBondAccount) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.AccountAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgRemoveValidatorBondAccount creates a new MsgRemoveValidatorBondAccount instance.
+//
+//nolint:interfacer
+func NewMsgRemoveValidatorBondAccount(valAddr sdk.ValAddress, accAddr sdk.AccAddress) *MsgRemoveValidatorBondAccount {
+	return &MsgRemoveValidatorBondAccount{
+		ValidatorAddress: valAddr.String(),
+		AccountAddress:   accAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondAccount) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondAccount) Type() string { return TypeMsgRemoveValidatorBondAccount }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondAccount) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondAccount) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondAccount) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.AccountAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetValidatorBondAllowlist creates a new MsgSetValidatorBondAllowlist instance.
+//
+//nolint:interfacer
+func NewMsgSetValidatorBondAllowlist(valAddr sdk.ValAddress, enabled bool) *MsgSetValidatorBondAllowlist {
+	return &MsgSetValidatorBondAllowlist{
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondAllowlist) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondAllowlist) Type() string { return TypeMsgSetValidatorBondAllowlist }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondAllowlist) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondAllowlist) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondAllowlist) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgTransferValidatorBond creates a new MsgTransferValidatorBond instance.
+//
+//nolint:interfacer
+func NewMsgTransferValidatorBond(delAddr sdk.AccAddress, valAddr sdk.ValAddress, recipient sdk.AccAddress, shares sdk.Dec) *MsgTransferValidatorBond {
+	return &MsgTransferValidatorBond{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		RecipientAddress: recipient.String(),
+		Shares:           shares,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTransferValidatorBond) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTransferValidatorBond) Type() string { return TypeMsgTransferValidatorBond }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTransferValidatorBond) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTransferValidatorBond) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTransferValidatorBond) ValidateBasic() error {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.RecipientAddress)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	// compare the decoded addresses, as a bech32 address may be written in upper case
+	if delAddr.Equals(recipient) {
+		return sdkerrors.ErrInvalidRequest.Wrap("delegator and recipient cannot be the same")
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("shares must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgCreateShareOffer creates a new MsgCreateShareOffer instance.
+//
+//nolint:interfacer
+func NewMsgCreateShareOffer(seller sdk.AccAddress, shareTokens sdk.Coins, recordId uint64, price sdk.Coin, expiration time.Time) *MsgCreateShareOffer {
+	return &MsgCreateShareOffer{
+		Seller:                seller.String(),
+		ShareTokens:           shareTokens,
+		TokenizeShareRecordId: recordId,
+		Price:                 price,
+		Expiration:            expiration,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateShareOffer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateShareOffer) Type() string { return TypeMsgCreateShareOffer }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateShareOffer) GetSigners() []sdk.AccAddress {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{seller}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateShareOffer) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateShareOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid seller address: %s", err)
+	}
+	if msg.Expiration.IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiration must be set")
+	}
+
+	return validateShareOfferAssets(msg.ShareTokens, msg.TokenizeShareRecordId, msg.Price)
+}
+
+// NewMsgAcceptShareOffer creates a new MsgAcceptShareOffer instance.
+//
+//nolint:interfacer
+func NewMsgAcceptShareOffer(buyer sdk.AccAddress, offerId uint64) *MsgAcceptShareOffer {
+	return &MsgAcceptShareOffer{
+		Buyer:   buyer.String(),
+		OfferId: offerId,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAcceptShareOffer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAcceptShareOffer) Type() string { return TypeMsgAcceptShareOffer }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAcceptShareOffer) GetSigners() []sdk.AccAddress {
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{buyer}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAcceptShareOffer) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAcceptShareOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid buyer address: %s", err)
+	}
+	if msg.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer id cannot be zero")
+	}
+
+	return nil
+}
+
+// NewMsgCancelShareOffer creates a new MsgCancelShareOffer instance.
+//
+//nolint:interfacer
+func NewMsgCancelShareOffer(seller sdk.AccAddress, offerId uint64) *MsgCancelShareOffer {
+	return &MsgCancelShareOffer{
+		Seller:  seller.String(),
+		OfferId: offerId,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelShareOffer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelShareOffer) Type() string { return TypeMsgCancelShareOffer }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelShareOffer) GetSigners() []sdk.AccAddress {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{seller}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelShareOffer) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelShareOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid seller address: %s", err)
+	}
+	if msg.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer id cannot be zero")
+	}
+
+	return nil
+}
+
+// NewMsgVoteWithTokenizeShareRecord creates a new MsgVoteWithTokenizeShareRecord instance.
+//
+//nolint:interfacer
+func NewMsgVoteWithTokenizeShareRecord(
+	owner sdk.AccAddress, recordId, proposalId uint64, options govv1.WeightedVoteOptions, metadata string,
+) *MsgVoteWithTokenizeShareRecord {
+	return &MsgVoteWithTokenizeShareRecord{
+		Owner:                 owner.String(),
+		TokenizeShareRecordId: recordId,
+		ProposalId:            proposalId,
+		Options:               options,
+		Metadata:              metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVoteWithTokenizeShareRecord) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVoteWithTokenizeShareRecord) Type() string { return TypeMsgVoteWithTokenizeShareRecord }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgVoteWithTokenizeShareRecord) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgVoteWithTokenizeShareRecord) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgVoteWithTokenizeShareRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if msg.TokenizeShareRecordId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("tokenize share record id cannot be zero")
+	}
+
+	// the vote options are subject to the same rules as a weighted vote
+	vote := govv1.MsgVoteWeighted{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Owner,
+		Options:    msg.Options,
+		Metadata:   msg.Metadata,
+	}
+	return vote.ValidateBasic()
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+//
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
@@ -112,6 +112,64 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for the liquid commission rate of MsgEditValidator
+func TestMsgEditValidatorLiquidCommissionRate(t *testing.T) {
+	tests := []struct {
+		name       string
+		liquidRate *sdk.Dec
+		expectPass bool
+	}{
+		{"no liquid rate", nil, true},
+		{"zero liquid rate", decPtr(sdk.ZeroDec()), true},
+		{"full liquid rate", decPtr(sdk.OneDec()), true},
+		{"negative liquid rate", decPtr(sdk.NewDecWithPrec(-1, 1)), false},
+		{"liquid rate above one", decPtr(sdk.NewDecWithPrec(11, 1)), false},
+	}
+
+	for _, tc := range tests {
+		description := types.NewDescription("a", "b", "c", "d", "e")
+
+		msg := types.NewMsgEditValidator(valAddr1, description, nil)
+		msg.LiquidCommissionRate = tc.liquidRate
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for the max liquid share ratio of MsgEditValidator
+func TestMsgEditValidatorMaxLiquidShareRatio(t *testing.T) {
+	tests := []struct {
+		name       string
+		ratio      *sdk.Dec
+		expectPass bool
+	}{
+		{"no ratio", nil, true},
+		{"zero ratio", decPtr(sdk.ZeroDec()), true},
+		{"full ratio", decPtr(sdk.OneDec()), true},
+		{"negative ratio", decPtr(sdk.NewDecWithPrec(-1, 1)), false},
+		{"ratio above one", decPtr(sdk.NewDecWithPrec(11, 1)), false},
+	}
+
+	for _, tc := range tests {
+		description := types.NewDescription("a", "b", "c", "d", "e")
+
+		msg := types.NewMsgEditValidator(valAddr1, description, nil)
+		msg.MaxLiquidShareRatio = tc.ratio
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func decPtr(d sdk.Dec) *sdk.Dec {
+	return &d
+}
+
 // test ValidateBasic for MsgDelegate
 func TestMsgDelegate(t *testing.T) {
 	tests := []struct {
@@ -31,6 +31,16 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMinValidatorBondGracePeriod is set to 0, which is only valid while
+	// the minimum validator bond is disabled
+	DefaultMinValidatorBondGracePeriod uint64 = 0
+
+	// DefaultTombstoneFallbackValidator is empty (disabled)
+	DefaultTombstoneFallbackValidator = ""
+
+	// DefaultTokenizeShareRecordVotingEnabled is false (disabled)
+	DefaultTokenizeShareRecordVotingEnabled = false
 )
 
 var (
@@ -38,6 +48,10 @@ var (
 	DefaultMinCommissionRate = sdk.ZeroDec()
 	// DefaultValidatorBondFactor is set to -1 (disabled)
 	DefaultValidatorBondFactor = sdk.NewDecFromInt(sdk.NewInt(-1))
+	// DefaultMinValidatorBond is set to 0 (disabled)
+	DefaultMinValidatorBond = sdk.ZeroDec()
+	// DefaultValidatorBondSlashMultiplier is set to 1 (no extra burn)
+	DefaultValidatorBondSlashMultiplier = sdk.OneDec()
 )
 
 var (
@@ -48,6 +62,13 @@ var (
 	KeyHistoricalEntries   = []byte("HistoricalEntries")
 	KeyMinCommissionRate   = []byte("MinCommissionRate")
 	KeyValidatorBondFactor = []byte("ValidatorBondFactor")
+	KeyMinValidatorBond    = []byte("MinValidatorBond")
+
+	KeyMinValidatorBondGracePeriod  = []byte("MinValidatorBondGracePeriod")
+	KeyValidatorBondSlashMultiplier = []byte("ValidatorBondSlashMultiplier")
+	KeyTombstoneFallbackValidator   = []byte("TombstoneFallbackValidator")
+
+	KeyTokenizeShareRecordVotingEnabled = []byte("TokenizeShareRecordVotingEnabled")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -58,7 +79,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate, validatorBondFactor sdk.Dec) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate, validatorBondFactor, minValidatorBond sdk.Dec, minValidatorBondGracePeriod uint64, validatorBondSlashMultiplier sdk.Dec, tombstoneFallbackValidator string, tokenizeShareRecordVotingEnabled bool) Params {
 	return Params{
 		UnbondingTime:       unbondingTime,
 		MaxValidators:       maxValidators,
@@ -67,6 +88,13 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 		BondDenom:           bondDenom,
 		MinCommissionRate:   minCommissionRate,
 		ValidatorBondFactor: validatorBondFactor,
+		MinValidatorBond:    minValidatorBond,
+
+		MinValidatorBondGracePeriod:  minValidatorBondGracePeriod,
+		ValidatorBondSlashMultiplier: validatorBondSlashMultiplier,
+		TombstoneFallbackValidator:   tombstoneFallbackValidator,
+
+		TokenizeShareRecordVotingEnabled: tokenizeShareRecordVotingEnabled,
 	}
 }
 
@@ -80,6 +108,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
 		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
+		paramtypes.NewParamSetPair(KeyMinValidatorBond, &p.MinValidatorBond, validateMinValidatorBond),
+		paramtypes.NewParamSetPair(KeyMinValidatorBondGracePeriod, &p.MinValidatorBondGracePeriod, validateMinValidatorBondGracePeriod),
+		paramtypes.NewParamSetPair(KeyValidatorBondSlashMultiplier, &p.ValidatorBondSlashMultiplier, validateValidatorBondSlashMultiplier),
+		paramtypes.NewParamSetPair(KeyTombstoneFallbackValidator, &p.TombstoneFallbackValidator, validateTombstoneFallbackValidator),
+		paramtypes.NewParamSetPair(KeyTokenizeShareRecordVotingEnabled, &p.TokenizeShareRecordVotingEnabled, validateTokenizeShareRecordVotingEnabled),
 	}
 }
 
@@ -93,6 +126,11 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultValidatorBondFactor,
+		DefaultMinValidatorBond,
+		DefaultMinValidatorBondGracePeriod,
+		DefaultValidatorBondSlashMultiplier,
+		DefaultTombstoneFallbackValidator,
+		DefaultTokenizeShareRecordVotingEnabled,
 	)
 }
 
@@ -136,6 +174,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateHistoricalEntries(p.HistoricalEntries); err != nil {
+		return err
+	}
+
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
@@ -148,6 +190,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinValidatorBond(p.MinValidatorBond); err != nil {
+		return err
+	}
+
+	if err := validateMinValidatorBondGracePeriod(p.MinValidatorBondGracePeriod); err != nil {
+		return err
+	}
+
+	if p.MinValidatorBond.IsPositive() && p.MinValidatorBondGracePeriod == 0 {
+		return fmt.Errorf("minimum validator bond grace period must be positive when the minimum validator bond is set")
+	}
+
+	if err := validateValidatorBondSlashMultiplier(p.ValidatorBondSlashMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateTombstoneFallbackValidator(p.TombstoneFallbackValidator); err != nil {
+		return err
+	}
+
+	if err := validateTokenizeShareRecordVotingEnabled(p.TokenizeShareRecordVotingEnabled); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -257,3 +323,64 @@ func validateValidatorBondFactor(i interface{}) error {
 
 	return nil
 }
+
+func validateMinValidatorBond(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("minimum validator bond cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinValidatorBondGracePeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateValidatorBondSlashMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("validator bond slash multiplier cannot be lower than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateTombstoneFallbackValidator(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.ValAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid tombstone fallback validator: %w", err)
+	}
+
+	return nil
+}
+
+func validateTokenizeShareRecordVotingEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -35,4 +35,37 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate min validator bond
+	params = types.DefaultParams()
+	params.MinValidatorBond = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.MinValidatorBond = sdk.NewDec(100)
+	require.Error(t, params.Validate())
+
+	params.MinValidatorBondGracePeriod = 100
+	require.NoError(t, params.Validate())
+
+	// validate validator bond slash multiplier
+	params = types.DefaultParams()
+	params.ValidatorBondSlashMultiplier = sdk.NewDecWithPrec(5, 1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorBondSlashMultiplier = sdk.NewDec(2)
+	require.NoError(t, params.Validate())
+
+	// validate tombstone fallback validator
+	params = types.DefaultParams()
+	params.TombstoneFallbackValidator = "invalid"
+	require.Error(t, params.Validate())
+
+	params.TombstoneFallbackValidator = sdk.ValAddress("fallback").String()
+	require.NoError(t, params.Validate())
+
+	// validate tokenize share record voting enabled
+	params = types.DefaultParams()
+	require.False(t, params.TokenizeShareRecordVotingEnabled)
+	params.TokenizeShareRecordVotingEnabled = true
+	require.NoError(t, params.Validate())
 }
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeValidatorLiquidStakingPause defines the type for a ValidatorLiquidStakingPauseProposal
+	ProposalTypeValidatorLiquidStakingPause = "ValidatorLiquidStakingPause"
+)
+
+// Assert ValidatorLiquidStakingPauseProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &ValidatorLiquidStakingPauseProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeValidatorLiquidStakingPause)
+}
+
+// NewValidatorLiquidStakingPauseProposal creates a new validator liquid staking pause proposal.
+//
+//nolint:interfacer
+func NewValidatorLiquidStakingPauseProposal(title, description string, valAddr sdk.ValAddress, paused bool) *ValidatorLiquidStakingPauseProposal {
+	return &ValidatorLiquidStakingPauseProposal{title, description, valAddr.String(), paused}
+}
+
+// GetTitle returns the title of a validator liquid staking pause proposal.
+func (p *ValidatorLiquidStakingPauseProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a validator liquid staking pause proposal.
+func (p *ValidatorLiquidStakingPauseProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a validator liquid staking pause proposal.
+func (p *ValidatorLiquidStakingPauseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a validator liquid staking pause proposal.
+func (p *ValidatorLiquidStakingPauseProposal) ProposalType() string {
+	return ProposalTypeValidatorLiquidStakingPause
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ValidatorLiquidStakingPauseProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if _, err := sdk.ValAddressFromBech32(p.ValidatorAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ValidatorLiquidStakingPauseProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Validator Liquid Staking Pause Proposal:
+  Title:       %s
+  Description: %s
+  Validator:   %s
+  Paused:      %t
+`, p.Title, p.Description, p.ValidatorAddress, p.Paused))
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MaxShareOfferExpirationsPerBlock is the maximum number of matured share offers
+// expired in a single block
+const MaxShareOfferExpirationsPerBlock = 100
+
+// GetShareOfferEscrowAddress returns the account holding the assets of a share
+// offer while the offer is open
+func GetShareOfferEscrowAddress(id uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("shareoffer_%d", id))
+}
+
+// GetEscrowAddress returns the account holding the assets of the offer
+func (o ShareOffer) GetEscrowAddress() sdk.AccAddress {
+	return GetShareOfferEscrowAddress(o.Id)
+}
+
+// HasTokenizeShareRecord returns true if the offer includes a tokenize share record
+func (o ShareOffer) HasTokenizeShareRecord() bool {
+	return o.TokenizeShareRecordId != 0
+}
+
+// Validate performs a stateless validation of the share offer
+func (o ShareOffer) Validate() error {
+	if o.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("share offer id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid seller address: %s", err)
+	}
+	return validateShareOfferAssets(o.ShareTokens, o.TokenizeShareRecordId, o.Price)
+}
+
+// validateShareOfferAssets checks that an offer includes share tokens or a record
+// and asks for a positive price
+func validateShareOfferAssets(shareTokens sdk.Coins, recordId uint64, price sdk.Coin) error {
+	if shareTokens.Empty() && recordId == 0 {
+		return ErrInvalidShareOfferAsset.Wrap("share tokens or a tokenize share record must be offered")
+	}
+	if !shareTokens.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid share tokens: %s", shareTokens)
+	}
+	for _, token := range shareTokens {
+		if _, err := ValidatorAddressFromShareDenom(token.Denom); err != nil {
+			return ErrInvalidShareOfferAsset.Wrap(err.Error())
+		}
+	}
+	if !price.IsValid() || !price.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid price: %s", price)
+	}
+	return nil
+}
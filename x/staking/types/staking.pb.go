@@ -141,6 +141,10 @@ type CommissionRates struct {
 	MaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate"`
 	// max_change_rate defines the maximum daily increase of the validator commission, as a fraction.
 	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate"`
+	// liquid_commission_rate is the optional commission rate charged to delegations
+	// held by tokenize share record module accounts, as a fraction. It only takes
+	// effect when it is greater than rate.
+	LiquidCommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquid_commission_rate,json=liquidCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_commission_rate,omitempty" yaml:"liquid_commission_rate,omitempty"`
 }
 
 func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
@@ -336,6 +340,10 @@ type Validator struct {
 	TotalValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=total_validator_bond_shares,json=totalValidatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_validator_bond_shares"`
 	// Total number of shares either tokenized or owned by a liquid staking provider
 	TotalLiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=total_liquid_shares,json=totalLiquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_liquid_shares"`
+	// max_liquid_share_ratio is the optional maximum fraction of the delegator
+	// shares that may be liquid, set by the validator operator on top of the
+	// global caps.
+	MaxLiquidShareRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_liquid_share_ratio,json=maxLiquidShareRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_liquid_share_ratio,omitempty" yaml:"max_liquid_share_ratio,omitempty"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -853,6 +861,25 @@ type Params struct {
 	// validator_bond_factor is required as a safety check for tokenizing shares and
 	// delegations from liquid staking providers
 	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor" yaml:"validator_bond_factor"`
+	// min_validator_bond is the minimum amount of tokens backing the validator
+	// bond shares that a validator must hold to be unjailed or to remain in the
+	// bonded set
+	MinValidatorBond github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_validator_bond,json=minValidatorBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_validator_bond" yaml:"min_validator_bond"`
+	// min_validator_bond_grace_period is the number of blocks a bonded validator
+	// may remain below the min_validator_bond before it is jailed
+	MinValidatorBondGracePeriod uint64 `protobuf:"varint,9,opt,name=min_validator_bond_grace_period,json=minValidatorBondGracePeriod,proto3" json:"min_validator_bond_grace_period,omitempty" yaml:"min_validator_bond_grace_period"`
+	// validator_bond_slash_multiplier scales the slash factor of a double-sign
+	// infraction into an extra burn of the validator bond delegations' shares,
+	// where a multiplier of 1 applies no extra burn
+	ValidatorBondSlashMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_bond_slash_multiplier,json=validatorBondSlashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_slash_multiplier" yaml:"validator_bond_slash_multiplier"`
+	// tombstone_fallback_validator is the operator address of the validator that
+	// tokenize share records are redelegated to when their validator is
+	// tombstoned, an empty address disables the redelegation
+	TombstoneFallbackValidator string `protobuf:"bytes,11,opt,name=tombstone_fallback_validator,json=tombstoneFallbackValidator,proto3" json:"tombstone_fallback_validator,omitempty" yaml:"tombstone_fallback_validator"`
+	// tokenize_share_record_voting_enabled allows tokenize share record owners
+	// to vote on governance proposals with the delegation of the record's module
+	// account, overriding the vote of the validator
+	TokenizeShareRecordVotingEnabled bool `protobuf:"varint,12,opt,name=tokenize_share_record_voting_enabled,json=tokenizeShareRecordVotingEnabled,proto3" json:"tokenize_share_record_voting_enabled,omitempty" yaml:"tokenize_share_record_voting_enabled"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -922,6 +949,27 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetMinValidatorBondGracePeriod() uint64 {
+	if m != nil {
+		return m.MinValidatorBondGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetTombstoneFallbackValidator() string {
+	if m != nil {
+		return m.TombstoneFallbackValidator
+	}
+	return ""
+}
+
+func (m *Params) GetTokenizeShareRecordVotingEnabled() bool {
+	if m != nil {
+		return m.TokenizeShareRecordVotingEnabled
+	}
+	return false
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1124,6 +1172,10 @@ type TokenizeShareRecord struct {
 	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
 	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	// denom is the denom of the record's share tokens. It is fixed at
+	// creation and keeps naming the original validator after the record's
+	// delegation is redelegated.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
@@ -1187,6 +1239,143 @@ func (m *TokenizeShareRecord) GetValidator() string {
 	return ""
 }
 
+func (m *TokenizeShareRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ValidatorBondAccount is an account that a validator operator has allowed to
+// mark its delegations to the validator as validator bond.
+type ValidatorBondAccount struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	AccountAddress   string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+}
+
+func (m *ValidatorBondAccount) Reset()         { *m = ValidatorBondAccount{} }
+func (m *ValidatorBondAccount) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBondAccount) ProtoMessage()    {}
+func (*ValidatorBondAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{21}
+}
+func (m *ValidatorBondAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBondAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBondAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBondAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBondAccount.Merge(m, src)
+}
+func (m *ValidatorBondAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBondAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBondAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBondAccount proto.InternalMessageInfo
+
+// ShareOffer is an offer to sell share tokens and/or a tokenize share record
+// for an asking price. The offered assets are held in escrow until the offer
+// is accepted, cancelled or expires.
+type ShareOffer struct {
+	Id          uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller      string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	ShareTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=share_tokens,json=shareTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"share_tokens"`
+	// tokenize_share_record_id is the id of the offered record, zero if no record is offered
+	TokenizeShareRecordId uint64      `protobuf:"varint,4,opt,name=tokenize_share_record_id,json=tokenizeShareRecordId,proto3" json:"tokenize_share_record_id,omitempty"`
+	Price                 types3.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	Expiration            time.Time   `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ShareOffer) Reset()         { *m = ShareOffer{} }
+func (m *ShareOffer) String() string { return proto.CompactTextString(m) }
+func (*ShareOffer) ProtoMessage()    {}
+func (*ShareOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{22}
+}
+func (m *ShareOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareOffer.Merge(m, src)
+}
+func (m *ShareOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareOffer proto.InternalMessageInfo
+
+// ValidatorLiquidStakingPauseProposal details a proposal to pause or unpause
+// liquid staking to a validator. While paused, shares delegated to the
+// validator cannot be tokenized, but existing share tokens can be redeemed.
+type ValidatorLiquidStakingPauseProposal struct {
+	Title            string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// paused is true to pause liquid staking to the validator and false to
+	// unpause it.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *ValidatorLiquidStakingPauseProposal) Reset()      { *m = ValidatorLiquidStakingPauseProposal{} }
+func (*ValidatorLiquidStakingPauseProposal) ProtoMessage() {}
+func (*ValidatorLiquidStakingPauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{23}
+}
+func (m *ValidatorLiquidStakingPauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiquidStakingPauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiquidStakingPauseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLiquidStakingPauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiquidStakingPauseProposal.Merge(m, src)
+}
+func (m *ValidatorLiquidStakingPauseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiquidStakingPauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiquidStakingPauseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiquidStakingPauseProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("liquidstaking.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "liquidstaking.staking.v1beta1.HistoricalInfo")
@@ -1210,128 +1399,161 @@ func init() {
 	proto.RegisterType((*RedelegationResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "liquidstaking.staking.v1beta1.Pool")
 	proto.RegisterType((*TokenizeShareRecord)(nil), "liquidstaking.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ValidatorBondAccount)(nil), "liquidstaking.staking.v1beta1.ValidatorBondAccount")
+	proto.RegisterType((*ShareOffer)(nil), "liquidstaking.staking.v1beta1.ShareOffer")
+	proto.RegisterType((*ValidatorLiquidStakingPauseProposal)(nil), "liquidstaking.staking.v1beta1.ValidatorLiquidStakingPauseProposal")
 }
 
 func init() { proto.RegisterFile("staking/v1beta1/staking.proto", fileDescriptor_76a7656dabf68054) }
 
 var fileDescriptor_76a7656dabf68054 = []byte{
-	// 1845 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x5b, 0x59,
-	0x15, 0xf7, 0x73, 0x5c, 0xc7, 0x3e, 0x4e, 0xec, 0xe4, 0x26, 0x03, 0xae, 0x69, 0x63, 0xcb, 0x52,
-	0x87, 0x74, 0x20, 0x36, 0x13, 0xa4, 0x01, 0x2a, 0x24, 0x14, 0xc7, 0x29, 0x0d, 0xed, 0x74, 0xcc,
-	0xcb, 0xc7, 0x30, 0xc3, 0xc2, 0xba, 0x7e, 0xef, 0xd6, 0xb9, 0xe4, 0xf9, 0x3d, 0xcf, 0xbb, 0xd7,
-	0x9d, 0x98, 0x0f, 0x09, 0x81, 0x84, 0x46, 0x59, 0x75, 0x39, 0x9b, 0x4a, 0x95, 0x80, 0x0d, 0x9a,
-	0xe5, 0x88, 0x3f, 0x80, 0xd5, 0x08, 0x09, 0xa9, 0xcc, 0x0a, 0x18, 0x14, 0x46, 0xed, 0x06, 0xb1,
-	0x42, 0x6c, 0x58, 0x21, 0xa1, 0xfb, 0xf1, 0x3e, 0x62, 0x87, 0x3a, 0xae, 0x82, 0x34, 0x52, 0x37,
-	0x89, 0xef, 0xc7, 0xf9, 0xdd, 0x7b, 0x7e, 0xe7, 0x77, 0xce, 0xbd, 0xf7, 0xc1, 0x55, 0xc6, 0xf1,
-	0x21, 0x75, 0xbb, 0xf5, 0xfb, 0xaf, 0x76, 0x08, 0xc7, 0xaf, 0xd6, 0x75, 0xbb, 0xd6, 0xf7, 0x3d,
-	0xee, 0xa1, 0xab, 0x0e, 0x7d, 0x67, 0x40, 0xed, 0xa0, 0x33, 0xf8, 0xaf, 0x27, 0x97, 0x96, 0xbb,
-	0x5e, 0xd7, 0x93, 0x33, 0xeb, 0xe2, 0x97, 0x32, 0x2a, 0x5d, 0xee, 0x7a, 0x5e, 0xd7, 0x21, 0x75,
-	0xd9, 0xea, 0x0c, 0xee, 0xd5, 0xb1, 0x3b, 0xd4, 0x43, 0x2b, 0xa3, 0x43, 0xf6, 0xc0, 0xc7, 0x9c,
-	0x7a, 0xae, 0x1e, 0x2f, 0x8f, 0x8e, 0x73, 0xda, 0x23, 0x8c, 0xe3, 0x5e, 0x3f, 0xc0, 0xb6, 0x3c,
-	0xd6, 0xf3, 0x58, 0x5b, 0x2d, 0xaa, 0x1a, 0x01, 0xb6, 0x6a, 0xd5, 0x3b, 0x98, 0x91, 0xd0, 0x1d,
-	0xcb, 0xa3, 0x01, 0xf6, 0x15, 0x4e, 0x5c, 0x9b, 0xf8, 0x3d, 0xea, 0xf2, 0x3a, 0x1f, 0xf6, 0x09,
-	0x53, 0x7f, 0xd5, 0x68, 0xf5, 0x81, 0x01, 0xf9, 0x5b, 0x94, 0x71, 0xcf, 0xa7, 0x16, 0x76, 0xb6,
-	0xdd, 0x7b, 0x1e, 0x7a, 0x0d, 0xd2, 0x07, 0x04, 0xdb, 0xc4, 0x2f, 0x1a, 0x15, 0x63, 0x35, 0xb7,
-	0x5e, 0xac, 0x45, 0x08, 0x35, 0x65, 0x7b, 0x4b, 0x8e, 0x37, 0x52, 0x1f, 0x9d, 0x94, 0x13, 0xa6,
-	0x9e, 0x8d, 0x6e, 0x42, 0xfa, 0x3e, 0x76, 0x18, 0xe1, 0xc5, 0x64, 0x65, 0x66, 0x35, 0xb7, 0xbe,
-	0x5a, 0x7b, 0x26, 0x8b, 0xb5, 0x7d, 0xec, 0x50, 0x1b, 0x73, 0x2f, 0xc4, 0x51, 0xd6, 0xd5, 0x0f,
-	0x92, 0x50, 0xd8, 0xf4, 0x7a, 0x3d, 0xca, 0x18, 0xf5, 0x5c, 0x13, 0x73, 0xc2, 0x50, 0x0b, 0x52,
-	0x3e, 0xe6, 0x44, 0xee, 0x28, 0xdb, 0xf8, 0xa6, 0x98, 0xff, 0x97, 0x93, 0xf2, 0xcb, 0x5d, 0xca,
-	0x0f, 0x06, 0x9d, 0x9a, 0xe5, 0xf5, 0x34, 0x27, 0xfa, 0xdf, 0x1a, 0xb3, 0x0f, 0xb5, 0x9b, 0x4d,
-	0x62, 0x7d, 0xfc, 0xe1, 0x1a, 0x68, 0xca, 0x9a, 0xc4, 0x32, 0x25, 0x12, 0x7a, 0x13, 0x32, 0x3d,
-	0x7c, 0xd4, 0x96, 0xa8, 0xc9, 0x0b, 0x40, 0x9d, 0xed, 0xe1, 0x23, 0xb1, 0x57, 0x64, 0x43, 0x41,
-	0x00, 0x5b, 0x07, 0xd8, 0xed, 0x12, 0x85, 0x3f, 0x73, 0x01, 0xf8, 0xf3, 0x3d, 0x7c, 0xb4, 0x29,
-	0x31, 0xc5, 0x2a, 0x37, 0x32, 0xef, 0x3f, 0x2a, 0x27, 0xfe, 0xfe, 0xa8, 0x6c, 0x54, 0x7f, 0x67,
-	0x00, 0x44, 0x74, 0x21, 0x0b, 0x16, 0xac, 0xb0, 0x25, 0x97, 0x67, 0x3a, 0x8e, 0xb5, 0x09, 0xf1,
-	0x18, 0xe1, 0xbc, 0x91, 0x11, 0xfb, 0x7d, 0x7c, 0x52, 0x36, 0xcc, 0x82, 0x35, 0x12, 0x8e, 0x2d,
-	0xc8, 0x0d, 0xfa, 0x36, 0xe6, 0xa4, 0x2d, 0x84, 0x2a, 0xf9, 0xcb, 0xad, 0x97, 0x6a, 0x4a, 0xc5,
-	0xb5, 0x40, 0xc5, 0xb5, 0xdd, 0x40, 0xc5, 0x0a, 0xeb, 0xc1, 0xdf, 0xca, 0x86, 0x09, 0xca, 0x50,
-	0x0c, 0xc5, 0x9c, 0xf8, 0xc0, 0x80, 0x5c, 0x93, 0x30, 0xcb, 0xa7, 0x7d, 0x91, 0x16, 0xa8, 0x08,
-	0xb3, 0x3d, 0xcf, 0xa5, 0x87, 0x5a, 0x84, 0x59, 0x33, 0x68, 0xa2, 0x12, 0x64, 0xa8, 0x4d, 0x5c,
-	0x4e, 0xf9, 0x50, 0xc5, 0xcd, 0x0c, 0xdb, 0xc2, 0xea, 0x5d, 0xd2, 0x61, 0x34, 0xa0, 0xdc, 0x0c,
-	0x9a, 0xe8, 0x3a, 0x2c, 0x30, 0x62, 0x0d, 0x7c, 0xca, 0x87, 0x6d, 0xcb, 0x73, 0x39, 0xb6, 0x78,
-	0x31, 0x25, 0xa7, 0x14, 0x82, 0xfe, 0x4d, 0xd5, 0x2d, 0x40, 0x6c, 0xc2, 0x31, 0x75, 0x58, 0xf1,
-	0x92, 0x02, 0xd1, 0xcd, 0xd8, 0x76, 0x3f, 0x99, 0x85, 0x6c, 0x28, 0x5f, 0xb4, 0x09, 0x0b, 0x5e,
-	0x9f, 0xf8, 0xe2, 0x77, 0x1b, 0xdb, 0xb6, 0x4f, 0x18, 0xd3, 0x42, 0x2d, 0x7e, 0xfc, 0xe1, 0xda,
-	0xb2, 0x0e, 0xe2, 0x86, 0x1a, 0xd9, 0xe1, 0x3e, 0x75, 0xbb, 0x66, 0x21, 0xb0, 0xd0, 0xdd, 0xe8,
-	0x2d, 0x11, 0x37, 0x97, 0x11, 0x97, 0x0d, 0x58, 0xbb, 0x3f, 0xe8, 0x1c, 0x92, 0xa1, 0xe6, 0x75,
-	0x79, 0x8c, 0xd7, 0x0d, 0x77, 0xd8, 0x28, 0xfe, 0x3e, 0x82, 0xb6, 0xfc, 0x61, 0x9f, 0x7b, 0xb5,
-	0xd6, 0xa0, 0x73, 0x9b, 0x0c, 0x45, 0xb4, 0x34, 0x4e, 0x4b, 0xc2, 0xa0, 0xcf, 0x41, 0xfa, 0x07,
-	0x98, 0x3a, 0xc4, 0x96, 0xac, 0x64, 0x4c, 0xdd, 0x42, 0x1b, 0x90, 0x66, 0x1c, 0xf3, 0x01, 0x93,
-	0x54, 0xe4, 0xd7, 0xaf, 0x4f, 0x10, 0x48, 0xc3, 0x73, 0xed, 0x1d, 0x69, 0x60, 0x6a, 0x43, 0xb4,
-	0x0b, 0x69, 0xee, 0x1d, 0x12, 0x57, 0x73, 0x35, 0x95, 0xc6, 0xb7, 0x5d, 0x1e, 0xd3, 0xf8, 0xb6,
-	0xcb, 0x4d, 0x8d, 0x85, 0xba, 0xb0, 0x60, 0x13, 0x87, 0x74, 0x25, 0xa3, 0xec, 0x00, 0xfb, 0x84,
-	0x15, 0xd3, 0x17, 0x90, 0x43, 0x85, 0x10, 0x75, 0x47, 0x82, 0x22, 0x13, 0x72, 0x76, 0xa4, 0xba,
-	0xe2, 0xac, 0xe4, 0xfb, 0x95, 0x09, 0x34, 0xc4, 0x74, 0xaa, 0x2b, 0x57, 0x1c, 0x44, 0x48, 0x6d,
-	0xe0, 0x76, 0x3c, 0xd7, 0xa6, 0x6e, 0xb7, 0x7d, 0x40, 0x68, 0xf7, 0x80, 0x17, 0x33, 0x15, 0x63,
-	0x75, 0xc6, 0x2c, 0x84, 0xfd, 0xb7, 0x64, 0x37, 0xba, 0x0d, 0xf9, 0x68, 0xaa, 0xcc, 0xa4, 0xec,
-	0x14, 0x99, 0x34, 0x1f, 0xda, 0x8a, 0x51, 0xf4, 0x06, 0x40, 0x94, 0xa6, 0x45, 0x90, 0x40, 0xd7,
-	0xcf, 0x9d, 0xf2, 0xda, 0x93, 0x18, 0x04, 0xfa, 0x11, 0x7c, 0x81, 0x7b, 0x1c, 0x3b, 0xed, 0xfb,
-	0x81, 0xd2, 0xdb, 0x62, 0xbd, 0x20, 0x20, 0xb9, 0x0b, 0x08, 0x48, 0x51, 0x2e, 0x10, 0x1d, 0x04,
-	0x42, 0x60, 0x2a, 0x32, 0x0e, 0x2c, 0xa9, 0xc5, 0x95, 0x03, 0xc1, 0xa2, 0x73, 0x17, 0xb0, 0xe8,
-	0xa2, 0x04, 0xbe, 0x23, 0x71, 0xd5, 0x6a, 0x37, 0xe6, 0xde, 0x7b, 0x54, 0x4e, 0xe8, 0xec, 0x4e,
-	0x54, 0x5b, 0x30, 0xb7, 0x8f, 0x1d, 0x9d, 0x98, 0x84, 0xa1, 0xd7, 0x20, 0x8b, 0x83, 0x46, 0xd1,
-	0xa8, 0xcc, 0x3c, 0x33, 0xb1, 0xa3, 0xa9, 0xaa, 0x5e, 0xfc, 0xf4, 0xaf, 0x15, 0xa3, 0xfa, 0x2b,
-	0x03, 0xd2, 0xcd, 0xfd, 0x16, 0xa6, 0x3e, 0xda, 0x82, 0xc5, 0x48, 0xdb, 0xe7, 0xad, 0x16, 0x51,
-	0x3a, 0x04, 0xe5, 0x62, 0x0b, 0x16, 0xa3, 0xb0, 0x04, 0x30, 0xc9, 0x49, 0x30, 0xa1, 0x89, 0xee,
-	0x1f, 0x71, 0xfc, 0x0e, 0xcc, 0xaa, 0x5d, 0x32, 0xb4, 0x01, 0x97, 0xfa, 0xe2, 0x87, 0xf4, 0x37,
-	0xb7, 0x7e, 0x6d, 0x52, 0x4e, 0x48, 0x33, 0x2d, 0x22, 0x65, 0x59, 0xfd, 0x8f, 0x01, 0xd0, 0xdc,
-	0xdf, 0xdf, 0xf5, 0x69, 0xdf, 0x21, 0xfc, 0xa2, 0x1c, 0xbf, 0x03, 0x2f, 0x45, 0x8e, 0x33, 0xdf,
-	0x3a, 0xb7, 0xf3, 0x4b, 0xa1, 0xd9, 0x8e, 0x6f, 0x9d, 0x89, 0x66, 0x33, 0x1e, 0xa2, 0xcd, 0x9c,
-	0x1b, 0xad, 0xc9, 0xf8, 0xd9, 0x6c, 0xbe, 0x0d, 0xb9, 0xc8, 0x7d, 0x86, 0x6e, 0x43, 0x86, 0xeb,
-	0xdf, 0x9a, 0xd4, 0xeb, 0x13, 0x49, 0x0d, 0xac, 0x35, 0xb1, 0x21, 0x40, 0xf5, 0xd7, 0x49, 0x80,
-	0xa6, 0xa2, 0x46, 0xa4, 0xea, 0x67, 0x4a, 0x54, 0xe2, 0x50, 0xd0, 0xe9, 0x7a, 0x11, 0x17, 0x1f,
-	0x8d, 0x85, 0xae, 0x41, 0xfe, 0x74, 0x21, 0x92, 0xa7, 0x56, 0xc6, 0x9c, 0xbf, 0x1f, 0x2f, 0x1f,
-	0x23, 0x31, 0x38, 0x4e, 0xc2, 0xd2, 0x5e, 0x50, 0x26, 0x3f, 0xb3, 0x84, 0xbd, 0x09, 0xb3, 0xc4,
-	0xe5, 0x3e, 0x95, 0x8c, 0x09, 0x65, 0x7c, 0x6d, 0x82, 0x32, 0xce, 0x70, 0x69, 0xcb, 0xe5, 0xfe,
-	0x50, 0xeb, 0x24, 0x40, 0x1b, 0x21, 0xe3, 0x93, 0x24, 0x14, 0xff, 0x97, 0x25, 0xfa, 0x22, 0x14,
-	0x2c, 0x9f, 0xc8, 0x8e, 0xe0, 0xd4, 0x32, 0xe4, 0xa9, 0x95, 0x0f, 0xba, 0xf5, 0xa1, 0xf5, 0x3a,
-	0x88, 0xeb, 0xa0, 0x90, 0xa1, 0x98, 0x3a, 0xf5, 0xfd, 0x2f, 0x1f, 0x19, 0xcb, 0x63, 0x8b, 0x40,
-	0x81, 0xba, 0x94, 0x53, 0xec, 0xb4, 0x3b, 0xd8, 0xc1, 0xae, 0xf5, 0x3c, 0xd7, 0xe5, 0xf1, 0xab,
-	0x44, 0x5e, 0x83, 0x36, 0x14, 0x26, 0xda, 0x87, 0xd9, 0x00, 0x3e, 0x75, 0x01, 0xf0, 0x01, 0x58,
-	0xec, 0x4e, 0xf8, 0xe7, 0x24, 0x2c, 0x9a, 0xc4, 0x7e, 0xb1, 0x68, 0xfd, 0x3e, 0x80, 0x4a, 0x4f,
-	0x51, 0x3c, 0x9f, 0x83, 0xd9, 0xf1, 0x74, 0xcf, 0x2a, 0xbc, 0x26, 0xe3, 0x31, 0x6e, 0xff, 0x98,
-	0x84, 0xb9, 0x38, 0xb7, 0x2f, 0xc0, 0x61, 0x82, 0x5a, 0x51, 0x51, 0x48, 0xc9, 0xa2, 0xf0, 0x95,
-	0x09, 0x45, 0x61, 0x4c, 0x7c, 0xcf, 0xae, 0x06, 0xff, 0x9e, 0x81, 0x74, 0x0b, 0xfb, 0xb8, 0xc7,
-	0xd0, 0x77, 0xc6, 0xee, 0xa1, 0xea, 0xc5, 0x78, 0x79, 0x4c, 0x7a, 0x4d, 0xfd, 0xdd, 0x42, 0x29,
-	0xef, 0xfd, 0x33, 0xae, 0xa1, 0xd7, 0x20, 0x2f, 0x9e, 0xbf, 0xa1, 0x47, 0x8a, 0xcb, 0x79, 0xf9,
-	0x7e, 0x0d, 0x2f, 0x7a, 0x0c, 0x95, 0x21, 0x27, 0xa6, 0x45, 0x65, 0x4f, 0xcc, 0x81, 0x1e, 0x3e,
-	0xda, 0x52, 0x3d, 0x68, 0x0d, 0xd0, 0x41, 0xf8, 0x5d, 0xa2, 0x1d, 0x31, 0x21, 0xe6, 0x2d, 0x46,
-	0x23, 0xc1, 0xf4, 0xab, 0x00, 0xf2, 0x72, 0x6a, 0x13, 0xd7, 0xeb, 0xe9, 0x87, 0x5b, 0x56, 0xf4,
-	0x34, 0x45, 0x07, 0xfa, 0x31, 0x2c, 0xf5, 0xa8, 0xdb, 0x1e, 0x79, 0x19, 0xeb, 0x47, 0xc5, 0x9d,
-	0xe9, 0x04, 0xfb, 0xaf, 0x93, 0x72, 0x69, 0x88, 0x7b, 0xce, 0x8d, 0xea, 0x19, 0x90, 0x55, 0x73,
-	0xb1, 0x47, 0xdd, 0xd3, 0x4f, 0x69, 0xf4, 0x33, 0x23, 0xae, 0x0c, 0xb9, 0xcf, 0x7b, 0xd8, 0xe2,
-	0x9e, 0x2f, 0x5f, 0x1c, 0xd9, 0xc6, 0xdd, 0xa9, 0x37, 0x70, 0x45, 0x6d, 0xe0, 0x4c, 0xd0, 0x6a,
-	0x4c, 0x4f, 0xe2, 0x48, 0xbc, 0x29, 0x7b, 0x63, 0xd9, 0xf4, 0x1b, 0x03, 0x50, 0x54, 0xfe, 0x4d,
-	0xc2, 0xfa, 0xe2, 0xc1, 0x28, 0x1e, 0x10, 0x91, 0x80, 0xb4, 0x02, 0x26, 0x5e, 0x51, 0x42, 0x83,
-	0xe0, 0x01, 0x11, 0x4b, 0xd2, 0x6f, 0x44, 0x35, 0x37, 0xa9, 0xf5, 0xa4, 0xe5, 0xdf, 0xc1, 0x8c,
-	0xc4, 0x1e, 0x21, 0x34, 0xb0, 0x1e, 0x2b, 0xab, 0x89, 0xea, 0xa7, 0x06, 0x5c, 0x1e, 0x53, 0x76,
-	0xb8, 0x67, 0x02, 0xc8, 0x8f, 0x0d, 0x4a, 0x9d, 0x0c, 0xf5, 0xde, 0x9f, 0x37, 0x5f, 0x16, 0xfd,
-	0xb1, 0x2a, 0xfe, 0xff, 0x3a, 0x3d, 0x52, 0x32, 0x1e, 0x7f, 0x30, 0x60, 0x39, 0xbe, 0x99, 0xd0,
-	0xbb, 0x3d, 0x98, 0x8b, 0xef, 0x45, 0xfb, 0xf5, 0xa5, 0x29, 0xfc, 0xd2, 0x2e, 0x9d, 0x82, 0x41,
-	0xdf, 0x8b, 0x2a, 0x8b, 0xfa, 0x52, 0xf7, 0xf5, 0x69, 0x99, 0x0a, 0x76, 0x38, 0x5a, 0x61, 0x52,
-	0x32, 0x64, 0x3f, 0x4f, 0x42, 0xaa, 0xe5, 0x79, 0x0e, 0xfa, 0x09, 0x2c, 0xba, 0x1e, 0x97, 0xda,
-	0x24, 0x76, 0x5b, 0x7f, 0x28, 0x50, 0x55, 0xfa, 0xbb, 0xd3, 0x11, 0xf8, 0x8f, 0x93, 0xf2, 0x38,
-	0xd4, 0x08, 0xab, 0x05, 0xd7, 0xe3, 0x0d, 0x39, 0xbe, 0xab, 0x3e, 0x23, 0xf8, 0x30, 0x7f, 0x7a,
-	0x69, 0x55, 0xd5, 0x5f, 0x9f, 0x7a, 0xe9, 0xf9, 0x67, 0x2d, 0x3b, 0xd7, 0x89, 0xad, 0x79, 0x23,
-	0x23, 0x22, 0xfa, 0x4f, 0x11, 0xd5, 0x5f, 0x18, 0xb0, 0x24, 0x3b, 0xe9, 0x0f, 0x89, 0x7c, 0x66,
-	0x9a, 0xc4, 0xf2, 0x7c, 0x1b, 0xe5, 0x21, 0x49, 0x6d, 0xc9, 0x42, 0xca, 0x4c, 0x52, 0x1b, 0x2d,
-	0xc3, 0x25, 0xef, 0x5d, 0x97, 0xf8, 0xfa, 0x6b, 0x96, 0x6a, 0xc8, 0x32, 0xea, 0xd9, 0x03, 0x87,
-	0xb4, 0xb1, 0x65, 0x79, 0x03, 0x97, 0xeb, 0x2f, 0x5a, 0xf3, 0xaa, 0x77, 0x43, 0x75, 0xa2, 0x2b,
-	0x90, 0x0d, 0x73, 0x5d, 0x7f, 0xd0, 0x8a, 0x3a, 0x94, 0xbc, 0x5e, 0xf9, 0xad, 0x01, 0x10, 0x7d,
-	0xba, 0x41, 0x5f, 0x86, 0xcf, 0x37, 0xde, 0xb8, 0xdb, 0x6c, 0xef, 0xec, 0x6e, 0xec, 0xee, 0xed,
-	0xb4, 0xf7, 0xee, 0xee, 0xb4, 0xb6, 0x36, 0xb7, 0x6f, 0x6e, 0x6f, 0x35, 0x17, 0x12, 0xa5, 0xc2,
-	0xf1, 0xc3, 0x4a, 0x6e, 0xcf, 0x65, 0x7d, 0x62, 0xd1, 0x7b, 0x94, 0xd8, 0xe8, 0x65, 0x58, 0x3e,
-	0x3d, 0x5b, 0xb4, 0xb6, 0x9a, 0x0b, 0x46, 0x69, 0xee, 0xf8, 0x61, 0x25, 0xa3, 0xae, 0x93, 0xc4,
-	0x46, 0xab, 0xf0, 0xd2, 0xf8, 0xbc, 0xed, 0xbb, 0xdf, 0x5e, 0x48, 0x96, 0xe6, 0x8f, 0x1f, 0x56,
-	0xb2, 0xe1, 0xbd, 0x13, 0x55, 0x01, 0xc5, 0x67, 0x6a, 0xbc, 0x99, 0x12, 0x1c, 0x3f, 0xac, 0xa4,
-	0x55, 0xfc, 0x4a, 0xa9, 0xf7, 0x7e, 0xb9, 0x92, 0x68, 0xbc, 0xf5, 0xd1, 0x93, 0x15, 0xe3, 0xf1,
-	0x93, 0x15, 0xe3, 0xd3, 0x27, 0x2b, 0xc6, 0x83, 0xa7, 0x2b, 0x89, 0xc7, 0x4f, 0x57, 0x12, 0x7f,
-	0x7a, 0xba, 0x92, 0x78, 0xfb, 0x5b, 0xb1, 0xd0, 0xd1, 0x77, 0x9c, 0x81, 0xa8, 0xb4, 0xd4, 0xb5,
-	0xea, 0x4a, 0xc6, 0x94, 0x0f, 0xd7, 0xb4, 0x84, 0xd7, 0x14, 0x5d, 0xf5, 0xa3, 0xe0, 0x03, 0xbf,
-	0x8a, 0x6b, 0x27, 0x2d, 0x4f, 0xb4, 0xaf, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x21, 0x19,
-	0x99, 0x08, 0x18, 0x00, 0x00,
+	// 2326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6f, 0x5b, 0xc7,
+	0x15, 0xd6, 0xa5, 0x68, 0x8a, 0x3c, 0x94, 0x44, 0x69, 0xa4, 0xb8, 0x34, 0xe3, 0x88, 0x02, 0xd3,
+	0x38, 0xb2, 0x13, 0x49, 0x8e, 0x8b, 0x26, 0xad, 0x51, 0x20, 0x10, 0x4d, 0x39, 0x56, 0xfd, 0x62,
+	0xaf, 0x64, 0xe5, 0xd1, 0x05, 0x71, 0x79, 0xef, 0x88, 0x9a, 0xea, 0x3e, 0x98, 0x3b, 0x43, 0x45,
+	0xec, 0x03, 0x7d, 0x6d, 0x0c, 0x77, 0xe3, 0xa5, 0x0b, 0xd4, 0xa8, 0x81, 0xb6, 0x1b, 0xa3, 0x4b,
+	0xa3, 0x3f, 0xa0, 0x2b, 0x23, 0x40, 0x01, 0x37, 0xab, 0xbe, 0xa0, 0x04, 0xf6, 0x26, 0xe8, 0xaa,
+	0xf0, 0xa2, 0xbb, 0x16, 0xc5, 0x3c, 0xee, 0x83, 0x0f, 0x8b, 0xa2, 0xa1, 0x02, 0x01, 0xb2, 0x91,
+	0xee, 0xcc, 0x9c, 0xf3, 0xcd, 0xcc, 0x99, 0x73, 0xbe, 0x39, 0x67, 0x08, 0x2f, 0x51, 0x66, 0xec,
+	0x10, 0xb7, 0xb1, 0xbc, 0xfb, 0x46, 0x1d, 0x33, 0xe3, 0x8d, 0x65, 0xd5, 0x5e, 0x6a, 0xfa, 0x1e,
+	0xf3, 0xd0, 0x4b, 0x36, 0xf9, 0xb0, 0x45, 0xac, 0xa0, 0x33, 0xf8, 0xaf, 0x84, 0x0b, 0xb3, 0x0d,
+	0xaf, 0xe1, 0x09, 0xc9, 0x65, 0xfe, 0x25, 0x95, 0x0a, 0x27, 0x1a, 0x9e, 0xd7, 0xb0, 0xf1, 0xb2,
+	0x68, 0xd5, 0x5b, 0x5b, 0xcb, 0x86, 0xdb, 0x56, 0x43, 0x73, 0xdd, 0x43, 0x56, 0xcb, 0x37, 0x18,
+	0xf1, 0x5c, 0x35, 0x5e, 0xec, 0x1e, 0x67, 0xc4, 0xc1, 0x94, 0x19, 0x4e, 0x33, 0xc0, 0x36, 0x3d,
+	0xea, 0x78, 0xb4, 0x26, 0x27, 0x95, 0x8d, 0x00, 0x5b, 0xb6, 0x96, 0xeb, 0x06, 0xc5, 0xe1, 0x76,
+	0x4c, 0x8f, 0x04, 0xd8, 0x27, 0x19, 0x76, 0x2d, 0xec, 0x3b, 0xc4, 0x65, 0xcb, 0xac, 0xdd, 0xc4,
+	0x54, 0xfe, 0x95, 0xa3, 0xa5, 0xdb, 0x1a, 0x4c, 0x5e, 0x22, 0x94, 0x79, 0x3e, 0x31, 0x0d, 0x7b,
+	0xcd, 0xdd, 0xf2, 0xd0, 0x9b, 0x90, 0xda, 0xc6, 0x86, 0x85, 0xfd, 0xbc, 0x36, 0xaf, 0x2d, 0x64,
+	0xcf, 0xe5, 0x97, 0x22, 0x84, 0x25, 0xa9, 0x7b, 0x49, 0x8c, 0x97, 0x93, 0x0f, 0xf7, 0x8b, 0x23,
+	0xba, 0x92, 0x46, 0x17, 0x21, 0xb5, 0x6b, 0xd8, 0x14, 0xb3, 0x7c, 0x62, 0x7e, 0x74, 0x21, 0x7b,
+	0x6e, 0x61, 0xe9, 0x40, 0x2b, 0x2e, 0x6d, 0x1a, 0x36, 0xb1, 0x0c, 0xe6, 0x85, 0x38, 0x52, 0xbb,
+	0xf4, 0x70, 0x14, 0x72, 0x17, 0x3c, 0xc7, 0x21, 0x94, 0x12, 0xcf, 0xd5, 0x0d, 0x86, 0x29, 0xaa,
+	0x42, 0xd2, 0x37, 0x18, 0x16, 0x2b, 0xca, 0x94, 0xbf, 0xc5, 0xe5, 0xff, 0xb6, 0x5f, 0x3c, 0xd5,
+	0x20, 0x6c, 0xbb, 0x55, 0x5f, 0x32, 0x3d, 0x47, 0xd9, 0x44, 0xfd, 0x5b, 0xa4, 0xd6, 0x8e, 0xda,
+	0x66, 0x05, 0x9b, 0x9f, 0x3c, 0x58, 0x04, 0x65, 0xb2, 0x0a, 0x36, 0x75, 0x81, 0x84, 0xde, 0x85,
+	0xb4, 0x63, 0xec, 0xd5, 0x04, 0x6a, 0xe2, 0x08, 0x50, 0xc7, 0x1c, 0x63, 0x8f, 0xaf, 0x15, 0x59,
+	0x90, 0xe3, 0xc0, 0xe6, 0xb6, 0xe1, 0x36, 0xb0, 0xc4, 0x1f, 0x3d, 0x02, 0xfc, 0x09, 0xc7, 0xd8,
+	0xbb, 0x20, 0x30, 0xc5, 0x2c, 0xbf, 0xd4, 0xe0, 0xb8, 0x34, 0x6f, 0xcd, 0x0c, 0x6d, 0x25, 0x67,
+	0x4b, 0x8a, 0xd9, 0xcc, 0xc3, 0xcf, 0xf4, 0x74, 0xbf, 0xf8, 0x6a, 0xdb, 0x70, 0xec, 0xf3, 0xa5,
+	0xfe, 0x88, 0xaf, 0x7b, 0x0e, 0x61, 0xd8, 0x69, 0xb2, 0x76, 0xa9, 0x6b, 0x51, 0xb3, 0x52, 0xa1,
+	0xf3, 0xb4, 0xce, 0xa7, 0xef, 0xdc, 0x2b, 0x8e, 0x7c, 0x7e, 0xaf, 0xa8, 0x95, 0xfe, 0xa8, 0x01,
+	0x44, 0x83, 0xc8, 0x84, 0xa9, 0x2e, 0x68, 0xaa, 0x7c, 0x6c, 0x69, 0x80, 0xaf, 0x74, 0xf9, 0x43,
+	0x39, 0xcd, 0x6d, 0xf9, 0x68, 0xbf, 0xa8, 0xe9, 0x39, 0xb3, 0xcb, 0x55, 0x56, 0x21, 0xdb, 0x6a,
+	0x5a, 0x06, 0xc3, 0x35, 0x1e, 0x44, 0xe2, 0x6c, 0xb3, 0xe7, 0x0a, 0x4b, 0x32, 0xc2, 0x96, 0x82,
+	0x08, 0x5b, 0xda, 0x08, 0x22, 0x4c, 0x62, 0xdd, 0xfe, 0xb4, 0xa8, 0xe9, 0x20, 0x15, 0xf9, 0x50,
+	0x6c, 0x13, 0xbf, 0xd7, 0x20, 0x5b, 0xc1, 0xd4, 0xf4, 0x49, 0x93, 0x87, 0x2c, 0xca, 0xc3, 0x98,
+	0xe3, 0xb9, 0x64, 0x47, 0x05, 0x48, 0x46, 0x0f, 0x9a, 0xa8, 0x00, 0x69, 0x62, 0x61, 0x97, 0x11,
+	0xd6, 0x96, 0x3e, 0xa5, 0x87, 0x6d, 0xae, 0xf5, 0x11, 0xae, 0x53, 0x12, 0xb8, 0x83, 0x1e, 0x34,
+	0xd1, 0x69, 0x98, 0xa2, 0xd8, 0x6c, 0xf9, 0x84, 0xb5, 0x6b, 0xa6, 0xe7, 0x32, 0xc3, 0x64, 0xf2,
+	0x0c, 0xf5, 0x5c, 0xd0, 0x7f, 0x41, 0x76, 0x73, 0x10, 0x0b, 0x33, 0x83, 0xd8, 0x34, 0x7f, 0x4c,
+	0x82, 0xa8, 0x66, 0x6c, 0xb9, 0xff, 0x4e, 0x43, 0x26, 0x0c, 0x2d, 0x74, 0x01, 0xa6, 0xbc, 0x26,
+	0xf6, 0xf9, 0x77, 0xcd, 0xb0, 0x2c, 0x1f, 0x53, 0xaa, 0x82, 0x28, 0xff, 0xc9, 0x83, 0xc5, 0x59,
+	0x75, 0x96, 0x2b, 0x72, 0x64, 0x9d, 0xf9, 0xc4, 0x6d, 0xe8, 0xb9, 0x40, 0x43, 0x75, 0xa3, 0xf7,
+	0xf9, 0xb9, 0xb9, 0x14, 0xbb, 0xb4, 0x45, 0x6b, 0xcd, 0x56, 0x7d, 0x07, 0xb7, 0x95, 0x5d, 0x67,
+	0x7b, 0xec, 0xba, 0xe2, 0xb6, 0xcb, 0xf9, 0x8f, 0x23, 0x68, 0xd3, 0x6f, 0x37, 0x99, 0xb7, 0x54,
+	0x6d, 0xd5, 0x2f, 0xe3, 0xb6, 0x9e, 0x0b, 0x71, 0xaa, 0x02, 0x06, 0x1d, 0x87, 0xd4, 0xf7, 0x0c,
+	0x62, 0x63, 0x4b, 0x58, 0x25, 0xad, 0xab, 0x16, 0x5a, 0x81, 0x14, 0x65, 0x06, 0x6b, 0x51, 0x61,
+	0x8a, 0xc9, 0x73, 0xa7, 0x07, 0x38, 0x48, 0xd9, 0x73, 0xad, 0x75, 0xa1, 0xa0, 0x2b, 0x45, 0xb4,
+	0x01, 0x29, 0xe6, 0xed, 0x60, 0x57, 0xd9, 0x6a, 0xa8, 0xf8, 0x5b, 0x73, 0x59, 0xcc, 0xd5, 0xd7,
+	0x5c, 0xa6, 0x2b, 0x2c, 0xd4, 0x80, 0x29, 0x0b, 0xdb, 0xb8, 0x21, 0x2c, 0x4a, 0xb7, 0x0d, 0x1f,
+	0xd3, 0x7c, 0xea, 0x08, 0xe2, 0x3b, 0x17, 0xa2, 0xae, 0x0b, 0x50, 0xa4, 0x43, 0xd6, 0x8a, 0xbc,
+	0x2e, 0x3f, 0x26, 0xec, 0x7d, 0x66, 0x80, 0x19, 0x62, 0x7e, 0xaa, 0x58, 0x35, 0x0e, 0xc2, 0x5d,
+	0xad, 0xe5, 0xd6, 0x3d, 0xd7, 0x22, 0x6e, 0xa3, 0xb6, 0x8d, 0x49, 0x63, 0x9b, 0xe5, 0xd3, 0xf3,
+	0xda, 0xc2, 0xa8, 0x9e, 0x0b, 0xfb, 0x2f, 0x89, 0x6e, 0x74, 0x19, 0x26, 0x23, 0x51, 0x11, 0x49,
+	0x99, 0x21, 0x22, 0x69, 0x22, 0xd4, 0xe5, 0xa3, 0xe8, 0x3a, 0x40, 0x14, 0xa6, 0x79, 0x10, 0x40,
+	0xa7, 0x0f, 0x1d, 0xf2, 0x6a, 0x27, 0x31, 0x08, 0xf4, 0x03, 0x78, 0x91, 0x79, 0xcc, 0xb0, 0x6b,
+	0xbb, 0x81, 0xa7, 0xd7, 0xf8, 0x7c, 0xc1, 0x81, 0x64, 0x8f, 0xe0, 0x40, 0xf2, 0x62, 0x82, 0xe8,
+	0x92, 0xe2, 0x0e, 0x26, 0x4f, 0xc6, 0x86, 0x19, 0x39, 0xb9, 0xa2, 0x4b, 0x35, 0xe9, 0xf8, 0x11,
+	0x4c, 0x3a, 0x2d, 0x80, 0xaf, 0x08, 0x5c, 0x35, 0xdb, 0x1d, 0x0d, 0x8e, 0xf3, 0x0b, 0x25, 0x3e,
+	0x59, 0x4d, 0x64, 0x0f, 0xf9, 0x89, 0xe7, 0x67, 0xfa, 0xfe, 0x88, 0xcf, 0x66, 0xfa, 0x19, 0xc7,
+	0xd8, 0x8b, 0x2d, 0x4b, 0xe7, 0xd2, 0xe7, 0xc7, 0x6f, 0xde, 0x2b, 0x8e, 0x28, 0xe2, 0x19, 0x29,
+	0x55, 0x61, 0x7c, 0xd3, 0xb0, 0x15, 0x67, 0x60, 0x8a, 0xde, 0x84, 0x8c, 0x11, 0x34, 0xf2, 0xda,
+	0xfc, 0xe8, 0x81, 0x9c, 0x13, 0x89, 0x4a, 0x2a, 0xfb, 0xc9, 0x3f, 0xe6, 0xb5, 0xd2, 0x6f, 0x35,
+	0x48, 0x55, 0x36, 0xab, 0x06, 0xf1, 0xd1, 0x2a, 0x4c, 0x47, 0x61, 0x77, 0x58, 0x22, 0x8b, 0x22,
+	0x55, 0xf5, 0x73, 0x98, 0xc8, 0x63, 0x02, 0x98, 0xc4, 0x20, 0x98, 0x50, 0x45, 0xf5, 0x77, 0x6d,
+	0xfc, 0x0a, 0x8c, 0xc9, 0x55, 0x52, 0xb4, 0x02, 0xc7, 0x9a, 0xfc, 0x43, 0xec, 0x37, 0x7b, 0xee,
+	0x95, 0x41, 0xe1, 0x2a, 0xd4, 0x94, 0x7f, 0x4b, 0xcd, 0xd2, 0x7f, 0x34, 0x80, 0xca, 0xe6, 0xe6,
+	0x86, 0x4f, 0x9a, 0x36, 0x66, 0x47, 0xb5, 0xf1, 0x2b, 0xf0, 0x42, 0xb4, 0x71, 0xea, 0x9b, 0x87,
+	0xde, 0xfc, 0x4c, 0xa8, 0xb6, 0xee, 0x9b, 0x7d, 0xd1, 0x2c, 0xca, 0x42, 0xb4, 0xd1, 0x43, 0xa3,
+	0x55, 0x28, 0xeb, 0x6f, 0xcd, 0x0f, 0x20, 0x1b, 0x6d, 0x9f, 0xa2, 0xcb, 0x90, 0x66, 0xea, 0x5b,
+	0x19, 0xf5, 0xf4, 0x40, 0xa3, 0x06, 0xda, 0xca, 0xb0, 0x21, 0x40, 0xe9, 0x77, 0x09, 0x80, 0x8a,
+	0x34, 0x0d, 0x67, 0x91, 0x2f, 0x94, 0x53, 0xf1, 0xfb, 0x4a, 0x31, 0xc9, 0x51, 0xe4, 0x8b, 0x0a,
+	0x0b, 0xbd, 0x02, 0x93, 0x9d, 0x1c, 0x29, 0x2e, 0xd4, 0xb4, 0x3e, 0xb1, 0x1b, 0x67, 0xb6, 0xae,
+	0x33, 0xb8, 0x95, 0x80, 0x99, 0x1b, 0x01, 0x83, 0x7f, 0x61, 0x0d, 0xf6, 0x2e, 0x8c, 0x61, 0x97,
+	0xf9, 0x44, 0x58, 0x8c, 0x7b, 0xc6, 0x5b, 0x03, 0x3c, 0xa3, 0xcf, 0x96, 0x56, 0x5d, 0xe6, 0xb7,
+	0x95, 0x9f, 0x04, 0x68, 0x5d, 0xc6, 0xf8, 0x7b, 0x02, 0xf2, 0xcf, 0xd2, 0x44, 0xaf, 0x42, 0xce,
+	0xf4, 0xb1, 0xe8, 0x08, 0x2e, 0x54, 0x4d, 0x5c, 0xa8, 0x93, 0x41, 0xb7, 0xba, 0x4f, 0xaf, 0x02,
+	0xcf, 0x54, 0xb9, 0x1b, 0x72, 0xd1, 0xa1, 0x53, 0xd3, 0xc9, 0x48, 0x99, 0x0f, 0x23, 0x0c, 0x39,
+	0xe2, 0x12, 0x46, 0x0c, 0xbb, 0x56, 0x37, 0x6c, 0xc3, 0x35, 0x9f, 0xa7, 0xca, 0xe8, 0xcd, 0x72,
+	0x26, 0x15, 0x68, 0x59, 0x62, 0xa2, 0x4d, 0x18, 0x0b, 0xe0, 0x93, 0x47, 0x00, 0x1f, 0x80, 0xc5,
+	0xd2, 0xd5, 0xbf, 0x26, 0x60, 0x5a, 0xc7, 0xd6, 0x97, 0xcb, 0xac, 0xdf, 0x05, 0x90, 0xe1, 0xc9,
+	0xc9, 0x33, 0x9f, 0x3c, 0x82, 0x70, 0xcf, 0x48, 0xbc, 0x0a, 0x65, 0x31, 0xdb, 0xfe, 0x39, 0x01,
+	0xe3, 0x71, 0xdb, 0x7e, 0x09, 0x2e, 0x13, 0x54, 0x8d, 0x48, 0x21, 0x29, 0x48, 0xe1, 0xec, 0x00,
+	0x52, 0xe8, 0x71, 0xbe, 0x83, 0xd9, 0xe0, 0x17, 0x19, 0x48, 0x55, 0x0d, 0xdf, 0x70, 0x28, 0xfa,
+	0x76, 0x4f, 0x8a, 0x2c, 0x8b, 0xd9, 0x13, 0x3d, 0xae, 0x57, 0x51, 0xcf, 0x3d, 0xd2, 0xf3, 0xee,
+	0xf4, 0xc9, 0x90, 0x5f, 0x81, 0x49, 0x9e, 0x92, 0x85, 0x3b, 0x92, 0xb6, 0x9c, 0x10, 0x65, 0x7f,
+	0x98, 0x83, 0x52, 0x54, 0x84, 0x2c, 0x17, 0x8b, 0x68, 0x8f, 0xcb, 0x80, 0x63, 0xec, 0xad, 0xca,
+	0x1e, 0xb4, 0x08, 0x68, 0x3b, 0x7c, 0xce, 0xa9, 0x45, 0x96, 0xe0, 0x72, 0xd3, 0xd1, 0x48, 0x20,
+	0xfe, 0x12, 0x80, 0xc8, 0x9b, 0x2d, 0xec, 0x7a, 0x8e, 0xaa, 0x29, 0x33, 0xbc, 0xa7, 0xc2, 0x3b,
+	0xd0, 0x0f, 0x61, 0xc6, 0x21, 0x6e, 0xcf, 0x0b, 0x83, 0xac, 0x77, 0xae, 0x0c, 0xe7, 0xb0, 0x4f,
+	0xf7, 0x8b, 0x05, 0x95, 0x7b, 0xf6, 0x42, 0x96, 0xf4, 0x69, 0x87, 0xb8, 0x9d, 0x55, 0x3e, 0xfa,
+	0x99, 0x16, 0xf7, 0x0c, 0xb1, 0xce, 0x2d, 0xc3, 0x64, 0x9e, 0x2f, 0x8a, 0xa1, 0x4c, 0xf9, 0xda,
+	0xd0, 0x0b, 0x38, 0x29, 0x17, 0xd0, 0x17, 0xb4, 0xa4, 0xcf, 0x74, 0x5c, 0x89, 0x17, 0x45, 0x2f,
+	0x6a, 0x03, 0xe2, 0xeb, 0xed, 0xba, 0x43, 0xd3, 0x62, 0x01, 0x97, 0x87, 0x5e, 0xc0, 0x89, 0xc8,
+	0x02, 0x9d, 0x88, 0x25, 0x7d, 0xca, 0x21, 0x6e, 0x47, 0xb5, 0x81, 0x9a, 0x50, 0xec, 0x15, 0xac,
+	0x35, 0x7c, 0xc3, 0xc4, 0xb5, 0x26, 0xf6, 0x89, 0x67, 0x89, 0x9a, 0x2c, 0x59, 0x3e, 0xf3, 0x74,
+	0xbf, 0x78, 0xea, 0x59, 0xc8, 0x1d, 0x0a, 0x25, 0xfd, 0xc5, 0xee, 0x69, 0xde, 0xe1, 0xc3, 0x55,
+	0x31, 0x8a, 0x7e, 0xad, 0x41, 0xb1, 0x4b, 0x9b, 0xda, 0x06, 0xdd, 0xae, 0x39, 0x2d, 0x9b, 0x91,
+	0xa6, 0x4d, 0xb0, 0x2f, 0xaa, 0xb7, 0x4c, 0xf9, 0xbd, 0xa1, 0xb7, 0x7e, 0xaa, 0xaf, 0xed, 0xbb,
+	0xe1, 0x4b, 0xfa, 0xc9, 0x8e, 0x53, 0x58, 0xe7, 0xe3, 0x57, 0xc3, 0x61, 0xf4, 0x53, 0x0d, 0x4e,
+	0x32, 0xcf, 0xa9, 0x53, 0xe6, 0xb9, 0xb8, 0xb6, 0x65, 0xd8, 0x76, 0xdd, 0x30, 0x77, 0xa2, 0x2d,
+	0xab, 0xd2, 0xef, 0xed, 0xa7, 0xfb, 0xc5, 0x97, 0xe5, 0x84, 0x07, 0x49, 0x97, 0x9e, 0xc9, 0x2d,
+	0x85, 0x50, 0xed, 0xa2, 0xd2, 0x8a, 0xde, 0x54, 0x7e, 0x0c, 0x5f, 0x15, 0x8f, 0x01, 0xe4, 0xfb,
+	0x38, 0x28, 0x9e, 0xb0, 0xe9, 0xf9, 0x56, 0x6d, 0xd7, 0x63, 0x9c, 0x0a, 0xb0, 0x6b, 0xd4, 0xf9,
+	0x8b, 0x06, 0x2f, 0x08, 0xd3, 0xe5, 0xe5, 0xa7, 0xfb, 0xc5, 0xd7, 0x82, 0xa5, 0x0c, 0xd6, 0x2a,
+	0xe9, 0xf3, 0x81, 0x98, 0xac, 0xb4, 0x84, 0xd0, 0xa6, 0x90, 0x59, 0x95, 0x22, 0x31, 0x86, 0xbf,
+	0xaf, 0x01, 0x8a, 0x52, 0x12, 0x1d, 0xd3, 0xa6, 0xe7, 0x52, 0x51, 0x6f, 0x47, 0xa4, 0xa6, 0x58,
+	0x69, 0x60, 0xda, 0x1c, 0x2a, 0x04, 0xf5, 0x76, 0xec, 0xe2, 0xf8, 0x66, 0x94, 0x07, 0x24, 0x14,
+	0xc7, 0x29, 0xb3, 0xf1, 0x67, 0xe7, 0x58, 0xcd, 0x4e, 0x02, 0xed, 0x9e, 0xab, 0x7e, 0xa4, 0xf4,
+	0x99, 0x06, 0x27, 0x7a, 0xd8, 0x36, 0x5c, 0x33, 0x06, 0xe4, 0xc7, 0x06, 0x05, 0x77, 0xb5, 0xd5,
+	0xda, 0x9f, 0x97, 0xc3, 0xa7, 0xfd, 0xee, 0x81, 0xff, 0x5b, 0x46, 0x93, 0x14, 0xe7, 0xf1, 0x27,
+	0x0d, 0x66, 0xe3, 0x8b, 0x09, 0x77, 0x77, 0x03, 0xc6, 0xe3, 0x6b, 0x51, 0xfb, 0x7a, 0x6d, 0x88,
+	0x7d, 0xa9, 0x2d, 0x75, 0xc0, 0xa0, 0xf7, 0xa2, 0xdb, 0x4e, 0x3e, 0xba, 0x7f, 0x63, 0x58, 0x4b,
+	0x05, 0x2b, 0xec, 0xbe, 0xf5, 0x92, 0xe2, 0xc8, 0x7e, 0x9e, 0x80, 0x64, 0xd5, 0xf3, 0x6c, 0xf4,
+	0x23, 0x98, 0x76, 0x3d, 0x26, 0x62, 0x16, 0x5b, 0x35, 0xf5, 0xae, 0x26, 0x33, 0x87, 0xef, 0x0c,
+	0x67, 0xc0, 0x7f, 0xee, 0x17, 0x7b, 0xa1, 0xba, 0xac, 0x9a, 0x73, 0x3d, 0x56, 0x16, 0xe3, 0x1b,
+	0x62, 0x18, 0xf9, 0x30, 0xd1, 0x39, 0xb5, 0xcc, 0x34, 0xae, 0x0e, 0x3d, 0xf5, 0xc4, 0x41, 0xd3,
+	0x8e, 0xd7, 0x63, 0x73, 0x9e, 0x4f, 0xf3, 0x13, 0xfd, 0x17, 0x3f, 0xd5, 0x5f, 0x69, 0x30, 0xb3,
+	0xd1, 0x1b, 0x94, 0x68, 0x12, 0x12, 0xc4, 0x12, 0x56, 0x48, 0xea, 0x09, 0x62, 0xa1, 0x59, 0x38,
+	0xe6, 0x7d, 0xe4, 0x62, 0x5f, 0x3d, 0xfe, 0xca, 0x86, 0xb8, 0xda, 0x3d, 0xab, 0x65, 0xe3, 0x9a,
+	0x61, 0x9a, 0x5e, 0xcb, 0x65, 0xea, 0x01, 0x78, 0x42, 0xf6, 0xae, 0xc8, 0x4e, 0x74, 0x12, 0x32,
+	0x11, 0x8b, 0xc9, 0xf7, 0xdf, 0xa8, 0x83, 0x43, 0xc7, 0xef, 0x68, 0xd9, 0x50, 0x4e, 0x77, 0x5f,
+	0x83, 0xd9, 0x0e, 0x4a, 0x0f, 0x20, 0xfb, 0xd6, 0x59, 0xda, 0xd0, 0x75, 0xd6, 0x0a, 0xe4, 0xd4,
+	0xca, 0x0f, 0x9d, 0xe8, 0x4d, 0x2a, 0x85, 0xa0, 0xc4, 0x4f, 0xdf, 0x0c, 0x48, 0xe0, 0xbf, 0x09,
+	0x00, 0x61, 0xc3, 0xeb, 0x5b, 0x5b, 0xd8, 0xef, 0x31, 0xe1, 0x59, 0x48, 0x51, 0x6c, 0xdb, 0xd8,
+	0x1f, 0x38, 0x85, 0x92, 0x43, 0x2e, 0x8c, 0x4b, 0x3a, 0x55, 0x9e, 0x21, 0x4b, 0xc1, 0x03, 0xf8,
+	0xe9, 0x2c, 0x77, 0x9a, 0xfb, 0x9f, 0x16, 0x17, 0x0e, 0xe1, 0x34, 0x5c, 0x81, 0xea, 0x59, 0x31,
+	0x81, 0x72, 0xc5, 0xb7, 0x20, 0xdf, 0x9f, 0xc7, 0x89, 0x2c, 0xad, 0x93, 0xfa, 0x0b, 0x7d, 0x08,
+	0x7c, 0xcd, 0x42, 0x5f, 0x87, 0x63, 0x4d, 0x9f, 0x98, 0x58, 0x1c, 0xe1, 0x21, 0x18, 0x54, 0x4a,
+	0xa3, 0x0a, 0x00, 0xde, 0x6b, 0x12, 0x99, 0x40, 0xe6, 0x53, 0x43, 0x14, 0x37, 0x31, 0xbd, 0xd8,
+	0x01, 0x7c, 0xae, 0xc1, 0xcb, 0xa1, 0xb7, 0xa8, 0x27, 0x3d, 0x49, 0x12, 0x55, 0xa3, 0x45, 0x71,
+	0xd5, 0xf7, 0x9a, 0x1e, 0x35, 0x6c, 0xee, 0x71, 0x8c, 0x30, 0x5b, 0xfd, 0xe6, 0xa6, 0xcb, 0x06,
+	0x9a, 0xef, 0x7c, 0x95, 0x96, 0x8e, 0x1e, 0xef, 0xea, 0xef, 0x74, 0xa3, 0x43, 0x3b, 0xdd, 0x71,
+	0x48, 0x35, 0xf9, 0x7a, 0x82, 0xf7, 0x0a, 0xd5, 0x3a, 0x7f, 0x26, 0x9e, 0x8d, 0x7f, 0xfc, 0x60,
+	0xb1, 0xa0, 0xf0, 0x1a, 0xde, 0x6e, 0xcc, 0x88, 0x2e, 0xc3, 0x2e, 0x3b, 0xf3, 0x07, 0x0d, 0x20,
+	0xfa, 0x61, 0x00, 0xbd, 0x0e, 0x5f, 0x29, 0x5f, 0xbf, 0x56, 0xa9, 0xad, 0x6f, 0xac, 0x6c, 0xdc,
+	0x58, 0xaf, 0xdd, 0xb8, 0xb6, 0x5e, 0x5d, 0xbd, 0xb0, 0x76, 0x71, 0x6d, 0xb5, 0x32, 0x35, 0x52,
+	0xc8, 0xdd, 0xba, 0x3b, 0x9f, 0xbd, 0xe1, 0xd2, 0x26, 0x36, 0xc9, 0x16, 0xc1, 0x16, 0x3a, 0x05,
+	0xb3, 0x9d, 0xd2, 0xbc, 0xb5, 0x5a, 0x99, 0xd2, 0x0a, 0xe3, 0xb7, 0xee, 0xce, 0xa7, 0xe5, 0x8b,
+	0x00, 0xb6, 0xd0, 0x02, 0xbc, 0xd0, 0x2b, 0xb7, 0x76, 0xed, 0x9d, 0xa9, 0x44, 0x61, 0xe2, 0xd6,
+	0xdd, 0xf9, 0x4c, 0xf8, 0x74, 0x80, 0x4a, 0x80, 0xe2, 0x92, 0x0a, 0x6f, 0xb4, 0x00, 0xb7, 0xee,
+	0xce, 0xa7, 0x24, 0xdd, 0x15, 0x92, 0x37, 0x7f, 0x33, 0x37, 0x52, 0x7e, 0xff, 0xe1, 0xe3, 0x39,
+	0xed, 0xd1, 0xe3, 0x39, 0xed, 0xb3, 0xc7, 0x73, 0xda, 0xed, 0x27, 0x73, 0x23, 0x8f, 0x9e, 0xcc,
+	0x8d, 0xfc, 0xe5, 0xc9, 0xdc, 0xc8, 0x07, 0x6f, 0xc7, 0x9c, 0x96, 0x7c, 0x68, 0xb7, 0x28, 0xf1,
+	0x5c, 0xe2, 0x9a, 0xcb, 0x92, 0xf5, 0x09, 0x6b, 0x2f, 0x2a, 0xc6, 0x5f, 0x94, 0xec, 0xb2, 0xbc,
+	0x17, 0xfc, 0xb4, 0x2d, 0x3d, 0xba, 0x9e, 0x12, 0x2e, 0xf3, 0xb5, 0xff, 0x0d, 0x00, 0x50, 0x93,
+	0xf2, 0x90, 0x02, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1340,487 +1562,516 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7668 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x7b, 0x70, 0x1c, 0xc9,
-		0x79, 0x1f, 0xf6, 0x81, 0xc5, 0xee, 0xb7, 0x8b, 0xc5, 0xa0, 0x01, 0x92, 0x4b, 0xf0, 0x08, 0xe0,
-		0x56, 0xba, 0x3b, 0x92, 0x77, 0x04, 0xef, 0x78, 0x47, 0xf2, 0xb8, 0xb4, 0x74, 0x59, 0x60, 0x97,
-		0x3c, 0x90, 0x78, 0xac, 0x66, 0x01, 0xde, 0xc3, 0x95, 0x9a, 0x0c, 0x66, 0x1b, 0x8b, 0x39, 0xcc,
-		0xce, 0x8c, 0x66, 0x66, 0x49, 0xe2, 0xe2, 0xa4, 0xce, 0x51, 0xe2, 0xc8, 0x4c, 0xc5, 0x91, 0xe3,
-		0x54, 0x2c, 0xcb, 0xa2, 0x22, 0x59, 0x76, 0xe4, 0x28, 0xca, 0xc3, 0x96, 0xa2, 0xc4, 0x49, 0x25,
-		0xa5, 0x38, 0x95, 0x44, 0x71, 0x55, 0x52, 0x92, 0xff, 0x88, 0x9d, 0x38, 0xb9, 0x28, 0x27, 0x55,
-		0xa2, 0x38, 0x72, 0xec, 0x38, 0x97, 0x4a, 0x52, 0x2a, 0x55, 0xa5, 0xfa, 0x35, 0x8f, 0x7d, 0x60,
-		0x16, 0x34, 0x4f, 0x76, 0x95, 0xfe, 0xc2, 0xf6, 0xd7, 0xdf, 0xef, 0xd7, 0xdd, 0x5f, 0x7f, 0xfd,
-		0xf5, 0xd7, 0x3d, 0x33, 0x80, 0x7f, 0x76, 0x0d, 0x16, 0xdb, 0x96, 0xd5, 0x36, 0xf0, 0x05, 0xdb,
-		0xb1, 0x3c, 0x6b, 0xa7, 0xbb, 0x7b, 0xa1, 0x85, 0x5d, 0xcd, 0xd1, 0x6d, 0xcf, 0x72, 0x96, 0xa8,
-		0x0c, 0x4d, 0x31, 0x8d, 0x25, 0xa1, 0x51, 0x5e, 0x87, 0xe9, 0xeb, 0xba, 0x81, 0x6b, 0xbe, 0x62,
-		0x13, 0x7b, 0xe8, 0x45, 0x48, 0xef, 0xea, 0x06, 0x2e, 0x25, 0x16, 0x53, 0x67, 0xf2, 0x17, 0xdf,
-		0xbf, 0xd4, 0x03, 0x5a, 0x8a, 0x22, 0x1a, 0x44, 0x2c, 0x53, 0x44, 0xf9, 0x5b, 0x69, 0x98, 0x19,
-		0x50, 0x8b, 0x10, 0xa4, 0x4d, 0xb5, 0x43, 0x18, 0x13, 0x67, 0x72, 0x32, 0xfd, 0x8d, 0x4a, 0x30,
-		0x61, 0xab, 0xda, 0xbe, 0xda, 0xc6, 0xa5, 0x24, 0x15, 0x8b, 0x22, 0x9a, 0x07, 0x68, 0x61, 0x1b,
-		0x9b, 0x2d, 0x6c, 0x6a, 0x07, 0xa5, 0xd4, 0x62, 0xea, 0x4c, 0x4e, 0x0e, 0x49, 0xd0, 0xd3, 0x30,
-		0x6d, 0x77, 0x77, 0x0c, 0x5d, 0x53, 0x42, 0x6a, 0xb0, 0x98, 0x3a, 0x33, 0x2e, 0x4b, 0xac, 0xa2,
-		0x16, 0x28, 0x3f, 0x05, 0x53, 0x77, 0xb1, 0xba, 0x1f, 0x56, 0xcd, 0x53, 0xd5, 0x22, 0x11, 0x87,
-		0x14, 0x57, 0xa0, 0xd0, 0xc1, 0xae, 0xab, 0xb6, 0xb1, 0xe2, 0x1d, 0xd8, 0xb8, 0x94, 0xa6, 0xa3,
-		0x5f, 0xec, 0x1b, 0x7d, 0xef, 0xc8, 0xf3, 0x1c, 0xb5, 0x75, 0x60, 0x63, 0x54, 0x85, 0x1c, 0x36,
-		0xbb, 0x1d, 0xc6, 0x30, 0x3e, 0xc4, 0x7e, 0x75, 0xb3, 0xdb, 0xe9, 0x65, 0xc9, 0x12, 0x18, 0xa7,
-		0x98, 0x70, 0xb1, 0x73, 0x47, 0xd7, 0x70, 0x29, 0x43, 0x09, 0x9e, 0xea, 0x23, 0x68, 0xb2, 0xfa,
-		0x5e, 0x0e, 0x81, 0x43, 0x2b, 0x90, 0xc3, 0xf7, 0x3c, 0x6c, 0xba, 0xba, 0x65, 0x96, 0x26, 0x28,
-		0xc9, 0x13, 0x03, 0x66, 0x11, 0x1b, 0xad, 0x5e, 0x8a, 0x00, 0x87, 0x2e, 0xc3, 0x84, 0x65, 0x7b,
-		0xba, 0x65, 0xba, 0xa5, 0xec, 0x62, 0xe2, 0x4c, 0xfe, 0xe2, 0x63, 0x03, 0x1d, 0x61, 0x93, 0xe9,
-		0xc8, 0x42, 0x19, 0xad, 0x82, 0xe4, 0x5a, 0x5d, 0x47, 0xc3, 0x8a, 0x66, 0xb5, 0xb0, 0xa2, 0x9b,
-		0xbb, 0x56, 0x29, 0x47, 0x09, 0x16, 0xfa, 0x07, 0x42, 0x15, 0x57, 0xac, 0x16, 0x5e, 0x35, 0x77,
-		0x2d, 0xb9, 0xe8, 0x46, 0xca, 0xe8, 0x38, 0x64, 0xdc, 0x03, 0xd3, 0x53, 0xef, 0x95, 0x0a, 0xd4,
-		0x43, 0x78, 0xa9, 0xfc, 0x2b, 0x19, 0x98, 0x1a, 0xc5, 0xc5, 0xae, 0xc1, 0xf8, 0x2e, 0x19, 0x65,
-		0x29, 0x79, 0x14, 0x1b, 0x30, 0x4c, 0xd4, 0x88, 0x99, 0x87, 0x34, 0x62, 0x15, 0xf2, 0x26, 0x76,
-		0x3d, 0xdc, 0x62, 0x1e, 0x91, 0x1a, 0xd1, 0xa7, 0x80, 0x81, 0xfa, 0x5d, 0x2a, 0xfd, 0x50, 0x2e,
-		0xf5, 0x2a, 0x4c, 0xf9, 0x5d, 0x52, 0x1c, 0xd5, 0x6c, 0x0b, 0xdf, 0xbc, 0x10, 0xd7, 0x93, 0xa5,
-		0xba, 0xc0, 0xc9, 0x04, 0x26, 0x17, 0x71, 0xa4, 0x8c, 0x6a, 0x00, 0x96, 0x89, 0xad, 0x5d, 0xa5,
-		0x85, 0x35, 0xa3, 0x94, 0x1d, 0x62, 0xa5, 0x4d, 0xa2, 0xd2, 0x67, 0x25, 0x8b, 0x49, 0x35, 0x03,
-		0x5d, 0x0d, 0x5c, 0x6d, 0x62, 0x88, 0xa7, 0xac, 0xb3, 0x45, 0xd6, 0xe7, 0x6d, 0xdb, 0x50, 0x74,
-		0x30, 0xf1, 0x7b, 0xdc, 0xe2, 0x23, 0xcb, 0xd1, 0x4e, 0x2c, 0xc5, 0x8e, 0x4c, 0xe6, 0x30, 0x36,
-		0xb0, 0x49, 0x27, 0x5c, 0x44, 0xef, 0x03, 0x5f, 0xa0, 0x50, 0xb7, 0x02, 0x1a, 0x85, 0x0a, 0x42,
-		0xb8, 0xa1, 0x76, 0xf0, 0xdc, 0x9b, 0x50, 0x8c, 0x9a, 0x07, 0xcd, 0xc2, 0xb8, 0xeb, 0xa9, 0x8e,
-		0x47, 0xbd, 0x70, 0x5c, 0x66, 0x05, 0x24, 0x41, 0x0a, 0x9b, 0x2d, 0x1a, 0xe5, 0xc6, 0x65, 0xf2,
-		0x13, 0xfd, 0xb1, 0x60, 0xc0, 0x29, 0x3a, 0xe0, 0x27, 0xfb, 0x67, 0x34, 0xc2, 0xdc, 0x3b, 0xee,
-		0xb9, 0x2b, 0x30, 0x19, 0x19, 0xc0, 0xa8, 0x4d, 0x97, 0x7f, 0x04, 0x8e, 0x0d, 0xa4, 0x46, 0xaf,
-		0xc2, 0x6c, 0xd7, 0xd4, 0x4d, 0x0f, 0x3b, 0xb6, 0x83, 0x89, 0xc7, 0xb2, 0xa6, 0x4a, 0xff, 0x75,
-		0x62, 0x88, 0xcf, 0x6d, 0x87, 0xb5, 0x19, 0x8b, 0x3c, 0xd3, 0xed, 0x17, 0x9e, 0xcb, 0x65, 0xbf,
-		0x3d, 0x21, 0xbd, 0xf5, 0xd6, 0x5b, 0x6f, 0x25, 0xcb, 0xff, 0x34, 0x03, 0xb3, 0x83, 0xd6, 0xcc,
-		0xc0, 0xe5, 0x7b, 0x1c, 0x32, 0x66, 0xb7, 0xb3, 0x83, 0x1d, 0x6a, 0xa4, 0x71, 0x99, 0x97, 0x50,
-		0x15, 0xc6, 0x0d, 0x75, 0x07, 0x1b, 0xa5, 0xf4, 0x62, 0xe2, 0x4c, 0xf1, 0xe2, 0xd3, 0x23, 0xad,
-		0xca, 0xa5, 0x35, 0x02, 0x91, 0x19, 0x12, 0x7d, 0x10, 0xd2, 0x3c, 0x44, 0x13, 0x86, 0x73, 0xa3,
-		0x31, 0x90, 0xb5, 0x24, 0x53, 0x1c, 0x3a, 0x05, 0x39, 0xf2, 0x97, 0xf9, 0x46, 0x86, 0xf6, 0x39,
-		0x4b, 0x04, 0xc4, 0x2f, 0xd0, 0x1c, 0x64, 0xe9, 0x32, 0x69, 0x61, 0xb1, 0xb5, 0xf9, 0x65, 0xe2,
-		0x58, 0x2d, 0xbc, 0xab, 0x76, 0x0d, 0x4f, 0xb9, 0xa3, 0x1a, 0x5d, 0x4c, 0x1d, 0x3e, 0x27, 0x17,
-		0xb8, 0xf0, 0x36, 0x91, 0xa1, 0x05, 0xc8, 0xb3, 0x55, 0xa5, 0x9b, 0x2d, 0x7c, 0x8f, 0x46, 0xcf,
-		0x71, 0x99, 0x2d, 0xb4, 0x55, 0x22, 0x21, 0xcd, 0xbf, 0xe1, 0x5a, 0xa6, 0x70, 0x4d, 0xda, 0x04,
-		0x11, 0xd0, 0xe6, 0xaf, 0xf4, 0x06, 0xee, 0xd3, 0x83, 0x87, 0xd7, 0xb7, 0x96, 0x9e, 0x82, 0x29,
-		0xaa, 0xf1, 0x3c, 0x9f, 0x7a, 0xd5, 0x28, 0x4d, 0x2f, 0x26, 0xce, 0x64, 0xe5, 0x22, 0x13, 0x6f,
-		0x72, 0x69, 0xf9, 0xcb, 0x49, 0x48, 0xd3, 0xc0, 0x32, 0x05, 0xf9, 0xad, 0xd7, 0x1a, 0x75, 0xa5,
-		0xb6, 0xb9, 0xbd, 0xbc, 0x56, 0x97, 0x12, 0xa8, 0x08, 0x40, 0x05, 0xd7, 0xd7, 0x36, 0xab, 0x5b,
-		0x52, 0xd2, 0x2f, 0xaf, 0x6e, 0x6c, 0x5d, 0x7e, 0x41, 0x4a, 0xf9, 0x80, 0x6d, 0x26, 0x48, 0x87,
-		0x15, 0x9e, 0xbf, 0x28, 0x8d, 0x23, 0x09, 0x0a, 0x8c, 0x60, 0xf5, 0xd5, 0x7a, 0xed, 0xf2, 0x0b,
-		0x52, 0x26, 0x2a, 0x79, 0xfe, 0xa2, 0x34, 0x81, 0x26, 0x21, 0x47, 0x25, 0xcb, 0x9b, 0x9b, 0x6b,
-		0x52, 0xd6, 0xe7, 0x6c, 0x6e, 0xc9, 0xab, 0x1b, 0x37, 0xa4, 0x9c, 0xcf, 0x79, 0x43, 0xde, 0xdc,
-		0x6e, 0x48, 0xe0, 0x33, 0xac, 0xd7, 0x9b, 0xcd, 0xea, 0x8d, 0xba, 0x94, 0xf7, 0x35, 0x96, 0x5f,
-		0xdb, 0xaa, 0x37, 0xa5, 0x42, 0xa4, 0x5b, 0xcf, 0x5f, 0x94, 0x26, 0xfd, 0x26, 0xea, 0x1b, 0xdb,
-		0xeb, 0x52, 0x11, 0x4d, 0xc3, 0x24, 0x6b, 0x42, 0x74, 0x62, 0xaa, 0x47, 0x74, 0xf9, 0x05, 0x49,
-		0x0a, 0x3a, 0xc2, 0x58, 0xa6, 0x23, 0x82, 0xcb, 0x2f, 0x48, 0xa8, 0xbc, 0x02, 0xe3, 0xd4, 0x0d,
-		0x11, 0x82, 0xe2, 0x5a, 0x75, 0xb9, 0xbe, 0xa6, 0x6c, 0x36, 0xb6, 0x56, 0x37, 0x37, 0xaa, 0x6b,
-		0x52, 0x22, 0x90, 0xc9, 0xf5, 0x0f, 0x6d, 0xaf, 0xca, 0xf5, 0x9a, 0x94, 0x0c, 0xcb, 0x1a, 0xf5,
-		0xea, 0x56, 0xbd, 0x26, 0xa5, 0xca, 0x1a, 0xcc, 0x0e, 0x0a, 0xa8, 0x03, 0x97, 0x50, 0xc8, 0x17,
-		0x92, 0x43, 0x7c, 0x81, 0x72, 0xf5, 0xfa, 0x42, 0xf9, 0x9b, 0x49, 0x98, 0x19, 0xb0, 0xa9, 0x0c,
-		0x6c, 0xe4, 0x25, 0x18, 0x67, 0xbe, 0xcc, 0xb6, 0xd9, 0xb3, 0x03, 0x77, 0x27, 0xea, 0xd9, 0x7d,
-		0x5b, 0x2d, 0xc5, 0x85, 0x53, 0x8d, 0xd4, 0x90, 0x54, 0x83, 0x50, 0xf4, 0x39, 0xec, 0x1f, 0xef,
-		0x0b, 0xfe, 0x6c, 0x7f, 0xbc, 0x3c, 0xca, 0xfe, 0x48, 0x65, 0x47, 0xdb, 0x04, 0xc6, 0x07, 0x6c,
-		0x02, 0xd7, 0x60, 0xba, 0x8f, 0x68, 0xe4, 0x60, 0xfc, 0x91, 0x04, 0x94, 0x86, 0x19, 0x27, 0x26,
-		0x24, 0x26, 0x23, 0x21, 0xf1, 0x5a, 0xaf, 0x05, 0x1f, 0x1f, 0x3e, 0x09, 0x7d, 0x73, 0xfd, 0xb9,
-		0x04, 0x1c, 0x1f, 0x9c, 0x52, 0x0e, 0xec, 0xc3, 0x07, 0x21, 0xd3, 0xc1, 0xde, 0x9e, 0x25, 0xd2,
-		0xaa, 0x27, 0x07, 0x6c, 0xd6, 0xa4, 0xba, 0x77, 0xb2, 0x39, 0x2a, 0xbc, 0xdb, 0xa7, 0x86, 0xe5,
-		0x85, 0xac, 0x37, 0x7d, 0x3d, 0xfd, 0xf1, 0x24, 0x1c, 0x1b, 0x48, 0x3e, 0xb0, 0xa3, 0xa7, 0x01,
-		0x74, 0xd3, 0xee, 0x7a, 0x2c, 0x75, 0x62, 0x91, 0x38, 0x47, 0x25, 0x34, 0x78, 0x91, 0x28, 0xdb,
-		0xf5, 0xfc, 0xfa, 0x14, 0xad, 0x07, 0x26, 0xa2, 0x0a, 0x2f, 0x06, 0x1d, 0x4d, 0xd3, 0x8e, 0xce,
-		0x0f, 0x19, 0x69, 0x9f, 0x63, 0x3e, 0x0b, 0x92, 0x66, 0xe8, 0xd8, 0xf4, 0x14, 0xd7, 0x73, 0xb0,
-		0xda, 0xd1, 0xcd, 0x36, 0xdd, 0x6a, 0xb2, 0x95, 0xf1, 0x5d, 0xd5, 0x70, 0xb1, 0x3c, 0xc5, 0xaa,
-		0x9b, 0xa2, 0x96, 0x20, 0xa8, 0x03, 0x39, 0x21, 0x44, 0x26, 0x82, 0x60, 0xd5, 0x3e, 0xa2, 0xfc,
-		0x93, 0x39, 0xc8, 0x87, 0x12, 0x70, 0xf4, 0x38, 0x14, 0xde, 0x50, 0xef, 0xa8, 0x8a, 0x38, 0x54,
-		0x31, 0x4b, 0xe4, 0x89, 0xac, 0xc1, 0x0f, 0x56, 0xcf, 0xc2, 0x2c, 0x55, 0xb1, 0xba, 0x1e, 0x76,
-		0x14, 0xcd, 0x50, 0x5d, 0x97, 0x1a, 0x2d, 0x4b, 0x55, 0x11, 0xa9, 0xdb, 0x24, 0x55, 0x2b, 0xa2,
-		0x06, 0x5d, 0x82, 0x19, 0x8a, 0xe8, 0x74, 0x0d, 0x4f, 0xb7, 0x0d, 0xac, 0x90, 0x63, 0x9e, 0x4b,
-		0xb7, 0x1c, 0xbf, 0x67, 0xd3, 0x44, 0x63, 0x9d, 0x2b, 0x90, 0x1e, 0xb9, 0xa8, 0x06, 0xa7, 0x29,
-		0xac, 0x8d, 0x4d, 0xec, 0xa8, 0x1e, 0x56, 0xf0, 0x87, 0xbb, 0xaa, 0xe1, 0x2a, 0xaa, 0xd9, 0x52,
-		0xf6, 0x54, 0x77, 0xaf, 0x34, 0x4b, 0x08, 0x96, 0x93, 0xa5, 0x84, 0x7c, 0x92, 0x28, 0xde, 0xe0,
-		0x7a, 0x75, 0xaa, 0x56, 0x35, 0x5b, 0x2f, 0xab, 0xee, 0x1e, 0xaa, 0xc0, 0x71, 0xca, 0xe2, 0x7a,
-		0x8e, 0x6e, 0xb6, 0x15, 0x6d, 0x0f, 0x6b, 0xfb, 0x4a, 0xd7, 0xdb, 0x7d, 0xb1, 0x74, 0x2a, 0xdc,
-		0x3e, 0xed, 0x61, 0x93, 0xea, 0xac, 0x10, 0x95, 0x6d, 0x6f, 0xf7, 0x45, 0xd4, 0x84, 0x02, 0x99,
-		0x8c, 0x8e, 0xfe, 0x26, 0x56, 0x76, 0x2d, 0x87, 0xee, 0xa1, 0xc5, 0x01, 0xa1, 0x29, 0x64, 0xc1,
-		0xa5, 0x4d, 0x0e, 0x58, 0xb7, 0x5a, 0xb8, 0x32, 0xde, 0x6c, 0xd4, 0xeb, 0x35, 0x39, 0x2f, 0x58,
-		0xae, 0x5b, 0x0e, 0x71, 0xa8, 0xb6, 0xe5, 0x1b, 0x38, 0xcf, 0x1c, 0xaa, 0x6d, 0x09, 0xf3, 0x5e,
-		0x82, 0x19, 0x4d, 0x63, 0x63, 0xd6, 0x35, 0x85, 0x1f, 0xc6, 0xdc, 0x92, 0x14, 0x31, 0x96, 0xa6,
-		0xdd, 0x60, 0x0a, 0xdc, 0xc7, 0x5d, 0x74, 0x15, 0x8e, 0x05, 0xc6, 0x0a, 0x03, 0xa7, 0xfb, 0x46,
-		0xd9, 0x0b, 0xbd, 0x04, 0x33, 0xf6, 0x41, 0x3f, 0x10, 0x45, 0x5a, 0xb4, 0x0f, 0x7a, 0x61, 0x57,
-		0x60, 0xd6, 0xde, 0xb3, 0xfb, 0x71, 0xe7, 0xc2, 0x38, 0x64, 0xef, 0xd9, 0xbd, 0xc0, 0x27, 0xe8,
-		0xc9, 0xdc, 0xc1, 0x9a, 0xea, 0xe1, 0x56, 0xe9, 0x44, 0x58, 0x3d, 0x54, 0x81, 0x96, 0x40, 0xd2,
-		0x34, 0x05, 0x9b, 0xea, 0x8e, 0x81, 0x15, 0xd5, 0xc1, 0xa6, 0xea, 0x96, 0x16, 0xa8, 0x72, 0xda,
-		0x73, 0xba, 0x58, 0x2e, 0x6a, 0x5a, 0x9d, 0x56, 0x56, 0x69, 0x1d, 0x3a, 0x07, 0xd3, 0xd6, 0xce,
-		0x1b, 0x1a, 0xf3, 0x48, 0xc5, 0x76, 0xf0, 0xae, 0x7e, 0xaf, 0xf4, 0x7e, 0x6a, 0xde, 0x29, 0x52,
-		0x41, 0xfd, 0xb1, 0x41, 0xc5, 0xe8, 0x2c, 0x48, 0x9a, 0xbb, 0xa7, 0x3a, 0x36, 0x0d, 0xc9, 0xae,
-		0xad, 0x6a, 0xb8, 0xf4, 0x04, 0x53, 0x65, 0xf2, 0x0d, 0x21, 0x26, 0x2b, 0xc2, 0xbd, 0xab, 0xef,
-		0x7a, 0x82, 0xf1, 0x29, 0xb6, 0x22, 0xa8, 0x8c, 0xb3, 0x9d, 0x01, 0x89, 0x58, 0x22, 0xd2, 0xf0,
-		0x19, 0xaa, 0x56, 0xb4, 0xf7, 0xec, 0x70, 0xbb, 0xef, 0x83, 0x49, 0xa2, 0x19, 0x34, 0x7a, 0x96,
-		0x25, 0x6e, 0xf6, 0x5e, 0xa8, 0xc5, 0x17, 0xe0, 0x38, 0x51, 0xea, 0x60, 0x4f, 0x6d, 0xa9, 0x9e,
-		0x1a, 0xd2, 0x7e, 0x86, 0x6a, 0x13, 0xb3, 0xaf, 0xf3, 0xca, 0x48, 0x3f, 0x9d, 0xee, 0xce, 0x81,
-		0xef, 0x58, 0xe7, 0x59, 0x3f, 0x89, 0x4c, 0xb8, 0xd6, 0x7b, 0x96, 0x9c, 0x97, 0x2b, 0x50, 0x08,
-		0xfb, 0x3d, 0xca, 0x01, 0xf3, 0x7c, 0x29, 0x41, 0x92, 0xa0, 0x95, 0xcd, 0x1a, 0x49, 0x5f, 0x5e,
-		0xaf, 0x4b, 0x49, 0x92, 0x46, 0xad, 0xad, 0x6e, 0xd5, 0x15, 0x79, 0x7b, 0x63, 0x6b, 0x75, 0xbd,
-		0x2e, 0xa5, 0x42, 0x89, 0xfd, 0xcd, 0x74, 0xf6, 0x49, 0xe9, 0x29, 0x92, 0x35, 0x14, 0xa3, 0x27,
-		0x35, 0xf4, 0x43, 0x70, 0x42, 0x5c, 0xab, 0xb8, 0xd8, 0x53, 0xee, 0xea, 0x0e, 0x5d, 0x90, 0x1d,
-		0x95, 0x6d, 0x8e, 0xbe, 0xff, 0xcc, 0x72, 0xad, 0x26, 0xf6, 0x5e, 0xd1, 0x1d, 0xb2, 0xdc, 0x3a,
-		0xaa, 0x87, 0xd6, 0x60, 0xc1, 0xb4, 0x14, 0xd7, 0x53, 0xcd, 0x96, 0xea, 0xb4, 0x94, 0xe0, 0x42,
-		0x4b, 0x51, 0x35, 0x0d, 0xbb, 0xae, 0xc5, 0x36, 0x42, 0x9f, 0xe5, 0x31, 0xd3, 0x6a, 0x72, 0xe5,
-		0x60, 0x87, 0xa8, 0x72, 0xd5, 0x1e, 0xf7, 0x4d, 0x0d, 0x73, 0xdf, 0x53, 0x90, 0xeb, 0xa8, 0xb6,
-		0x82, 0x4d, 0xcf, 0x39, 0xa0, 0xf9, 0x79, 0x56, 0xce, 0x76, 0x54, 0xbb, 0x4e, 0xca, 0xdf, 0x97,
-		0x63, 0xd2, 0xcd, 0x74, 0x36, 0x2d, 0x8d, 0xdf, 0x4c, 0x67, 0xc7, 0xa5, 0xcc, 0xcd, 0x74, 0x36,
-		0x23, 0x4d, 0xdc, 0x4c, 0x67, 0xb3, 0x52, 0xee, 0x66, 0x3a, 0x9b, 0x93, 0xa0, 0xfc, 0x4e, 0x0a,
-		0x0a, 0xe1, 0x0c, 0x9e, 0x1c, 0x88, 0x34, 0xba, 0x87, 0x25, 0x68, 0x94, 0x7b, 0xdf, 0xa1, 0xf9,
-		0xfe, 0xd2, 0x0a, 0xd9, 0xdc, 0x2a, 0x19, 0x96, 0x2e, 0xcb, 0x0c, 0x49, 0x12, 0x0b, 0xe2, 0x7e,
-		0x98, 0xa5, 0x27, 0x59, 0x99, 0x97, 0xd0, 0x0d, 0xc8, 0xbc, 0xe1, 0x52, 0xee, 0x0c, 0xe5, 0x7e,
-		0xff, 0xe1, 0xdc, 0x37, 0x9b, 0x94, 0x3c, 0x77, 0xb3, 0xa9, 0x6c, 0x6c, 0xca, 0xeb, 0xd5, 0x35,
-		0x99, 0xc3, 0xd1, 0x49, 0x48, 0x1b, 0xea, 0x9b, 0x07, 0xd1, 0x6d, 0x90, 0x8a, 0x46, 0x9d, 0x96,
-		0x93, 0x90, 0xbe, 0x8b, 0xd5, 0xfd, 0xe8, 0xe6, 0x43, 0x45, 0xef, 0xe1, 0xf2, 0xb8, 0x00, 0xe3,
-		0xd4, 0x5e, 0x08, 0x80, 0x5b, 0x4c, 0x1a, 0x43, 0x59, 0x48, 0xaf, 0x6c, 0xca, 0x64, 0x89, 0x48,
-		0x50, 0x60, 0x52, 0xa5, 0xb1, 0x5a, 0x5f, 0xa9, 0x4b, 0xc9, 0xf2, 0x25, 0xc8, 0x30, 0x23, 0x90,
-		0xe5, 0xe3, 0x9b, 0x41, 0x1a, 0xe3, 0x45, 0xce, 0x91, 0x10, 0xb5, 0xdb, 0xeb, 0xcb, 0x75, 0x59,
-		0x4a, 0xf6, 0x4d, 0x7e, 0xd9, 0x85, 0x42, 0x38, 0x33, 0xff, 0xfe, 0x1c, 0xcf, 0xbf, 0x92, 0x80,
-		0x7c, 0x28, 0xd3, 0x26, 0x29, 0x92, 0x6a, 0x18, 0xd6, 0x5d, 0x45, 0x35, 0x74, 0xd5, 0xe5, 0xae,
-		0x01, 0x54, 0x54, 0x25, 0x92, 0x51, 0xa7, 0xee, 0xfb, 0xb4, 0x68, 0xc6, 0xa5, 0x4c, 0xf9, 0x53,
-		0x09, 0x90, 0x7a, 0x53, 0xdd, 0x9e, 0x6e, 0x26, 0xfe, 0x30, 0xbb, 0x59, 0xfe, 0x64, 0x02, 0x8a,
-		0xd1, 0xfc, 0xb6, 0xa7, 0x7b, 0x8f, 0xff, 0xa1, 0x76, 0xef, 0x1b, 0x49, 0x98, 0x8c, 0x64, 0xb5,
-		0xa3, 0xf6, 0xee, 0xc3, 0x30, 0xad, 0xb7, 0x70, 0xc7, 0xb6, 0x3c, 0x6c, 0x6a, 0x07, 0x8a, 0x81,
-		0xef, 0x60, 0xa3, 0x54, 0xa6, 0x41, 0xe3, 0xc2, 0xe1, 0x79, 0xf3, 0xd2, 0x6a, 0x80, 0x5b, 0x23,
-		0xb0, 0xca, 0xcc, 0x6a, 0xad, 0xbe, 0xde, 0xd8, 0xdc, 0xaa, 0x6f, 0xac, 0xbc, 0xa6, 0x6c, 0x6f,
-		0xdc, 0xda, 0xd8, 0x7c, 0x65, 0x43, 0x96, 0xf4, 0x1e, 0xb5, 0xf7, 0x70, 0xd9, 0x37, 0x40, 0xea,
-		0xed, 0x14, 0x3a, 0x01, 0x83, 0xba, 0x25, 0x8d, 0xa1, 0x19, 0x98, 0xda, 0xd8, 0x54, 0x9a, 0xab,
-		0xb5, 0xba, 0x52, 0xbf, 0x7e, 0xbd, 0xbe, 0xb2, 0xd5, 0x64, 0x37, 0x21, 0xbe, 0xf6, 0x56, 0x64,
-		0x81, 0x97, 0x3f, 0x91, 0x82, 0x99, 0x01, 0x3d, 0x41, 0x55, 0x7e, 0x86, 0x61, 0xc7, 0xaa, 0xf3,
-		0xa3, 0xf4, 0x7e, 0x89, 0x64, 0x11, 0x0d, 0xd5, 0xf1, 0xf8, 0x91, 0xe7, 0x2c, 0x10, 0x2b, 0x99,
-		0x9e, 0xbe, 0xab, 0x63, 0x87, 0xdf, 0x30, 0xb1, 0x83, 0xcd, 0x54, 0x20, 0x67, 0x97, 0x4c, 0xcf,
-		0x00, 0xb2, 0x2d, 0x57, 0xf7, 0xf4, 0x3b, 0x58, 0xd1, 0x4d, 0x71, 0x1d, 0x45, 0x0e, 0x3a, 0x69,
-		0x59, 0x12, 0x35, 0xab, 0xa6, 0xe7, 0x6b, 0x9b, 0xb8, 0xad, 0xf6, 0x68, 0x93, 0x60, 0x9e, 0x92,
-		0x25, 0x51, 0xe3, 0x6b, 0x3f, 0x0e, 0x85, 0x96, 0xd5, 0x25, 0xd9, 0x1f, 0xd3, 0x23, 0x7b, 0x47,
-		0x42, 0xce, 0x33, 0x99, 0xaf, 0xc2, 0xf3, 0xfa, 0xe0, 0x1e, 0xac, 0x20, 0xe7, 0x99, 0x8c, 0xa9,
-		0x3c, 0x05, 0x53, 0x6a, 0xbb, 0xed, 0x10, 0x72, 0x41, 0xc4, 0x4e, 0x2a, 0x45, 0x5f, 0x4c, 0x15,
-		0xe7, 0x6e, 0x42, 0x56, 0xd8, 0x81, 0x6c, 0xde, 0xc4, 0x12, 0x8a, 0xcd, 0x8e, 0xdf, 0xc9, 0x33,
-		0x39, 0x39, 0x6b, 0x8a, 0xca, 0xc7, 0xa1, 0xa0, 0xbb, 0x4a, 0x70, 0xad, 0x9f, 0x5c, 0x4c, 0x9e,
-		0xc9, 0xca, 0x79, 0xdd, 0xf5, 0xaf, 0x44, 0xcb, 0x9f, 0x4b, 0x42, 0x31, 0xfa, 0x58, 0x02, 0xd5,
-		0x20, 0x6b, 0x58, 0x9a, 0x4a, 0x5d, 0x8b, 0x3d, 0x13, 0x3b, 0x13, 0xf3, 0x24, 0x63, 0x69, 0x8d,
-		0xeb, 0xcb, 0x3e, 0x72, 0xee, 0xdf, 0x24, 0x20, 0x2b, 0xc4, 0xe8, 0x38, 0xa4, 0x6d, 0xd5, 0xdb,
-		0xa3, 0x74, 0xe3, 0xcb, 0x49, 0x29, 0x21, 0xd3, 0x32, 0x91, 0xbb, 0xb6, 0x6a, 0x52, 0x17, 0xe0,
-		0x72, 0x52, 0x26, 0xf3, 0x6a, 0x60, 0xb5, 0x45, 0x8f, 0x41, 0x56, 0xa7, 0x83, 0x4d, 0xcf, 0x15,
-		0xf3, 0xca, 0xe5, 0x2b, 0x5c, 0x8c, 0x9e, 0x86, 0x69, 0xcf, 0x51, 0x75, 0x23, 0xa2, 0x9b, 0xa6,
-		0xba, 0x92, 0xa8, 0xf0, 0x95, 0x2b, 0x70, 0x52, 0xf0, 0xb6, 0xb0, 0xa7, 0x6a, 0x7b, 0xb8, 0x15,
-		0x80, 0x32, 0xf4, 0xba, 0xe3, 0x04, 0x57, 0xa8, 0xf1, 0x7a, 0x81, 0x2d, 0x7f, 0x3d, 0x01, 0xd3,
-		0xe2, 0xe0, 0xd6, 0xf2, 0x8d, 0xb5, 0x0e, 0xa0, 0x9a, 0xa6, 0xe5, 0x85, 0xcd, 0xd5, 0xef, 0xca,
-		0x7d, 0xb8, 0xa5, 0xaa, 0x0f, 0x92, 0x43, 0x04, 0x73, 0x1d, 0x80, 0xa0, 0x66, 0xa8, 0xd9, 0x16,
-		0x20, 0xcf, 0x9f, 0x39, 0xd1, 0x07, 0x97, 0xec, 0xa8, 0x0f, 0x4c, 0x44, 0x4e, 0x78, 0x68, 0x16,
-		0xc6, 0x77, 0x70, 0x5b, 0x37, 0xf9, 0x4d, 0x32, 0x2b, 0x88, 0x0b, 0x99, 0xb4, 0x7f, 0x21, 0xb3,
-		0xfc, 0xa7, 0x61, 0x46, 0xb3, 0x3a, 0xbd, 0xdd, 0x5d, 0x96, 0x7a, 0xae, 0x1b, 0xdc, 0x97, 0x13,
-		0xaf, 0x9f, 0xe7, 0x4a, 0x6d, 0xcb, 0x50, 0xcd, 0xf6, 0x92, 0xe5, 0xb4, 0x83, 0x07, 0xaf, 0x24,
-		0xe3, 0x71, 0x43, 0x8f, 0x5f, 0xed, 0x9d, 0xff, 0x9b, 0x48, 0xfc, 0x5c, 0x32, 0x75, 0xa3, 0xb1,
-		0xfc, 0xf9, 0xe4, 0xdc, 0x0d, 0x06, 0x6c, 0x08, 0x63, 0xc8, 0x78, 0xd7, 0xc0, 0x1a, 0x19, 0x20,
-		0xfc, 0xce, 0xd3, 0x30, 0xdb, 0xb6, 0xda, 0x16, 0x65, 0xba, 0x40, 0x7e, 0xf1, 0x27, 0xb7, 0x39,
-		0x5f, 0x3a, 0x17, 0xfb, 0x98, 0xb7, 0xb2, 0x01, 0x33, 0x5c, 0x59, 0xa1, 0x8f, 0x8e, 0xd8, 0xc1,
-		0x06, 0x1d, 0x7a, 0xab, 0x56, 0xfa, 0xa5, 0x6f, 0xd1, 0xed, 0x5b, 0x9e, 0xe6, 0x50, 0x52, 0xc7,
-		0xce, 0x3e, 0x15, 0x19, 0x8e, 0x45, 0xf8, 0xd8, 0x22, 0xc5, 0x4e, 0x0c, 0xe3, 0x3f, 0xe7, 0x8c,
-		0x33, 0x21, 0xc6, 0x26, 0x87, 0x56, 0x56, 0x60, 0xf2, 0x28, 0x5c, 0xff, 0x82, 0x73, 0x15, 0x70,
-		0x98, 0xe4, 0x06, 0x4c, 0x51, 0x12, 0xad, 0xeb, 0x7a, 0x56, 0x87, 0x46, 0xc0, 0xc3, 0x69, 0xfe,
-		0xe5, 0xb7, 0xd8, 0xaa, 0x29, 0x12, 0xd8, 0x8a, 0x8f, 0xaa, 0x54, 0x80, 0x3e, 0x2d, 0x6b, 0x61,
-		0xcd, 0x88, 0x61, 0xf8, 0x2a, 0xef, 0x88, 0xaf, 0x5f, 0xb9, 0x0d, 0xb3, 0xe4, 0x37, 0x0d, 0x50,
-		0xe1, 0x9e, 0xc4, 0x5f, 0xc1, 0x95, 0xbe, 0xfe, 0x11, 0xb6, 0x30, 0x67, 0x7c, 0x82, 0x50, 0x9f,
-		0x42, 0xb3, 0xd8, 0xc6, 0x9e, 0x87, 0x1d, 0x57, 0x51, 0x8d, 0x41, 0xdd, 0x0b, 0xdd, 0x61, 0x94,
-		0x7e, 0xe6, 0x3b, 0xd1, 0x59, 0xbc, 0xc1, 0x90, 0x55, 0xc3, 0xa8, 0x6c, 0xc3, 0x89, 0x01, 0x5e,
-		0x31, 0x02, 0xe7, 0x27, 0x38, 0xe7, 0x6c, 0x9f, 0x67, 0x10, 0xda, 0x06, 0x08, 0xb9, 0x3f, 0x97,
-		0x23, 0x70, 0xfe, 0x2c, 0xe7, 0x44, 0x1c, 0x2b, 0xa6, 0x94, 0x30, 0xde, 0x84, 0xe9, 0x3b, 0xd8,
-		0xd9, 0xb1, 0x5c, 0x7e, 0x6f, 0x34, 0x02, 0xdd, 0x27, 0x39, 0xdd, 0x14, 0x07, 0xd2, 0x8b, 0x24,
-		0xc2, 0x75, 0x15, 0xb2, 0xbb, 0xaa, 0x86, 0x47, 0xa0, 0x78, 0xc0, 0x29, 0x26, 0x88, 0x3e, 0x81,
-		0x56, 0xa1, 0xd0, 0xb6, 0xf8, 0x1e, 0x15, 0x0f, 0xff, 0x14, 0x87, 0xe7, 0x05, 0x86, 0x53, 0xd8,
-		0x96, 0xdd, 0x35, 0xc8, 0x06, 0x16, 0x4f, 0xf1, 0xd7, 0x04, 0x85, 0xc0, 0x70, 0x8a, 0x23, 0x98,
-		0xf5, 0xd3, 0x82, 0xc2, 0x0d, 0xd9, 0xf3, 0x25, 0xc8, 0x5b, 0xa6, 0x71, 0x60, 0x99, 0xa3, 0x74,
-		0xe2, 0x33, 0x9c, 0x01, 0x38, 0x84, 0x10, 0x5c, 0x83, 0xdc, 0xa8, 0x13, 0xf1, 0x0b, 0xdf, 0x11,
-		0xcb, 0x43, 0xcc, 0xc0, 0x0d, 0x98, 0x12, 0x01, 0x4a, 0xb7, 0xcc, 0x11, 0x28, 0xfe, 0x3a, 0xa7,
-		0x28, 0x86, 0x60, 0x7c, 0x18, 0x1e, 0x76, 0xbd, 0x36, 0x1e, 0x85, 0xe4, 0x73, 0x62, 0x18, 0x1c,
-		0xc2, 0x4d, 0xb9, 0x83, 0x4d, 0x6d, 0x6f, 0x34, 0x86, 0x5f, 0x14, 0xa6, 0x14, 0x18, 0x42, 0xb1,
-		0x02, 0x93, 0x1d, 0xd5, 0x71, 0xf7, 0x54, 0x63, 0xa4, 0xe9, 0xf8, 0x1b, 0x9c, 0xa3, 0xe0, 0x83,
-		0xb8, 0x45, 0xba, 0xe6, 0x51, 0x68, 0x3e, 0x2f, 0x2c, 0x12, 0x82, 0xf1, 0xa5, 0xe7, 0x7a, 0xf4,
-		0x92, 0xed, 0x28, 0x6c, 0x7f, 0x53, 0x2c, 0x3d, 0x86, 0x5d, 0x0f, 0x33, 0x5e, 0x83, 0x9c, 0xab,
-		0xbf, 0x39, 0x12, 0xcd, 0x17, 0xc4, 0x4c, 0x53, 0x00, 0x01, 0xbf, 0x06, 0x27, 0x07, 0x6e, 0x13,
-		0x23, 0x90, 0xfd, 0x2d, 0x4e, 0x76, 0x7c, 0xc0, 0x56, 0xc1, 0x43, 0xc2, 0x51, 0x29, 0xff, 0xb6,
-		0x08, 0x09, 0xb8, 0x87, 0xab, 0x41, 0x4e, 0x0d, 0xae, 0xba, 0x7b, 0x34, 0xab, 0xfd, 0x1d, 0x61,
-		0x35, 0x86, 0x8d, 0x58, 0x6d, 0x0b, 0x8e, 0x73, 0xc6, 0xa3, 0xcd, 0xeb, 0xdf, 0x15, 0x81, 0x95,
-		0xa1, 0xb7, 0xa3, 0xb3, 0xfb, 0xc3, 0x30, 0xe7, 0x9b, 0x53, 0xa4, 0xa7, 0xae, 0xd2, 0x51, 0xed,
-		0x11, 0x98, 0x7f, 0x89, 0x33, 0x8b, 0x88, 0xef, 0xe7, 0xb7, 0xee, 0xba, 0x6a, 0x13, 0xf2, 0x57,
-		0xa1, 0x24, 0xc8, 0xbb, 0xa6, 0x83, 0x35, 0xab, 0x6d, 0xea, 0x6f, 0xe2, 0xd6, 0x08, 0xd4, 0xbf,
-		0xdc, 0x33, 0x55, 0xdb, 0x21, 0x38, 0x61, 0x5e, 0x05, 0xc9, 0xcf, 0x55, 0x14, 0xbd, 0x63, 0x5b,
-		0x8e, 0x17, 0xc3, 0xf8, 0x45, 0x31, 0x53, 0x3e, 0x6e, 0x95, 0xc2, 0x2a, 0x75, 0x60, 0x4f, 0x9e,
-		0x47, 0x75, 0xc9, 0x2f, 0x71, 0xa2, 0xc9, 0x00, 0xc5, 0x03, 0x87, 0x66, 0x75, 0x6c, 0xd5, 0x19,
-		0x25, 0xfe, 0xfd, 0x3d, 0x11, 0x38, 0x38, 0x84, 0x07, 0x0e, 0x92, 0xd1, 0x91, 0xdd, 0x7e, 0x04,
-		0x86, 0x2f, 0x8b, 0xc0, 0x21, 0x30, 0x9c, 0x42, 0x24, 0x0c, 0x23, 0x50, 0xfc, 0x7d, 0x41, 0x21,
-		0x30, 0x84, 0xe2, 0x43, 0xc1, 0x46, 0xeb, 0xe0, 0xb6, 0xee, 0x7a, 0x0e, 0x4b, 0x8a, 0x0f, 0xa7,
-		0xfa, 0x07, 0xdf, 0x89, 0x26, 0x61, 0x72, 0x08, 0x4a, 0x22, 0x11, 0xbf, 0x76, 0xa5, 0x67, 0xa6,
-		0xf8, 0x8e, 0xfd, 0x8a, 0x88, 0x44, 0x21, 0x18, 0xe9, 0x5b, 0x28, 0x43, 0x24, 0x66, 0xd7, 0xc8,
-		0x49, 0x61, 0x04, 0xba, 0x7f, 0xd8, 0xd3, 0xb9, 0xa6, 0xc0, 0x12, 0xce, 0x50, 0xfe, 0xd3, 0x35,
-		0xf7, 0xf1, 0xc1, 0x48, 0xde, 0xf9, 0x8f, 0x7a, 0xf2, 0x9f, 0x6d, 0x86, 0x64, 0x31, 0x64, 0xaa,
-		0x27, 0x9f, 0x42, 0x71, 0xef, 0x19, 0x95, 0x7e, 0xf4, 0x5d, 0x3e, 0xde, 0x68, 0x3a, 0x55, 0x59,
-		0x23, 0x4e, 0x1e, 0x4d, 0x7a, 0xe2, 0xc9, 0x3e, 0xf2, 0xae, 0xef, 0xe7, 0x91, 0x9c, 0xa7, 0x72,
-		0x1d, 0x26, 0x23, 0x09, 0x4f, 0x3c, 0xd5, 0x9f, 0xe5, 0x54, 0x85, 0x70, 0xbe, 0x53, 0xb9, 0x04,
-		0x69, 0x92, 0xbc, 0xc4, 0xc3, 0xff, 0x1c, 0x87, 0x53, 0xf5, 0xca, 0x07, 0x20, 0x2b, 0x92, 0x96,
-		0x78, 0xe8, 0x8f, 0x71, 0xa8, 0x0f, 0x21, 0x70, 0x91, 0xb0, 0xc4, 0xc3, 0xff, 0xbc, 0x80, 0x0b,
-		0x08, 0x81, 0x8f, 0x6e, 0xc2, 0xaf, 0xfc, 0x85, 0x34, 0xdf, 0x74, 0x84, 0xed, 0xae, 0xc1, 0x04,
-		0xcf, 0x54, 0xe2, 0xd1, 0x3f, 0xce, 0x1b, 0x17, 0x88, 0xca, 0x15, 0x18, 0x1f, 0xd1, 0xe0, 0x7f,
-		0x91, 0x43, 0x99, 0x7e, 0x65, 0x05, 0xf2, 0xa1, 0xec, 0x24, 0x1e, 0xfe, 0x13, 0x1c, 0x1e, 0x46,
-		0x91, 0xae, 0xf3, 0xec, 0x24, 0x9e, 0xe0, 0x2f, 0x89, 0xae, 0x73, 0x04, 0x31, 0x9b, 0x48, 0x4c,
-		0xe2, 0xd1, 0x1f, 0x13, 0x56, 0x17, 0x90, 0xca, 0x4b, 0x90, 0xf3, 0x37, 0x9b, 0x78, 0xfc, 0x4f,
-		0x72, 0x7c, 0x80, 0x21, 0x16, 0x08, 0x6d, 0x76, 0xf1, 0x14, 0x7f, 0x59, 0x58, 0x20, 0x84, 0x22,
-		0xcb, 0xa8, 0x37, 0x81, 0x89, 0x67, 0xfa, 0x29, 0xb1, 0x8c, 0x7a, 0xf2, 0x17, 0x32, 0x9b, 0x34,
-		0xe6, 0xc7, 0x53, 0xfc, 0x15, 0x31, 0x9b, 0x54, 0x9f, 0x74, 0xa3, 0x37, 0x23, 0x88, 0xe7, 0xf8,
-		0x69, 0xd1, 0x8d, 0x9e, 0x84, 0xa0, 0xd2, 0x00, 0xd4, 0x9f, 0x0d, 0xc4, 0xf3, 0x7d, 0x9c, 0xf3,
-		0x4d, 0xf7, 0x25, 0x03, 0x95, 0x57, 0xe0, 0xf8, 0xe0, 0x4c, 0x20, 0x9e, 0xf5, 0x67, 0xde, 0xed,
-		0x39, 0xbb, 0x85, 0x13, 0x81, 0xca, 0x56, 0xb0, 0xa5, 0x84, 0xb3, 0x80, 0x78, 0xda, 0x4f, 0xbc,
-		0x1b, 0x0d, 0xdc, 0xe1, 0x24, 0xa0, 0x52, 0x05, 0x08, 0x36, 0xe0, 0x78, 0xae, 0x4f, 0x72, 0xae,
-		0x10, 0x88, 0x2c, 0x0d, 0xbe, 0xff, 0xc6, 0xe3, 0x1f, 0x88, 0xa5, 0xc1, 0x11, 0x64, 0x69, 0x88,
-		0xad, 0x37, 0x1e, 0xfd, 0x29, 0xb1, 0x34, 0x04, 0x84, 0x78, 0x76, 0x68, 0x77, 0x8b, 0x67, 0xf8,
-		0x8c, 0xf0, 0xec, 0x10, 0xaa, 0xb2, 0x01, 0xd3, 0x7d, 0x1b, 0x62, 0x3c, 0xd5, 0xcf, 0x71, 0x2a,
-		0xa9, 0x77, 0x3f, 0x0c, 0x6f, 0x5e, 0x7c, 0x33, 0x8c, 0x67, 0xfb, 0x6c, 0xcf, 0xe6, 0xc5, 0xf7,
-		0xc2, 0xca, 0x35, 0xc8, 0x9a, 0x5d, 0xc3, 0x20, 0x8b, 0x07, 0x1d, 0xfe, 0x6e, 0x60, 0xe9, 0xbf,
-		0x7d, 0x97, 0x5b, 0x47, 0x00, 0x2a, 0x97, 0x60, 0x1c, 0x77, 0x76, 0x70, 0x2b, 0x0e, 0xf9, 0xdb,
-		0xdf, 0x15, 0x01, 0x93, 0x68, 0x57, 0x5e, 0x02, 0x60, 0x57, 0x23, 0xf4, 0x61, 0x60, 0x0c, 0xf6,
-		0xbf, 0x7f, 0x97, 0xbf, 0x8c, 0x13, 0x40, 0x02, 0x02, 0xf6, 0x6a, 0xcf, 0xe1, 0x04, 0xdf, 0x89,
-		0x12, 0xd0, 0x19, 0xb9, 0x0a, 0x13, 0x6f, 0xb8, 0x96, 0xe9, 0xa9, 0xed, 0x38, 0xf4, 0xef, 0x70,
-		0xb4, 0xd0, 0x27, 0x06, 0xeb, 0x58, 0x0e, 0xf6, 0xd4, 0xb6, 0x1b, 0x87, 0xfd, 0x1f, 0x1c, 0xeb,
-		0x03, 0x08, 0x58, 0x53, 0x5d, 0x6f, 0x94, 0x71, 0xff, 0xae, 0x00, 0x0b, 0x00, 0xe9, 0x34, 0xf9,
-		0xbd, 0x8f, 0x0f, 0xe2, 0xb0, 0xbf, 0x27, 0x3a, 0xcd, 0xf5, 0x2b, 0x1f, 0x80, 0x1c, 0xf9, 0xc9,
-		0xde, 0xb0, 0x8b, 0x01, 0xff, 0x4f, 0x0e, 0x0e, 0x10, 0xa4, 0x65, 0xd7, 0x6b, 0x79, 0x7a, 0xbc,
-		0xb1, 0x7f, 0x9f, 0xcf, 0xb4, 0xd0, 0xaf, 0x54, 0x21, 0xef, 0x7a, 0xad, 0x56, 0x97, 0xe7, 0xa7,
-		0x31, 0xf0, 0xff, 0xf5, 0x5d, 0xff, 0xca, 0xc2, 0xc7, 0x90, 0xd9, 0xbe, 0xbb, 0xef, 0xd9, 0x16,
-		0x7d, 0xe0, 0x11, 0xc7, 0xf0, 0x2e, 0x67, 0x08, 0x41, 0x2a, 0x2b, 0x50, 0x20, 0x63, 0x71, 0xb0,
-		0x8d, 0xe9, 0xd3, 0xa9, 0x18, 0x8a, 0xff, 0xcd, 0x0d, 0x10, 0x01, 0x2d, 0xff, 0x89, 0xaf, 0xbe,
-		0x33, 0x9f, 0xf8, 0xda, 0x3b, 0xf3, 0x89, 0x6f, 0xbc, 0x33, 0x9f, 0xf8, 0xd8, 0x37, 0xe7, 0xc7,
-		0xbe, 0xf6, 0xcd, 0xf9, 0xb1, 0xdf, 0xfc, 0xe6, 0xfc, 0xd8, 0xe0, 0x5b, 0x62, 0xb8, 0x61, 0xdd,
-		0xb0, 0xd8, 0xfd, 0xf0, 0xeb, 0x4f, 0xb4, 0x75, 0x6f, 0xaf, 0xbb, 0xb3, 0xa4, 0x59, 0x9d, 0x0b,
-		0x9a, 0xe5, 0x76, 0x2c, 0xf7, 0x42, 0xf4, 0x5e, 0x97, 0xfe, 0x82, 0xef, 0x25, 0xc8, 0x99, 0x39,
-		0x7a, 0x9d, 0xab, 0x9a, 0x07, 0xc3, 0x3e, 0xd7, 0xb9, 0x0c, 0xa9, 0xaa, 0x79, 0x80, 0x4e, 0xb2,
-		0x00, 0xa7, 0x74, 0x1d, 0x83, 0xbf, 0xe6, 0x35, 0x41, 0xca, 0xdb, 0x8e, 0x81, 0x66, 0x83, 0x77,
-		0x31, 0x13, 0x67, 0x0a, 0xfc, 0x05, 0xcb, 0xe5, 0x9f, 0x48, 0x1c, 0x6d, 0x24, 0xd9, 0xaa, 0x79,
-		0x40, 0x07, 0xd2, 0x48, 0xbc, 0xfe, 0x4c, 0xec, 0x3d, 0xf7, 0xbe, 0x69, 0xdd, 0x35, 0x49, 0xb7,
-		0xed, 0x1d, 0x71, 0xc7, 0x3d, 0xdf, 0x7b, 0xc7, 0xfd, 0x0a, 0x36, 0x8c, 0x5b, 0x44, 0x6f, 0x8b,
-		0x40, 0x76, 0x32, 0xec, 0x8d, 0x62, 0xf8, 0xa9, 0x24, 0xcc, 0xf7, 0x5d, 0x67, 0x73, 0x27, 0x18,
-		0x66, 0x84, 0x0a, 0x64, 0x6b, 0xc2, 0xb7, 0x4a, 0x30, 0xe1, 0x62, 0xcd, 0x32, 0x5b, 0x2e, 0x35,
-		0x44, 0x4a, 0x16, 0x45, 0x62, 0x08, 0x53, 0x35, 0x2d, 0x97, 0xbf, 0x28, 0xc9, 0x0a, 0xcb, 0x3f,
-		0x7b, 0x44, 0x43, 0x4c, 0x8a, 0x96, 0x84, 0x35, 0x9e, 0x1b, 0xd1, 0x1a, 0x62, 0x10, 0x91, 0x9b,
-		0xff, 0x51, 0xad, 0xf2, 0xd3, 0x49, 0x58, 0xe8, 0xb5, 0x0a, 0x59, 0x59, 0xae, 0xa7, 0x76, 0xec,
-		0x61, 0x66, 0xb9, 0x06, 0xb9, 0x2d, 0xa1, 0x73, 0x64, 0xbb, 0x3c, 0x38, 0xa2, 0x5d, 0x8a, 0x7e,
-		0x53, 0xc2, 0x30, 0x17, 0x47, 0x34, 0x8c, 0x3f, 0x8e, 0x87, 0xb2, 0xcc, 0xff, 0xcb, 0xc0, 0x49,
-		0xb6, 0x9c, 0x14, 0xb6, 0x94, 0x58, 0x81, 0xdb, 0xa4, 0x10, 0xae, 0x8a, 0x7f, 0x4e, 0x52, 0xbe,
-		0x05, 0x33, 0xab, 0x24, 0x5a, 0x90, 0x53, 0x50, 0xf0, 0x84, 0x67, 0xe0, 0xbb, 0xa4, 0x8b, 0x91,
-		0x84, 0x9f, 0x3f, 0x61, 0x0a, 0x8b, 0xca, 0x3f, 0x9a, 0x00, 0xa9, 0xa9, 0xa9, 0x86, 0xea, 0xfc,
-		0x41, 0xa9, 0xd0, 0x15, 0x00, 0xfa, 0x0d, 0x52, 0xf0, 0xd1, 0x50, 0xf1, 0x62, 0x69, 0x29, 0x3c,
-		0xb8, 0x25, 0xd6, 0x12, 0xfd, 0x22, 0x21, 0x47, 0x75, 0xc9, 0xcf, 0x73, 0xaf, 0x02, 0x04, 0x15,
-		0xe8, 0x14, 0x9c, 0x68, 0xae, 0x54, 0xd7, 0xaa, 0xb2, 0xc2, 0x5e, 0x6e, 0xdf, 0x68, 0x36, 0xea,
-		0x2b, 0xab, 0xd7, 0x57, 0xeb, 0x35, 0x69, 0x0c, 0x1d, 0x07, 0x14, 0xae, 0xf4, 0xdf, 0x4b, 0x39,
-		0x06, 0xd3, 0x61, 0x39, 0x7b, 0x43, 0x3e, 0x49, 0x32, 0x45, 0xbd, 0x63, 0x1b, 0x98, 0x3e, 0xfa,
-		0x53, 0x74, 0x61, 0xb5, 0xf8, 0x24, 0xe4, 0x5f, 0xfd, 0x5b, 0xf6, 0xd6, 0xf4, 0x4c, 0x00, 0xf7,
-		0x6d, 0x5e, 0x59, 0x83, 0x69, 0x55, 0xd3, 0xb0, 0x1d, 0xa1, 0x8c, 0x09, 0xd5, 0x84, 0x90, 0x3e,
-		0xcc, 0xe4, 0xc8, 0x80, 0xed, 0x0a, 0x64, 0x5c, 0x3a, 0xfa, 0x38, 0x8a, 0x5f, 0xe3, 0x14, 0x5c,
-		0xbd, 0x62, 0xc2, 0x34, 0xc9, 0xfc, 0x54, 0x07, 0x87, 0xba, 0x71, 0xf8, 0x3d, 0xc3, 0x3f, 0xfe,
-		0xe2, 0xb3, 0xf4, 0xd1, 0xe6, 0xe3, 0xd1, 0x69, 0x19, 0xe0, 0x4e, 0xb2, 0xc4, 0xb9, 0x83, 0x8e,
-		0x62, 0x28, 0x8a, 0xf6, 0x78, 0x87, 0x0f, 0x6f, 0xec, 0x9f, 0xf0, 0xc6, 0xe6, 0x07, 0xf9, 0x40,
-		0xa8, 0xa5, 0x49, 0xce, 0xca, 0x2a, 0x96, 0xeb, 0xc3, 0xd6, 0xf4, 0xeb, 0x4f, 0xf7, 0xef, 0x4e,
-		0xec, 0xcf, 0x79, 0xca, 0x7c, 0x2d, 0xdc, 0x8c, 0xbf, 0xf6, 0x7e, 0x23, 0x05, 0xf3, 0x5c, 0x79,
-		0x47, 0x75, 0xf1, 0x85, 0x3b, 0xcf, 0xed, 0x60, 0x4f, 0x7d, 0xee, 0x82, 0x66, 0xe9, 0x22, 0x56,
-		0xcf, 0xf0, 0xe5, 0x48, 0xea, 0x97, 0x78, 0xfd, 0xdc, 0xc0, 0x07, 0x9a, 0x73, 0xc3, 0x97, 0x71,
-		0x79, 0x1b, 0xd2, 0x2b, 0x96, 0x6e, 0x92, 0x50, 0xd5, 0xc2, 0xa6, 0xd5, 0xe1, 0xab, 0x87, 0x15,
-		0xd0, 0x73, 0x90, 0x51, 0x3b, 0x56, 0xd7, 0xf4, 0xd8, 0xca, 0x59, 0x3e, 0xf9, 0xd5, 0xb7, 0x17,
-		0xc6, 0xfe, 0xfd, 0xdb, 0x0b, 0xa9, 0x55, 0xd3, 0xfb, 0xf5, 0x2f, 0x9d, 0x07, 0x4e, 0xb5, 0x6a,
-		0x7a, 0x32, 0x57, 0xac, 0xa4, 0xbf, 0xfd, 0xe9, 0x85, 0x44, 0xf9, 0x55, 0x98, 0xa8, 0x61, 0xed,
-		0x61, 0x98, 0x6b, 0x58, 0x0b, 0x31, 0xd7, 0xb0, 0xd6, 0xc3, 0x7c, 0x05, 0xb2, 0xab, 0xa6, 0xc7,
-		0x5e, 0x44, 0x7f, 0x1a, 0x52, 0xba, 0xc9, 0xde, 0x6d, 0x3c, 0xb4, 0x6f, 0x44, 0x8b, 0x00, 0x6b,
-		0x58, 0xf3, 0x81, 0x2d, 0xac, 0xf5, 0x02, 0xfb, 0x9b, 0x26, 0x5a, 0xcb, 0xb5, 0xdf, 0xfc, 0xcf,
-		0xf3, 0x63, 0x6f, 0xbd, 0x33, 0x3f, 0x36, 0x74, 0x8a, 0xcb, 0x43, 0xa7, 0xd8, 0x6d, 0xed, 0xb3,
-		0x88, 0xec, 0xcf, 0xec, 0xe7, 0xd3, 0x70, 0x9a, 0x7e, 0x9f, 0xe4, 0x74, 0x74, 0xd3, 0xbb, 0xa0,
-		0x39, 0x07, 0xb6, 0x67, 0x91, 0xb8, 0x69, 0xed, 0xf2, 0x89, 0x9d, 0x0e, 0xaa, 0x97, 0x58, 0xf5,
-		0xe0, 0x69, 0x2d, 0xef, 0xc2, 0x78, 0x83, 0xe0, 0x88, 0x89, 0x3d, 0xcb, 0x53, 0x0d, 0xbe, 0xff,
-		0xb0, 0x02, 0x91, 0xb2, 0x6f, 0x9a, 0x92, 0x4c, 0xaa, 0x8b, 0xcf, 0x99, 0x0c, 0xac, 0xee, 0xb2,
-		0x57, 0xc3, 0x53, 0x34, 0x71, 0xc9, 0x12, 0x01, 0x7d, 0x0b, 0x7c, 0x16, 0xc6, 0xd5, 0x2e, 0x7b,
-		0x87, 0x21, 0x45, 0x32, 0x1a, 0x5a, 0x28, 0xdf, 0x82, 0x09, 0xfe, 0x24, 0x15, 0x49, 0x90, 0xda,
-		0xc7, 0x07, 0xb4, 0x9d, 0x82, 0x4c, 0x7e, 0xa2, 0x25, 0x18, 0xa7, 0x9d, 0xe7, 0xdf, 0xbc, 0x94,
-		0x96, 0xfa, 0x7a, 0xbf, 0x44, 0x3b, 0x29, 0x33, 0xb5, 0xf2, 0x4d, 0xc8, 0xd6, 0xac, 0x8e, 0x6e,
-		0x5a, 0x51, 0xb6, 0x1c, 0x63, 0xa3, 0x7d, 0xb6, 0xbb, 0xdc, 0x2b, 0x64, 0x56, 0x40, 0xc7, 0x21,
-		0xc3, 0x3e, 0x15, 0xe0, 0xef, 0x61, 0xf0, 0x52, 0x79, 0x05, 0x26, 0x28, 0xf7, 0xa6, 0x4d, 0x82,
-		0xbf, 0xff, 0x56, 0x66, 0x8e, 0x7f, 0x38, 0xc6, 0xe9, 0x93, 0x41, 0x67, 0x11, 0xa4, 0x5b, 0xaa,
-		0xa7, 0xf2, 0x71, 0xd3, 0xdf, 0xe5, 0x0f, 0x42, 0x96, 0x93, 0xb8, 0xe8, 0x22, 0xa4, 0x2c, 0xdb,
-		0xe5, 0x6f, 0x52, 0xcc, 0x0d, 0x1b, 0xca, 0xa6, 0xbd, 0x9c, 0x26, 0x3e, 0x23, 0x13, 0xe5, 0x65,
-		0x79, 0xa8, 0x5b, 0xbc, 0x18, 0x72, 0x8b, 0xd0, 0x94, 0x87, 0x7e, 0xb2, 0x29, 0xed, 0x73, 0x07,
-		0xdf, 0x59, 0x3e, 0x93, 0x84, 0xf9, 0x50, 0xed, 0x1d, 0xec, 0xb8, 0xba, 0x65, 0x32, 0x8f, 0xe2,
-		0xde, 0x82, 0x42, 0x9d, 0xe4, 0xf5, 0x43, 0xdc, 0xe5, 0x03, 0x90, 0xaa, 0xda, 0x36, 0x9a, 0x83,
-		0x2c, 0x2d, 0x6b, 0x16, 0xf3, 0x97, 0xb4, 0xec, 0x97, 0x49, 0x9d, 0x6b, 0xed, 0x7a, 0x77, 0x55,
-		0xc7, 0xff, 0x9a, 0x4e, 0x94, 0xcb, 0x57, 0x21, 0xb7, 0x62, 0x99, 0x2e, 0x36, 0xdd, 0x2e, 0xcd,
-		0x6c, 0x76, 0x0c, 0x4b, 0xdb, 0xe7, 0x0c, 0xac, 0x40, 0x0c, 0xae, 0xda, 0x36, 0x45, 0xa6, 0x65,
-		0xf2, 0x93, 0xad, 0xd9, 0xe5, 0xe6, 0x50, 0x13, 0x5d, 0x3d, 0xba, 0x89, 0xf8, 0x20, 0x7d, 0x1b,
-		0x7d, 0x2f, 0x01, 0x8f, 0xf5, 0x2f, 0xa8, 0x7d, 0x7c, 0xe0, 0x1e, 0x75, 0x3d, 0xbd, 0x0a, 0xb9,
-		0x06, 0xfd, 0xa4, 0xfd, 0x16, 0x3e, 0x40, 0x73, 0x30, 0x81, 0x5b, 0x17, 0x2f, 0x5d, 0x7a, 0xee,
-		0x2a, 0xf3, 0xf6, 0x97, 0xc7, 0x64, 0x21, 0x40, 0xf3, 0x90, 0x73, 0xb1, 0x66, 0x5f, 0xbc, 0x74,
-		0x79, 0xff, 0x39, 0xe6, 0x5e, 0x2f, 0x8f, 0xc9, 0x81, 0xa8, 0x92, 0x25, 0xa3, 0xfe, 0xf6, 0x67,
-		0x16, 0x12, 0xcb, 0xe3, 0x90, 0x72, 0xbb, 0x9d, 0xf7, 0xd4, 0x47, 0x3e, 0x31, 0x0e, 0x8b, 0x61,
-		0x24, 0xcd, 0xff, 0xee, 0xa8, 0x86, 0xde, 0x52, 0x83, 0x7f, 0x46, 0x20, 0x85, 0x6c, 0x40, 0x35,
-		0x86, 0xec, 0x14, 0x87, 0x5a, 0xb2, 0xfc, 0xcb, 0x09, 0x28, 0xdc, 0x16, 0xcc, 0x4d, 0xec, 0xa1,
-		0x6b, 0x00, 0x7e, 0x4b, 0x62, 0xd9, 0x9c, 0x5a, 0xea, 0x6d, 0x6b, 0xc9, 0xc7, 0xc8, 0x21, 0x75,
-		0x74, 0x85, 0x3a, 0xa2, 0x6d, 0xb9, 0xfc, 0x0b, 0xab, 0x18, 0xa8, 0xaf, 0x8c, 0x9e, 0x01, 0x44,
-		0x23, 0x9c, 0x72, 0xc7, 0xf2, 0x74, 0xb3, 0xad, 0xd8, 0xd6, 0x5d, 0xfe, 0xdd, 0x6a, 0x4a, 0x96,
-		0x68, 0xcd, 0x6d, 0x5a, 0xd1, 0x20, 0x72, 0xd2, 0xe9, 0x9c, 0xcf, 0x42, 0x92, 0x75, 0xb5, 0xd5,
-		0x72, 0xb0, 0xeb, 0xf2, 0x20, 0x26, 0x8a, 0xe8, 0x1a, 0x4c, 0xd8, 0xdd, 0x1d, 0x45, 0x44, 0x8c,
-		0xfc, 0xc5, 0xc7, 0x06, 0xad, 0x7f, 0xe1, 0x1f, 0x3c, 0x02, 0x64, 0xec, 0xee, 0x0e, 0xf1, 0x96,
-		0xc7, 0xa1, 0x30, 0xa0, 0x33, 0xf9, 0x3b, 0x41, 0x3f, 0xe8, 0x7f, 0x52, 0xe0, 0x23, 0x50, 0x6c,
-		0x47, 0xb7, 0x1c, 0xdd, 0x3b, 0xa0, 0xaf, 0x43, 0xa5, 0x64, 0x49, 0x54, 0x34, 0xb8, 0xbc, 0xbc,
-		0x0f, 0x53, 0x4d, 0x9a, 0xc4, 0x05, 0x3d, 0xbf, 0x14, 0xf4, 0x2f, 0x11, 0xdf, 0xbf, 0xa1, 0x3d,
-		0x4b, 0xf6, 0xf5, 0x6c, 0xf9, 0x43, 0x43, 0xbd, 0xf3, 0xca, 0xd1, 0xbd, 0x33, 0xba, 0xdb, 0xfd,
-		0xee, 0xc9, 0xc8, 0xe2, 0x64, 0xce, 0x19, 0x0e, 0x5f, 0xa3, 0x3a, 0x66, 0xdc, 0x19, 0x6d, 0xee,
-		0xf0, 0x4d, 0x75, 0x2e, 0x26, 0x8c, 0xce, 0xc5, 0x2e, 0xa1, 0xf2, 0x55, 0x98, 0x6c, 0xa8, 0x8e,
-		0xd7, 0xc4, 0xde, 0xcb, 0x58, 0x6d, 0x61, 0x27, 0xba, 0xeb, 0x4e, 0x8a, 0x5d, 0x17, 0x41, 0x9a,
-		0x6e, 0xad, 0x6c, 0xd7, 0xa1, 0xbf, 0xcb, 0x7b, 0x90, 0xa6, 0xaf, 0x44, 0xfa, 0x3b, 0x32, 0x47,
-		0xb0, 0x1d, 0x99, 0xc4, 0xd2, 0x03, 0x0f, 0xbb, 0xe2, 0x1a, 0x81, 0x16, 0xd0, 0x0b, 0x62, 0x5f,
-		0x4d, 0x1d, 0xbe, 0xaf, 0x72, 0x47, 0xe4, 0xbb, 0xab, 0x01, 0x13, 0xcb, 0x24, 0x14, 0xaf, 0xd6,
-		0xfc, 0x8e, 0x24, 0x82, 0x8e, 0xa0, 0x75, 0x98, 0xb2, 0x55, 0xc7, 0xa3, 0x5f, 0x87, 0xec, 0xd1,
-		0x51, 0x70, 0x5f, 0x5f, 0xe8, 0x5f, 0x79, 0x91, 0xc1, 0xf2, 0x56, 0x26, 0xed, 0xb0, 0xb0, 0xfc,
-		0x5f, 0xd2, 0x90, 0xe1, 0xc6, 0xf8, 0x00, 0x4c, 0x70, 0xb3, 0x72, 0xef, 0x3c, 0xbd, 0xd4, 0xbf,
-		0x31, 0x2d, 0xf9, 0x1b, 0x08, 0xe7, 0x13, 0x18, 0xf4, 0x24, 0x64, 0xb5, 0x3d, 0x55, 0x37, 0x15,
-		0xbd, 0xc5, 0x13, 0xc2, 0xfc, 0x3b, 0x6f, 0x2f, 0x4c, 0xac, 0x10, 0xd9, 0x6a, 0x4d, 0x9e, 0xa0,
-		0x95, 0xab, 0x2d, 0x92, 0x09, 0xec, 0x61, 0xbd, 0xbd, 0xe7, 0xf1, 0x15, 0xc6, 0x4b, 0xe8, 0x45,
-		0x48, 0x13, 0x87, 0xe0, 0xdf, 0x0e, 0xce, 0xf5, 0x65, 0xf8, 0xfe, 0x11, 0x7a, 0x39, 0x4b, 0x1a,
-		0xfe, 0xd8, 0x7f, 0x5a, 0x48, 0xc8, 0x14, 0x81, 0x56, 0x60, 0xd2, 0x50, 0x5d, 0x4f, 0xa1, 0x3b,
-		0x18, 0x69, 0x7e, 0x9c, 0x52, 0x9c, 0xec, 0x37, 0x08, 0x37, 0x2c, 0xef, 0x7a, 0x9e, 0xa0, 0x98,
-		0xa8, 0x85, 0xce, 0x80, 0x44, 0x49, 0x34, 0xab, 0xd3, 0xd1, 0x3d, 0x96, 0x5b, 0x65, 0xa8, 0xdd,
-		0x8b, 0x44, 0xbe, 0x42, 0xc5, 0x34, 0xc3, 0x3a, 0x05, 0x39, 0xfa, 0xb5, 0x12, 0x55, 0x61, 0xef,
-		0xe1, 0x66, 0x89, 0x80, 0x56, 0x3e, 0x05, 0x53, 0x41, 0x7c, 0x64, 0x2a, 0x59, 0xc6, 0x12, 0x88,
-		0xa9, 0xe2, 0xb3, 0x30, 0x6b, 0xe2, 0x7b, 0xf4, 0xcd, 0xe0, 0x88, 0x76, 0x8e, 0x6a, 0x23, 0x52,
-		0x77, 0x3b, 0x8a, 0x78, 0x02, 0x8a, 0x9a, 0x30, 0x3e, 0xd3, 0x05, 0xaa, 0x3b, 0xe9, 0x4b, 0xa9,
-		0xda, 0x49, 0xc8, 0xaa, 0xb6, 0xcd, 0x14, 0xf2, 0x3c, 0x3e, 0xda, 0x36, 0xad, 0x3a, 0x07, 0xd3,
-		0x74, 0x8c, 0x0e, 0x76, 0xbb, 0x86, 0xc7, 0x49, 0x0a, 0x54, 0x67, 0x8a, 0x54, 0xc8, 0x4c, 0x4e,
-		0x75, 0xdf, 0x07, 0x93, 0xf8, 0x8e, 0xde, 0xc2, 0xa6, 0x86, 0x99, 0xde, 0x24, 0xd5, 0x2b, 0x08,
-		0x21, 0x55, 0x3a, 0x0b, 0x7e, 0xdc, 0x53, 0x44, 0x4c, 0x2e, 0x32, 0x3e, 0x21, 0xaf, 0x32, 0x71,
-		0xb9, 0x04, 0xe9, 0x9a, 0xea, 0xa9, 0x24, 0xc1, 0xf0, 0xee, 0xb1, 0x8d, 0xa6, 0x20, 0x93, 0x9f,
-		0xe5, 0x6f, 0x27, 0x21, 0x7d, 0xdb, 0xf2, 0x30, 0x7a, 0x3e, 0x94, 0x00, 0x16, 0x07, 0xf9, 0x73,
-		0x53, 0x6f, 0x9b, 0xb8, 0xb5, 0xee, 0xb6, 0x43, 0xff, 0x5a, 0x20, 0x70, 0xa7, 0x64, 0xc4, 0x9d,
-		0x66, 0x61, 0xdc, 0xb1, 0xba, 0x66, 0x4b, 0xbc, 0xc2, 0x4a, 0x0b, 0xa8, 0x0e, 0x59, 0xdf, 0x4b,
-		0xd2, 0x71, 0x5e, 0x32, 0x45, 0xbc, 0x84, 0xf8, 0x30, 0x17, 0xc8, 0x13, 0x3b, 0xdc, 0x59, 0x96,
-		0x21, 0xe7, 0x07, 0x2f, 0xee, 0x6d, 0xa3, 0x39, 0x6c, 0x00, 0x23, 0x9b, 0x89, 0x3f, 0xf7, 0xbe,
-		0xf1, 0x98, 0xc7, 0x49, 0x7e, 0x05, 0xb7, 0x5e, 0xc4, 0xad, 0xf8, 0xbf, 0x39, 0x98, 0xa0, 0xe3,
-		0x0a, 0xdc, 0x8a, 0xfd, 0xab, 0x83, 0xc7, 0x20, 0xe7, 0xea, 0x6d, 0x53, 0xf5, 0xba, 0x0e, 0xe6,
-		0x9e, 0x17, 0x08, 0xca, 0x5f, 0x49, 0x40, 0x86, 0x79, 0x72, 0xc8, 0x6e, 0x89, 0xc1, 0x76, 0x4b,
-		0x0e, 0xb3, 0x5b, 0xea, 0xe1, 0xed, 0x56, 0x05, 0xf0, 0x3b, 0xe3, 0xf2, 0xaf, 0xcf, 0x07, 0x64,
-		0x0c, 0xac, 0x8b, 0x4d, 0xbd, 0xcd, 0x17, 0x6a, 0x08, 0x54, 0xfe, 0x8f, 0x09, 0x92, 0xc4, 0xf2,
-		0x7a, 0x54, 0x85, 0x49, 0xd1, 0x2f, 0x65, 0xd7, 0x50, 0xdb, 0xdc, 0x77, 0x4e, 0x0f, 0xed, 0xdc,
-		0x75, 0x43, 0x6d, 0xcb, 0x79, 0xde, 0x1f, 0x52, 0x18, 0x3c, 0x0f, 0xc9, 0x21, 0xf3, 0x10, 0x99,
-		0xf8, 0xd4, 0xc3, 0x4d, 0x7c, 0x64, 0x8a, 0xd2, 0xbd, 0x53, 0xf4, 0xc5, 0x24, 0x3d, 0xcc, 0xd8,
-		0x96, 0xab, 0x1a, 0xdf, 0x8f, 0x15, 0x71, 0x0a, 0x72, 0xb6, 0x65, 0x28, 0xac, 0x86, 0xbd, 0xda,
-		0x9d, 0xb5, 0x2d, 0x43, 0xee, 0x9b, 0xf6, 0xf1, 0x47, 0xb4, 0x5c, 0x32, 0x8f, 0xc0, 0x6a, 0x13,
-		0xbd, 0x56, 0x73, 0xa0, 0xc0, 0x4c, 0xc1, 0xf7, 0xb2, 0x67, 0x89, 0x0d, 0xe8, 0xe6, 0x98, 0xe8,
-		0xdf, 0x7b, 0x59, 0xb7, 0x99, 0xa6, 0xcc, 0xf5, 0x08, 0x82, 0x85, 0xfe, 0x41, 0xa7, 0xe0, 0xb0,
-		0x5b, 0xca, 0x5c, 0xaf, 0xfc, 0x57, 0x13, 0x00, 0x6b, 0xc4, 0xb2, 0x74, 0xbc, 0x64, 0x17, 0x72,
-		0x69, 0x17, 0x94, 0x48, 0xcb, 0xf3, 0xc3, 0x26, 0x8d, 0xb7, 0x5f, 0x70, 0xc3, 0xfd, 0x5e, 0x81,
-		0xc9, 0xc0, 0x19, 0x5d, 0x2c, 0x3a, 0x33, 0x7f, 0x48, 0x56, 0xdd, 0xc4, 0x9e, 0x5c, 0xb8, 0x13,
-		0x2a, 0x95, 0x7f, 0x35, 0x01, 0x39, 0xda, 0xa7, 0x75, 0xec, 0xa9, 0x91, 0x39, 0x4c, 0x3c, 0xfc,
-		0x1c, 0x9e, 0x06, 0x60, 0x34, 0xae, 0xfe, 0x26, 0xe6, 0x9e, 0x95, 0xa3, 0x92, 0xa6, 0xfe, 0x26,
-		0x46, 0x97, 0x7d, 0x83, 0xa7, 0x0e, 0x37, 0xb8, 0xc8, 0xba, 0xb9, 0xd9, 0x4f, 0xc0, 0x04, 0xfd,
-		0x6f, 0x4d, 0xf7, 0x5c, 0x9e, 0x48, 0x67, 0xcc, 0x6e, 0x67, 0xeb, 0x9e, 0x5b, 0x7e, 0x03, 0x26,
-		0xb6, 0xee, 0xb1, 0xbb, 0x91, 0x53, 0x90, 0x73, 0x2c, 0x8b, 0xef, 0xc9, 0x2c, 0x17, 0xca, 0x12,
-		0x01, 0xdd, 0x82, 0xc4, 0x7d, 0x40, 0x32, 0xb8, 0x0f, 0x08, 0x2e, 0x34, 0x52, 0x23, 0x5d, 0x68,
-		0x9c, 0xfb, 0x8d, 0x04, 0xe4, 0x43, 0xf1, 0x01, 0x3d, 0x07, 0xc7, 0x96, 0xd7, 0x36, 0x57, 0x6e,
-		0x29, 0xab, 0x35, 0xe5, 0xfa, 0x5a, 0xf5, 0x46, 0xf0, 0xf1, 0xd2, 0xdc, 0xf1, 0xfb, 0x0f, 0x16,
-		0x51, 0x48, 0x77, 0xdb, 0xa4, 0xf7, 0xf4, 0xe8, 0x02, 0xcc, 0x46, 0x21, 0xd5, 0xe5, 0x66, 0x7d,
-		0x63, 0x4b, 0x4a, 0xcc, 0x1d, 0xbb, 0xff, 0x60, 0x71, 0x3a, 0x84, 0xa8, 0xee, 0xb8, 0xd8, 0xf4,
-		0xfa, 0x01, 0x2b, 0x9b, 0xeb, 0xeb, 0xab, 0x5b, 0x52, 0xb2, 0x0f, 0xc0, 0x03, 0xf6, 0x59, 0x98,
-		0x8e, 0x02, 0x36, 0x56, 0xd7, 0xa4, 0xd4, 0x1c, 0xba, 0xff, 0x60, 0xb1, 0x18, 0xd2, 0xde, 0xd0,
-		0x8d, 0xb9, 0xec, 0x47, 0x3f, 0x3b, 0x3f, 0xf6, 0x8b, 0x3f, 0x3f, 0x9f, 0x20, 0x23, 0x9b, 0x8c,
-		0xc4, 0x08, 0xf4, 0x0c, 0x9c, 0x68, 0xae, 0xde, 0xd8, 0xa8, 0xd7, 0x94, 0xf5, 0xe6, 0x0d, 0x71,
-		0xd3, 0x2d, 0x46, 0x37, 0x75, 0xff, 0xc1, 0x62, 0x9e, 0x0f, 0x69, 0x98, 0x76, 0x43, 0xae, 0xdf,
-		0xde, 0xdc, 0xaa, 0x4b, 0x09, 0xa6, 0xdd, 0x70, 0xf0, 0x1d, 0xcb, 0x63, 0xff, 0xce, 0xed, 0x59,
-		0x38, 0x39, 0x40, 0xdb, 0x1f, 0xd8, 0xf4, 0xfd, 0x07, 0x8b, 0x93, 0x0d, 0x07, 0xb3, 0xf5, 0x43,
-		0x11, 0x4b, 0x50, 0xea, 0x47, 0x6c, 0x36, 0x36, 0x9b, 0xd5, 0x35, 0x69, 0x71, 0x4e, 0xba, 0xff,
-		0x60, 0xb1, 0x20, 0x82, 0x21, 0xd1, 0x0f, 0x46, 0xf6, 0x5e, 0x9e, 0x78, 0x3e, 0xfa, 0x2c, 0x9c,
-		0x76, 0x3d, 0x75, 0x5f, 0x37, 0xdb, 0xfe, 0xad, 0x2d, 0x2f, 0xf3, 0x23, 0xcf, 0x69, 0x43, 0xff,
-		0x70, 0x57, 0x6f, 0x09, 0xa1, 0xf8, 0x1b, 0x73, 0x85, 0x3b, 0xf4, 0xc9, 0xe5, 0x5c, 0xcc, 0x43,
-		0xbd, 0xf8, 0xa3, 0xd3, 0xf0, 0xeb, 0xe1, 0xb9, 0x98, 0x4b, 0xe8, 0xb9, 0x43, 0x0f, 0x77, 0xe5,
-		0x8f, 0x25, 0xa0, 0xf8, 0xb2, 0xee, 0x7a, 0x96, 0xa3, 0x6b, 0xaa, 0x41, 0x3f, 0x59, 0xba, 0x3c,
-		0x6a, 0x6c, 0xed, 0x59, 0xea, 0xd7, 0x21, 0x73, 0x47, 0x35, 0x58, 0x50, 0x63, 0x5f, 0x85, 0x1d,
-		0x6a, 0xc5, 0x20, 0xc2, 0x09, 0x1e, 0x86, 0x2e, 0x7f, 0x21, 0x09, 0x53, 0x74, 0x4d, 0xb8, 0xec,
-		0x9f, 0x72, 0x91, 0xa3, 0x56, 0x03, 0xd2, 0x8e, 0xea, 0xf1, 0xbb, 0xc3, 0xe5, 0x1f, 0xe2, 0xd7,
-		0xc1, 0x4f, 0xc6, 0x5f, 0xea, 0x2e, 0xf5, 0xdf, 0x18, 0x53, 0x26, 0xf4, 0x0a, 0x64, 0x3b, 0xea,
-		0x3d, 0x85, 0xb2, 0x26, 0x1f, 0x01, 0xeb, 0x44, 0x47, 0xbd, 0x47, 0xfa, 0x8a, 0x5a, 0x30, 0x45,
-		0x88, 0xb5, 0x3d, 0xd5, 0x6c, 0x63, 0xc6, 0x9f, 0x7a, 0x04, 0xfc, 0x93, 0x1d, 0xf5, 0xde, 0x0a,
-		0xe5, 0x24, 0xad, 0x54, 0xb2, 0x1f, 0xff, 0xf4, 0xc2, 0x18, 0xbd, 0x6d, 0xff, 0xd5, 0x04, 0x40,
-		0x60, 0x2e, 0xa4, 0x81, 0xa4, 0xf9, 0x25, 0xda, 0xbc, 0xcb, 0xe7, 0x71, 0x29, 0x66, 0x3e, 0x7a,
-		0x6c, 0xce, 0xb6, 0xe9, 0xaf, 0xbd, 0xbd, 0x90, 0x90, 0xa7, 0xb4, 0x9e, 0xe9, 0xa8, 0x43, 0xbe,
-		0x6b, 0xb7, 0x54, 0x0f, 0x2b, 0xf4, 0x48, 0x97, 0x3c, 0xc2, 0x96, 0x0f, 0x0c, 0x48, 0xaa, 0x42,
-		0x83, 0xf8, 0x42, 0x02, 0xf2, 0xb5, 0xd0, 0x23, 0xbf, 0x12, 0x4c, 0x74, 0x2c, 0x53, 0xdf, 0xe7,
-		0x4e, 0x98, 0x93, 0x45, 0x11, 0xcd, 0x41, 0x96, 0x7d, 0xba, 0xe9, 0x1d, 0x88, 0xfb, 0x4f, 0x51,
-		0x26, 0xa8, 0xbb, 0x78, 0xc7, 0xd5, 0x85, 0xc9, 0x65, 0x51, 0x24, 0x07, 0x19, 0x17, 0x6b, 0x5d,
-		0x47, 0xf7, 0x0e, 0x14, 0xcd, 0x32, 0x3d, 0x55, 0xf3, 0xf8, 0x47, 0x80, 0x53, 0x42, 0xbe, 0xc2,
-		0xc4, 0x84, 0xa4, 0x85, 0x3d, 0x55, 0x37, 0xdc, 0x12, 0x7b, 0x2c, 0x26, 0x8a, 0xa1, 0xee, 0xfe,
-		0xd6, 0x44, 0xf8, 0xc2, 0x6a, 0x05, 0x24, 0xcb, 0xc6, 0x4e, 0x24, 0xc1, 0x64, 0x8e, 0x5a, 0xfa,
-		0xf5, 0x2f, 0x9d, 0x9f, 0xe5, 0x93, 0xc8, 0x53, 0x4c, 0xf6, 0x96, 0xab, 0x3c, 0x25, 0x10, 0x22,
-		0xf3, 0x7c, 0x8d, 0xcc, 0x9b, 0x38, 0xfd, 0xd9, 0xdd, 0x9d, 0xe0, 0x92, 0x6b, 0xb6, 0xcf, 0xae,
-		0x55, 0xf3, 0x60, 0xb9, 0xf4, 0x6b, 0x01, 0x75, 0x70, 0xb3, 0x74, 0x0b, 0x1f, 0x90, 0xd9, 0xe2,
-		0x3c, 0x0d, 0x4a, 0x43, 0x12, 0xc6, 0x37, 0x54, 0xdd, 0x10, 0x5f, 0xa4, 0xcb, 0xbc, 0x84, 0xaa,
-		0x90, 0x71, 0x3d, 0xd5, 0xeb, 0xba, 0xfc, 0x3f, 0xc7, 0x9d, 0x8d, 0x71, 0x90, 0x65, 0xcb, 0x6c,
-		0x35, 0x29, 0x40, 0xe6, 0x40, 0xb4, 0x05, 0x19, 0xcf, 0xda, 0xc7, 0x26, 0xb7, 0xd5, 0x91, 0x7c,
-		0x7c, 0xc0, 0x03, 0x2a, 0xc6, 0x85, 0xda, 0x20, 0xb5, 0xb0, 0x81, 0xdb, 0x2c, 0x4b, 0xda, 0x53,
-		0xc9, 0x61, 0x22, 0xf3, 0x08, 0xd6, 0xd0, 0x94, 0xcf, 0xda, 0xa4, 0xa4, 0x48, 0x8e, 0x3e, 0x7b,
-		0x66, 0xff, 0x6d, 0xf1, 0x5c, 0x8c, 0x19, 0x42, 0x7e, 0x2a, 0x2e, 0x1a, 0xc2, 0x4f, 0xab, 0xcf,
-		0x82, 0xd4, 0x35, 0x77, 0x2c, 0x93, 0x7e, 0x45, 0xca, 0x13, 0xf5, 0x2c, 0x4d, 0x7d, 0xa6, 0x7c,
-		0xf9, 0xcb, 0x2c, 0x63, 0xbf, 0x05, 0xc5, 0x40, 0x95, 0xae, 0xa4, 0xdc, 0x11, 0x56, 0xd2, 0xa4,
-		0x8f, 0x25, 0xb5, 0x68, 0x13, 0x20, 0x58, 0xa6, 0xf4, 0xea, 0x20, 0x1f, 0x3b, 0xa3, 0xc1, 0x92,
-		0x17, 0x27, 0xb1, 0x80, 0x02, 0xfd, 0x49, 0x38, 0xc5, 0xef, 0x70, 0xfd, 0x8c, 0x95, 0xb4, 0x27,
-		0x26, 0x24, 0xff, 0x08, 0x26, 0xa4, 0xc4, 0xae, 0x82, 0xfd, 0x8d, 0x80, 0x38, 0x18, 0x9b, 0x19,
-		0x03, 0x66, 0x58, 0xe3, 0x6c, 0x00, 0xa2, 0xd1, 0xc2, 0x23, 0x68, 0x74, 0x9a, 0x12, 0xaf, 0x51,
-		0x5e, 0xd6, 0x5a, 0xa5, 0xf0, 0xd1, 0x4f, 0x2f, 0x8c, 0xf1, 0xd5, 0x3d, 0x56, 0x6e, 0xd0, 0x2b,
-		0x74, 0xbe, 0x30, 0xb1, 0x8b, 0x2e, 0x43, 0x4e, 0x15, 0x05, 0x7a, 0xb1, 0x71, 0xd8, 0xc2, 0x0e,
-		0x54, 0x59, 0xbc, 0x78, 0xeb, 0x3f, 0x2c, 0x26, 0xca, 0x3f, 0x9f, 0x80, 0x4c, 0xed, 0x76, 0x43,
-		0xd5, 0x1d, 0x54, 0x87, 0xe9, 0xc0, 0xb7, 0x47, 0x8d, 0x16, 0xc1, 0x72, 0x10, 0xe1, 0xa2, 0x3e,
-		0xec, 0x54, 0x7b, 0x28, 0x4d, 0xef, 0x79, 0xb7, 0x67, 0xe0, 0x6b, 0x30, 0xc1, 0x7a, 0x49, 0xff,
-		0x87, 0x8a, 0x4d, 0x7e, 0xf0, 0x27, 0x06, 0x4f, 0xc4, 0xad, 0x09, 0x0a, 0xf3, 0x2f, 0x3a, 0x09,
-		0xb2, 0xfc, 0xbd, 0x04, 0x40, 0xed, 0xf6, 0xed, 0x2d, 0x47, 0xb7, 0x0d, 0xec, 0x3d, 0xaa, 0x81,
-		0xaf, 0xc1, 0xb1, 0xd0, 0x09, 0xca, 0xd1, 0x46, 0x1e, 0xfc, 0x4c, 0x70, 0x86, 0x72, 0xb4, 0x81,
-		0x6c, 0x2d, 0xd7, 0xf3, 0xd9, 0x52, 0x23, 0xb3, 0xd5, 0x5c, 0x6f, 0xb0, 0x35, 0x5f, 0x87, 0x7c,
-		0x30, 0x7c, 0x17, 0xdd, 0x82, 0xac, 0xc7, 0x7f, 0x73, 0xa3, 0x9e, 0x8d, 0x35, 0xaa, 0x40, 0x73,
-		0xc3, 0xfa, 0x04, 0xe5, 0x5f, 0x48, 0x02, 0xd4, 0x98, 0x69, 0xc8, 0x52, 0xfd, 0x23, 0xe5, 0x54,
-		0x64, 0x53, 0xe0, 0xcb, 0xf5, 0x51, 0x24, 0x3e, 0x9c, 0x0b, 0x3d, 0x01, 0xc5, 0x68, 0x20, 0x2a,
-		0xb1, 0x6f, 0x1f, 0x82, 0x03, 0x35, 0x09, 0x1f, 0x3d, 0x73, 0x70, 0x3f, 0x09, 0x33, 0xdb, 0x22,
-		0x4c, 0xfe, 0x91, 0x35, 0xd8, 0x2b, 0x30, 0x81, 0x4d, 0xcf, 0xd1, 0xa9, 0xc5, 0x88, 0x67, 0x5c,
-		0x89, 0xf1, 0x8c, 0x01, 0x43, 0xa2, 0xff, 0x8e, 0x49, 0xdc, 0xd9, 0x73, 0xb6, 0x1e, 0x63, 0xfc,
-		0x56, 0x12, 0x4a, 0xc3, 0x90, 0xe8, 0x29, 0x98, 0xd2, 0x1c, 0x4c, 0x05, 0x4a, 0xe4, 0xe2, 0xb0,
-		0x28, 0xc4, 0x7c, 0xd3, 0x5a, 0x07, 0x92, 0x0e, 0x12, 0x37, 0x24, 0xaa, 0x47, 0xce, 0xff, 0x8a,
-		0x01, 0x98, 0x6e, 0x5b, 0x18, 0xa6, 0x74, 0x53, 0xf7, 0x74, 0xd5, 0x50, 0x76, 0x54, 0x43, 0x35,
-		0xb5, 0x87, 0x49, 0x97, 0xfb, 0x53, 0x89, 0x22, 0x27, 0x5d, 0x66, 0x9c, 0xe8, 0x36, 0x4c, 0x08,
-		0xfa, 0xf4, 0x23, 0xa0, 0x17, 0x64, 0xa1, 0x9c, 0xf0, 0xdf, 0x25, 0x61, 0x5a, 0xc6, 0xad, 0x1f,
-		0x2c, 0xb3, 0xfe, 0x30, 0x00, 0x5b, 0x9e, 0x24, 0x78, 0x3e, 0x84, 0x65, 0xfb, 0x97, 0x7b, 0x8e,
-		0xf1, 0xd5, 0x5c, 0x2f, 0x64, 0xdb, 0xaf, 0x27, 0xa1, 0x10, 0xb6, 0xed, 0x0f, 0xc0, 0x66, 0x82,
-		0x1a, 0x41, 0x50, 0x60, 0x17, 0xe9, 0xcf, 0xc6, 0x04, 0x85, 0x3e, 0xe7, 0x3b, 0x3c, 0x1a, 0xfc,
-		0x9f, 0x14, 0x64, 0x1a, 0xaa, 0xa3, 0x76, 0x5c, 0x74, 0xb3, 0x2f, 0x0f, 0x15, 0x17, 0x89, 0x7d,
-		0xff, 0x35, 0x9c, 0xdf, 0x5b, 0x30, 0xcf, 0xfb, 0xf8, 0x80, 0x34, 0xf4, 0x09, 0x28, 0x92, 0xe3,
-		0x6f, 0xe8, 0x9d, 0x83, 0x24, 0x7d, 0x92, 0x4a, 0xce, 0xaf, 0xc1, 0x03, 0x2f, 0xb4, 0x00, 0x79,
-		0xa2, 0x16, 0x84, 0x3d, 0xa2, 0x03, 0x1d, 0xf5, 0x5e, 0x9d, 0x49, 0xd0, 0x79, 0x40, 0x7b, 0xfe,
-		0xbd, 0x84, 0x12, 0x58, 0x82, 0xe8, 0x4d, 0x07, 0x35, 0x42, 0xfd, 0x34, 0x00, 0x4d, 0x4e, 0xd9,
-		0x7b, 0x6c, 0xec, 0xe0, 0x96, 0x23, 0x92, 0x1a, 0x7d, 0x97, 0xed, 0x47, 0x60, 0xa6, 0xa3, 0x9b,
-		0x4a, 0xcf, 0xc9, 0x98, 0x1f, 0x2a, 0xd6, 0x8e, 0xe6, 0xb0, 0xbf, 0xff, 0xf6, 0xc2, 0xdc, 0x81,
-		0xda, 0x31, 0x2a, 0xe5, 0x01, 0x94, 0x65, 0x79, 0xba, 0xa3, 0x9b, 0xd1, 0xa3, 0x34, 0xfa, 0x33,
-		0x89, 0xb0, 0x67, 0xd0, 0x7e, 0xee, 0xaa, 0x9a, 0x67, 0x39, 0xec, 0xdf, 0x5d, 0x2f, 0x6f, 0x1c,
-		0xb9, 0x03, 0x8f, 0xb1, 0x0e, 0x0c, 0x24, 0x2d, 0x87, 0xfc, 0x89, 0x6c, 0x89, 0xd7, 0xa9, 0x34,
-		0xb4, 0x9a, 0x3e, 0x9f, 0x00, 0x14, 0x84, 0x7f, 0x19, 0xbb, 0x36, 0x39, 0x30, 0x92, 0x03, 0x44,
-		0xe0, 0x40, 0xdc, 0x03, 0x62, 0x53, 0x14, 0x1f, 0x20, 0x0e, 0x10, 0xa1, 0x45, 0x7a, 0x35, 0x88,
-		0xb9, 0x49, 0xee, 0x4f, 0x03, 0x5e, 0x88, 0x5c, 0x5a, 0xb1, 0x74, 0x81, 0xee, 0x0b, 0xab, 0x63,
-		0xe5, 0x6f, 0x24, 0xe0, 0x64, 0x9f, 0x67, 0xfb, 0x7d, 0xc6, 0x80, 0x9c, 0x50, 0x25, 0xff, 0x27,
-		0x85, 0xac, 0xef, 0x0f, 0xbb, 0x5e, 0xa6, 0x9d, 0xbe, 0x28, 0xfe, 0x5e, 0xed, 0x1e, 0xec, 0x7d,
-		0xc9, 0x7f, 0x9d, 0x80, 0xd9, 0x70, 0x67, 0xfc, 0xd1, 0x6d, 0x43, 0x21, 0xdc, 0x17, 0x3e, 0xae,
-		0xa7, 0x8f, 0x30, 0x2e, 0x3e, 0xa4, 0x08, 0x0d, 0x7a, 0x35, 0x88, 0x2c, 0xec, 0xa6, 0xee, 0xc5,
-		0xa3, 0x5a, 0x4a, 0xf4, 0xb0, 0x37, 0xc2, 0xa4, 0xe9, 0x94, 0x7d, 0x24, 0x09, 0xe9, 0x86, 0x65,
-		0x19, 0xe8, 0x4f, 0xc1, 0xb4, 0x69, 0x79, 0xd4, 0x37, 0x71, 0x4b, 0xe1, 0x17, 0x05, 0x2c, 0x4a,
-		0x7f, 0xe8, 0x68, 0x06, 0xfc, 0xed, 0xb7, 0x17, 0xfa, 0xa9, 0x7a, 0xac, 0x3a, 0x65, 0x5a, 0xde,
-		0x32, 0xad, 0xdf, 0x62, 0xd7, 0x08, 0x0e, 0x4c, 0x46, 0x9b, 0x66, 0x51, 0x7d, 0xfd, 0xc8, 0x4d,
-		0x4f, 0x1e, 0xd6, 0x6c, 0x61, 0x27, 0xd4, 0x26, 0x7b, 0xaf, 0xec, 0xf7, 0xc8, 0xac, 0xfe, 0x58,
-		0x02, 0x66, 0xa8, 0x50, 0x7f, 0x13, 0xd3, 0x63, 0xa6, 0x8c, 0x35, 0xcb, 0x69, 0xa1, 0x22, 0x24,
-		0xf9, 0x93, 0x9a, 0xb4, 0x9c, 0xd4, 0x5b, 0x68, 0x16, 0xc6, 0xad, 0xbb, 0x26, 0x7f, 0xcd, 0x23,
-		0x27, 0xb3, 0x02, 0x0d, 0xa3, 0x56, 0xab, 0x6b, 0x60, 0x45, 0xd5, 0x34, 0xfa, 0x12, 0x2e, 0xbb,
-		0xd1, 0x9a, 0x64, 0xd2, 0x2a, 0x13, 0xa2, 0xc7, 0x20, 0xe7, 0xaf, 0x75, 0x7e, 0xa1, 0x15, 0x08,
-		0x98, 0x7b, 0x9d, 0xfb, 0x72, 0x02, 0x20, 0xb8, 0xba, 0x41, 0xcf, 0xc0, 0x89, 0xe5, 0xcd, 0x8d,
-		0x9a, 0xd2, 0xdc, 0xaa, 0x6e, 0x6d, 0x37, 0xa3, 0xaf, 0xc1, 0x8b, 0x07, 0x04, 0xae, 0x8d, 0x35,
-		0x7d, 0x57, 0xc7, 0x2d, 0xf4, 0x24, 0xcc, 0x46, 0xb5, 0x49, 0xa9, 0x5e, 0x93, 0x12, 0x73, 0x85,
-		0xfb, 0x0f, 0x16, 0xb3, 0x2c, 0x9d, 0xc4, 0x2d, 0x74, 0x06, 0x8e, 0xf5, 0xeb, 0xad, 0x6e, 0xdc,
-		0x90, 0x92, 0x73, 0x93, 0xf7, 0x1f, 0x2c, 0xe6, 0xfc, 0xbc, 0x13, 0x95, 0x01, 0x85, 0x35, 0x39,
-		0x5f, 0x6a, 0x0e, 0xee, 0x3f, 0x58, 0xcc, 0xb0, 0xf9, 0x9b, 0x4b, 0x7f, 0xf4, 0xb3, 0xf3, 0x63,
-		0xcb, 0xaf, 0x0d, 0x7d, 0x04, 0xf0, 0x52, 0x68, 0xea, 0xf4, 0x0f, 0x1b, 0x5d, 0x12, 0x69, 0x75,
-		0x53, 0xbb, 0xc0, 0xdc, 0x58, 0xf7, 0x0e, 0xce, 0x73, 0x17, 0x3e, 0xcf, 0xcc, 0x75, 0xe1, 0x9e,
-		0xb8, 0xe0, 0x8f, 0x3e, 0x0a, 0xf8, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xb7, 0xbe, 0xae,
-		0x27, 0x68, 0x00, 0x00,
+		// 8141 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7b, 0x90, 0x1c, 0xc7,
+		0x79, 0xdf, 0xed, 0xf3, 0x76, 0xbf, 0xdb, 0xdb, 0x9d, 0x9b, 0x3b, 0x80, 0x8b, 0x03, 0x71, 0x77,
+		0x5c, 0x8a, 0x24, 0x00, 0x0a, 0x07, 0x10, 0x24, 0x00, 0x62, 0x21, 0x89, 0xd9, 0xbd, 0x5d, 0x80,
+		0x07, 0xdc, 0x63, 0x35, 0x7b, 0x07, 0x82, 0x74, 0xb9, 0xa6, 0xe6, 0x66, 0xfb, 0xf6, 0x86, 0x37,
+		0x3b, 0x33, 0x9a, 0x99, 0x3d, 0xe0, 0x18, 0x27, 0xa1, 0xac, 0x3c, 0x64, 0xb8, 0x92, 0xc8, 0x76,
+		0x2a, 0x96, 0x64, 0x41, 0x16, 0xfd, 0x88, 0x1c, 0xc6, 0x79, 0xd8, 0x52, 0x94, 0x38, 0xa9, 0xa4,
+		0x14, 0x57, 0x25, 0x51, 0x54, 0x95, 0x94, 0xe4, 0x3f, 0x62, 0xe7, 0x05, 0x2b, 0x94, 0x2a, 0x61,
+		0x14, 0x25, 0x56, 0x68, 0x3a, 0x95, 0x94, 0xca, 0xa9, 0x54, 0xbf, 0xe6, 0xb1, 0x8f, 0x9b, 0x3d,
+		0x18, 0xa4, 0x5d, 0xa5, 0xbf, 0x6e, 0xe7, 0xeb, 0xef, 0xfb, 0x75, 0xf7, 0xd7, 0x5f, 0x7f, 0xfd,
+		0xf5, 0xd7, 0x3d, 0x73, 0xf0, 0xfb, 0x57, 0x60, 0xa1, 0x6d, 0x9a, 0x6d, 0x1d, 0x9d, 0xb5, 0x6c,
+		0xd3, 0x35, 0xb7, 0xba, 0xdb, 0x67, 0x5b, 0xc8, 0x51, 0x6d, 0xcd, 0x72, 0x4d, 0x7b, 0x91, 0xd0,
+		0xc4, 0x02, 0xe5, 0x58, 0xe4, 0x1c, 0xa5, 0x55, 0x98, 0xba, 0xaa, 0xe9, 0xa8, 0xe6, 0x31, 0x36,
+		0x91, 0x2b, 0x3e, 0x0f, 0xc9, 0x6d, 0x4d, 0x47, 0xc5, 0xd8, 0x42, 0xe2, 0xe4, 0xc4, 0xf9, 0x0f,
+		0x2c, 0xf6, 0x08, 0x2d, 0x86, 0x25, 0x1a, 0x98, 0x2c, 0x11, 0x89, 0xd2, 0x77, 0x92, 0x30, 0x3d,
+		0xa0, 0x54, 0x14, 0x21, 0x69, 0x28, 0x1d, 0x8c, 0x18, 0x3b, 0x99, 0x95, 0xc8, 0x6f, 0xb1, 0x08,
+		0xe3, 0x96, 0xa2, 0xee, 0x2a, 0x6d, 0x54, 0x8c, 0x13, 0x32, 0x7f, 0x14, 0xe7, 0x00, 0x5a, 0xc8,
+		0x42, 0x46, 0x0b, 0x19, 0xea, 0x7e, 0x31, 0xb1, 0x90, 0x38, 0x99, 0x95, 0x02, 0x14, 0xf1, 0x69,
+		0x98, 0xb2, 0xba, 0x5b, 0xba, 0xa6, 0xca, 0x01, 0x36, 0x58, 0x48, 0x9c, 0x4c, 0x49, 0x02, 0x2d,
+		0xa8, 0xf9, 0xcc, 0x4f, 0x41, 0xe1, 0x36, 0x52, 0x76, 0x83, 0xac, 0x13, 0x84, 0x35, 0x8f, 0xc9,
+		0x01, 0xc6, 0x25, 0xc8, 0x75, 0x90, 0xe3, 0x28, 0x6d, 0x24, 0xbb, 0xfb, 0x16, 0x2a, 0x26, 0x49,
+		0xef, 0x17, 0xfa, 0x7a, 0xdf, 0xdb, 0xf3, 0x09, 0x26, 0xb5, 0xb1, 0x6f, 0x21, 0xb1, 0x02, 0x59,
+		0x64, 0x74, 0x3b, 0x14, 0x21, 0x35, 0x44, 0x7f, 0x75, 0xa3, 0xdb, 0xe9, 0x45, 0xc9, 0x60, 0x31,
+		0x06, 0x31, 0xee, 0x20, 0x7b, 0x4f, 0x53, 0x51, 0x31, 0x4d, 0x00, 0x9e, 0xea, 0x03, 0x68, 0xd2,
+		0xf2, 0x5e, 0x0c, 0x2e, 0x27, 0x2e, 0x41, 0x16, 0xdd, 0x71, 0x91, 0xe1, 0x68, 0xa6, 0x51, 0x1c,
+		0x27, 0x20, 0x4f, 0x0c, 0x18, 0x45, 0xa4, 0xb7, 0x7a, 0x21, 0x7c, 0x39, 0xf1, 0x22, 0x8c, 0x9b,
+		0x96, 0xab, 0x99, 0x86, 0x53, 0xcc, 0x2c, 0xc4, 0x4e, 0x4e, 0x9c, 0x7f, 0x74, 0xa0, 0x21, 0xac,
+		0x53, 0x1e, 0x89, 0x33, 0x8b, 0xcb, 0x20, 0x38, 0x66, 0xd7, 0x56, 0x91, 0xac, 0x9a, 0x2d, 0x24,
+		0x6b, 0xc6, 0xb6, 0x59, 0xcc, 0x12, 0x80, 0xf9, 0xfe, 0x8e, 0x10, 0xc6, 0x25, 0xb3, 0x85, 0x96,
+		0x8d, 0x6d, 0x53, 0xca, 0x3b, 0xa1, 0x67, 0xf1, 0x28, 0xa4, 0x9d, 0x7d, 0xc3, 0x55, 0xee, 0x14,
+		0x73, 0xc4, 0x42, 0xd8, 0x53, 0xe9, 0x37, 0xd2, 0x50, 0x18, 0xc5, 0xc4, 0xae, 0x40, 0x6a, 0x1b,
+		0xf7, 0xb2, 0x18, 0x3f, 0x8c, 0x0e, 0xa8, 0x4c, 0x58, 0x89, 0xe9, 0x07, 0x54, 0x62, 0x05, 0x26,
+		0x0c, 0xe4, 0xb8, 0xa8, 0x45, 0x2d, 0x22, 0x31, 0xa2, 0x4d, 0x01, 0x15, 0xea, 0x37, 0xa9, 0xe4,
+		0x03, 0x99, 0xd4, 0x2d, 0x28, 0x78, 0x4d, 0x92, 0x6d, 0xc5, 0x68, 0x73, 0xdb, 0x3c, 0x1b, 0xd5,
+		0x92, 0xc5, 0x3a, 0x97, 0x93, 0xb0, 0x98, 0x94, 0x47, 0xa1, 0x67, 0xb1, 0x06, 0x60, 0x1a, 0xc8,
+		0xdc, 0x96, 0x5b, 0x48, 0xd5, 0x8b, 0x99, 0x21, 0x5a, 0x5a, 0xc7, 0x2c, 0x7d, 0x5a, 0x32, 0x29,
+		0x55, 0xd5, 0xc5, 0xcb, 0xbe, 0xa9, 0x8d, 0x0f, 0xb1, 0x94, 0x55, 0x3a, 0xc9, 0xfa, 0xac, 0x6d,
+		0x13, 0xf2, 0x36, 0xc2, 0x76, 0x8f, 0x5a, 0xac, 0x67, 0x59, 0xd2, 0x88, 0xc5, 0xc8, 0x9e, 0x49,
+		0x4c, 0x8c, 0x76, 0x6c, 0xd2, 0x0e, 0x3e, 0x8a, 0x8f, 0x83, 0x47, 0x90, 0x89, 0x59, 0x01, 0xf1,
+		0x42, 0x39, 0x4e, 0x5c, 0x53, 0x3a, 0x68, 0xf6, 0x35, 0xc8, 0x87, 0xd5, 0x23, 0xce, 0x40, 0xca,
+		0x71, 0x15, 0xdb, 0x25, 0x56, 0x98, 0x92, 0xe8, 0x83, 0x28, 0x40, 0x02, 0x19, 0x2d, 0xe2, 0xe5,
+		0x52, 0x12, 0xfe, 0x29, 0xfe, 0x29, 0xbf, 0xc3, 0x09, 0xd2, 0xe1, 0x27, 0xfb, 0x47, 0x34, 0x84,
+		0xdc, 0xdb, 0xef, 0xd9, 0x4b, 0x30, 0x19, 0xea, 0xc0, 0xa8, 0x55, 0x97, 0x7e, 0x0c, 0x8e, 0x0c,
+		0x84, 0x16, 0x6f, 0xc1, 0x4c, 0xd7, 0xd0, 0x0c, 0x17, 0xd9, 0x96, 0x8d, 0xb0, 0xc5, 0xd2, 0xaa,
+		0x8a, 0xff, 0x75, 0x7c, 0x88, 0xcd, 0x6d, 0x06, 0xb9, 0x29, 0x8a, 0x34, 0xdd, 0xed, 0x27, 0x9e,
+		0xce, 0x66, 0xde, 0x1e, 0x17, 0x5e, 0x7f, 0xfd, 0xf5, 0xd7, 0xe3, 0xa5, 0x7f, 0x96, 0x86, 0x99,
+		0x41, 0x73, 0x66, 0xe0, 0xf4, 0x3d, 0x0a, 0x69, 0xa3, 0xdb, 0xd9, 0x42, 0x36, 0x51, 0x52, 0x4a,
+		0x62, 0x4f, 0x62, 0x05, 0x52, 0xba, 0xb2, 0x85, 0xf4, 0x62, 0x72, 0x21, 0x76, 0x32, 0x7f, 0xfe,
+		0xe9, 0x91, 0x66, 0xe5, 0xe2, 0x0a, 0x16, 0x91, 0xa8, 0xa4, 0xf8, 0x11, 0x48, 0x32, 0x17, 0x8d,
+		0x11, 0x4e, 0x8f, 0x86, 0x80, 0xe7, 0x92, 0x44, 0xe4, 0xc4, 0xe3, 0x90, 0xc5, 0x7f, 0xa9, 0x6d,
+		0xa4, 0x49, 0x9b, 0x33, 0x98, 0x80, 0xed, 0x42, 0x9c, 0x85, 0x0c, 0x99, 0x26, 0x2d, 0xc4, 0x97,
+		0x36, 0xef, 0x19, 0x1b, 0x56, 0x0b, 0x6d, 0x2b, 0x5d, 0xdd, 0x95, 0xf7, 0x14, 0xbd, 0x8b, 0x88,
+		0xc1, 0x67, 0xa5, 0x1c, 0x23, 0xde, 0xc4, 0x34, 0x71, 0x1e, 0x26, 0xe8, 0xac, 0xd2, 0x8c, 0x16,
+		0xba, 0x43, 0xbc, 0x67, 0x4a, 0xa2, 0x13, 0x6d, 0x19, 0x53, 0x70, 0xf5, 0xaf, 0x3a, 0xa6, 0xc1,
+		0x4d, 0x93, 0x54, 0x81, 0x09, 0xa4, 0xfa, 0x4b, 0xbd, 0x8e, 0xfb, 0xc4, 0xe0, 0xee, 0xf5, 0xcd,
+		0xa5, 0xa7, 0xa0, 0x40, 0x38, 0x9e, 0x65, 0x43, 0xaf, 0xe8, 0xc5, 0xa9, 0x85, 0xd8, 0xc9, 0x8c,
+		0x94, 0xa7, 0xe4, 0x75, 0x46, 0x2d, 0x7d, 0x25, 0x0e, 0x49, 0xe2, 0x58, 0x0a, 0x30, 0xb1, 0xf1,
+		0x72, 0xa3, 0x2e, 0xd7, 0xd6, 0x37, 0xab, 0x2b, 0x75, 0x21, 0x26, 0xe6, 0x01, 0x08, 0xe1, 0xea,
+		0xca, 0x7a, 0x65, 0x43, 0x88, 0x7b, 0xcf, 0xcb, 0x6b, 0x1b, 0x17, 0x9f, 0x13, 0x12, 0x9e, 0xc0,
+		0x26, 0x25, 0x24, 0x83, 0x0c, 0xcf, 0x9e, 0x17, 0x52, 0xa2, 0x00, 0x39, 0x0a, 0xb0, 0x7c, 0xab,
+		0x5e, 0xbb, 0xf8, 0x9c, 0x90, 0x0e, 0x53, 0x9e, 0x3d, 0x2f, 0x8c, 0x8b, 0x93, 0x90, 0x25, 0x94,
+		0xea, 0xfa, 0xfa, 0x8a, 0x90, 0xf1, 0x30, 0x9b, 0x1b, 0xd2, 0xf2, 0xda, 0x35, 0x21, 0xeb, 0x61,
+		0x5e, 0x93, 0xd6, 0x37, 0x1b, 0x02, 0x78, 0x08, 0xab, 0xf5, 0x66, 0xb3, 0x72, 0xad, 0x2e, 0x4c,
+		0x78, 0x1c, 0xd5, 0x97, 0x37, 0xea, 0x4d, 0x21, 0x17, 0x6a, 0xd6, 0xb3, 0xe7, 0x85, 0x49, 0xaf,
+		0x8a, 0xfa, 0xda, 0xe6, 0xaa, 0x90, 0x17, 0xa7, 0x60, 0x92, 0x56, 0xc1, 0x1b, 0x51, 0xe8, 0x21,
+		0x5d, 0x7c, 0x4e, 0x10, 0xfc, 0x86, 0x50, 0x94, 0xa9, 0x10, 0xe1, 0xe2, 0x73, 0x82, 0x58, 0x5a,
+		0x82, 0x14, 0x31, 0x43, 0x51, 0x84, 0xfc, 0x4a, 0xa5, 0x5a, 0x5f, 0x91, 0xd7, 0x1b, 0x1b, 0xcb,
+		0xeb, 0x6b, 0x95, 0x15, 0x21, 0xe6, 0xd3, 0xa4, 0xfa, 0x47, 0x37, 0x97, 0xa5, 0x7a, 0x4d, 0x88,
+		0x07, 0x69, 0x8d, 0x7a, 0x65, 0xa3, 0x5e, 0x13, 0x12, 0x25, 0x15, 0x66, 0x06, 0x39, 0xd4, 0x81,
+		0x53, 0x28, 0x60, 0x0b, 0xf1, 0x21, 0xb6, 0x40, 0xb0, 0x7a, 0x6d, 0xa1, 0xf4, 0xed, 0x38, 0x4c,
+		0x0f, 0x58, 0x54, 0x06, 0x56, 0xf2, 0x02, 0xa4, 0xa8, 0x2d, 0xd3, 0x65, 0xf6, 0xd4, 0xc0, 0xd5,
+		0x89, 0x58, 0x76, 0xdf, 0x52, 0x4b, 0xe4, 0x82, 0xa1, 0x46, 0x62, 0x48, 0xa8, 0x81, 0x21, 0xfa,
+		0x0c, 0xf6, 0x47, 0xfb, 0x9c, 0x3f, 0x5d, 0x1f, 0x2f, 0x8e, 0xb2, 0x3e, 0x12, 0xda, 0xe1, 0x16,
+		0x81, 0xd4, 0x80, 0x45, 0xe0, 0x0a, 0x4c, 0xf5, 0x01, 0x8d, 0xec, 0x8c, 0x3f, 0x11, 0x83, 0xe2,
+		0x30, 0xe5, 0x44, 0xb8, 0xc4, 0x78, 0xc8, 0x25, 0x5e, 0xe9, 0xd5, 0xe0, 0x63, 0xc3, 0x07, 0xa1,
+		0x6f, 0xac, 0xbf, 0x18, 0x83, 0xa3, 0x83, 0x43, 0xca, 0x81, 0x6d, 0xf8, 0x08, 0xa4, 0x3b, 0xc8,
+		0xdd, 0x31, 0x79, 0x58, 0xf5, 0xe4, 0x80, 0xc5, 0x1a, 0x17, 0xf7, 0x0e, 0x36, 0x93, 0x12, 0x2f,
+		0xf7, 0xb6, 0x75, 0x7e, 0x58, 0x80, 0xdb, 0xd7, 0xd2, 0x9f, 0x88, 0xc3, 0x91, 0x81, 0xe0, 0x03,
+		0x1b, 0x7a, 0x02, 0x40, 0x33, 0xac, 0xae, 0x4b, 0x43, 0x27, 0xea, 0x89, 0xb3, 0x84, 0x42, 0x9c,
+		0x17, 0xf6, 0xb2, 0x5d, 0xd7, 0x2b, 0x4f, 0x90, 0x72, 0xa0, 0x24, 0xc2, 0xf0, 0xbc, 0xdf, 0xd0,
+		0x24, 0x69, 0xe8, 0xdc, 0x90, 0x9e, 0xf6, 0x19, 0xe6, 0x39, 0x10, 0x54, 0x5d, 0x43, 0x86, 0x2b,
+		0x3b, 0xae, 0x8d, 0x94, 0x8e, 0x66, 0xb4, 0xc9, 0x52, 0x93, 0x29, 0xa7, 0xb6, 0x15, 0xdd, 0x41,
+		0x52, 0x81, 0x16, 0x37, 0x79, 0x29, 0x96, 0x20, 0x06, 0x64, 0x07, 0x24, 0xd2, 0x21, 0x09, 0x5a,
+		0xec, 0x49, 0x94, 0x7e, 0x2a, 0x0b, 0x13, 0x81, 0x00, 0x5c, 0x7c, 0x0c, 0x72, 0xaf, 0x2a, 0x7b,
+		0x8a, 0xcc, 0x37, 0x55, 0x54, 0x13, 0x13, 0x98, 0xd6, 0xa0, 0x24, 0xf1, 0x1c, 0xcc, 0x10, 0x16,
+		0xb3, 0xeb, 0x22, 0x5b, 0x56, 0x75, 0xc5, 0x71, 0x88, 0xd2, 0x32, 0x84, 0x55, 0xc4, 0x65, 0xeb,
+		0xb8, 0x68, 0x89, 0x97, 0x88, 0x17, 0x60, 0x9a, 0x48, 0x74, 0xba, 0xba, 0xab, 0x59, 0x3a, 0x92,
+		0xf1, 0x36, 0xcf, 0x29, 0x42, 0xb0, 0x65, 0x53, 0x98, 0x63, 0x95, 0x31, 0xe0, 0x16, 0x39, 0x62,
+		0x0d, 0x4e, 0x10, 0xb1, 0x36, 0x32, 0x90, 0xad, 0xb8, 0x48, 0x46, 0x1f, 0xeb, 0x2a, 0xba, 0x23,
+		0x2b, 0x46, 0x4b, 0xde, 0x51, 0x9c, 0x9d, 0xe2, 0x0c, 0x06, 0xa8, 0xc6, 0x8b, 0x31, 0xe9, 0x18,
+		0x66, 0xbc, 0xc6, 0xf8, 0xea, 0x84, 0xad, 0x62, 0xb4, 0x5e, 0x54, 0x9c, 0x1d, 0xb1, 0x0c, 0x47,
+		0x09, 0x8a, 0xe3, 0xda, 0x9a, 0xd1, 0x96, 0xd5, 0x1d, 0xa4, 0xee, 0xca, 0x5d, 0x77, 0xfb, 0xf9,
+		0xe2, 0xf1, 0x60, 0xfd, 0xa4, 0x85, 0x4d, 0xc2, 0xb3, 0x84, 0x59, 0x36, 0xdd, 0xed, 0xe7, 0xc5,
+		0x26, 0xe4, 0xf0, 0x60, 0x74, 0xb4, 0xd7, 0x90, 0xbc, 0x6d, 0xda, 0x64, 0x0d, 0xcd, 0x0f, 0x70,
+		0x4d, 0x01, 0x0d, 0x2e, 0xae, 0x33, 0x81, 0x55, 0xb3, 0x85, 0xca, 0xa9, 0x66, 0xa3, 0x5e, 0xaf,
+		0x49, 0x13, 0x1c, 0xe5, 0xaa, 0x69, 0x63, 0x83, 0x6a, 0x9b, 0x9e, 0x82, 0x27, 0xa8, 0x41, 0xb5,
+		0x4d, 0xae, 0xde, 0x0b, 0x30, 0xad, 0xaa, 0xb4, 0xcf, 0x9a, 0x2a, 0xb3, 0xcd, 0x98, 0x53, 0x14,
+		0x42, 0xca, 0x52, 0xd5, 0x6b, 0x94, 0x81, 0xd9, 0xb8, 0x23, 0x5e, 0x86, 0x23, 0xbe, 0xb2, 0x82,
+		0x82, 0x53, 0x7d, 0xbd, 0xec, 0x15, 0xbd, 0x00, 0xd3, 0xd6, 0x7e, 0xbf, 0xa0, 0x18, 0xaa, 0xd1,
+		0xda, 0xef, 0x15, 0xbb, 0x04, 0x33, 0xd6, 0x8e, 0xd5, 0x2f, 0x77, 0x3a, 0x28, 0x27, 0x5a, 0x3b,
+		0x56, 0xaf, 0xe0, 0x13, 0x64, 0x67, 0x6e, 0x23, 0x55, 0x71, 0x51, 0xab, 0xf8, 0x48, 0x90, 0x3d,
+		0x50, 0x20, 0x2e, 0x82, 0xa0, 0xaa, 0x32, 0x32, 0x94, 0x2d, 0x1d, 0xc9, 0x8a, 0x8d, 0x0c, 0xc5,
+		0x29, 0xce, 0x13, 0xe6, 0xa4, 0x6b, 0x77, 0x91, 0x94, 0x57, 0xd5, 0x3a, 0x29, 0xac, 0x90, 0x32,
+		0xf1, 0x34, 0x4c, 0x99, 0x5b, 0xaf, 0xaa, 0xd4, 0x22, 0x65, 0xcb, 0x46, 0xdb, 0xda, 0x9d, 0xe2,
+		0x07, 0x88, 0x7a, 0x0b, 0xb8, 0x80, 0xd8, 0x63, 0x83, 0x90, 0xc5, 0x53, 0x20, 0xa8, 0xce, 0x8e,
+		0x62, 0x5b, 0xc4, 0x25, 0x3b, 0x96, 0xa2, 0xa2, 0xe2, 0x13, 0x94, 0x95, 0xd2, 0xd7, 0x38, 0x19,
+		0xcf, 0x08, 0xe7, 0xb6, 0xb6, 0xed, 0x72, 0xc4, 0xa7, 0xe8, 0x8c, 0x20, 0x34, 0x86, 0x76, 0x12,
+		0x04, 0xac, 0x89, 0x50, 0xc5, 0x27, 0x09, 0x5b, 0xde, 0xda, 0xb1, 0x82, 0xf5, 0x3e, 0x0e, 0x93,
+		0xd6, 0x4e, 0xb0, 0xd2, 0x53, 0x34, 0x70, 0xb3, 0x76, 0x02, 0x35, 0x3e, 0x07, 0x47, 0x31, 0x53,
+		0x07, 0xb9, 0x4a, 0x4b, 0x71, 0x95, 0x00, 0xf7, 0x07, 0x09, 0x37, 0x56, 0xfb, 0x2a, 0x2b, 0x0c,
+		0xb5, 0xd3, 0xee, 0x6e, 0xed, 0x7b, 0x86, 0x75, 0x86, 0xb6, 0x13, 0xd3, 0xb8, 0x69, 0xbd, 0x67,
+		0xc1, 0x79, 0xa9, 0x0c, 0xb9, 0xa0, 0xdd, 0x8b, 0x59, 0xa0, 0x96, 0x2f, 0xc4, 0x70, 0x10, 0xb4,
+		0xb4, 0x5e, 0xc3, 0xe1, 0xcb, 0x2b, 0x75, 0x21, 0x8e, 0xc3, 0xa8, 0x95, 0xe5, 0x8d, 0xba, 0x2c,
+		0x6d, 0xae, 0x6d, 0x2c, 0xaf, 0xd6, 0x85, 0x44, 0x20, 0xb0, 0xbf, 0x9e, 0xcc, 0x3c, 0x29, 0x3c,
+		0x85, 0xa3, 0x86, 0x7c, 0x78, 0xa7, 0x26, 0x7e, 0x08, 0x1e, 0xe1, 0x69, 0x15, 0x07, 0xb9, 0xf2,
+		0x6d, 0xcd, 0x26, 0x13, 0xb2, 0xa3, 0xd0, 0xc5, 0xd1, 0xb3, 0x9f, 0x19, 0xc6, 0xd5, 0x44, 0xee,
+		0x4b, 0x9a, 0x8d, 0xa7, 0x5b, 0x47, 0x71, 0xc5, 0x15, 0x98, 0x37, 0x4c, 0xd9, 0x71, 0x15, 0xa3,
+		0xa5, 0xd8, 0x2d, 0xd9, 0x4f, 0x68, 0xc9, 0x8a, 0xaa, 0x22, 0xc7, 0x31, 0xe9, 0x42, 0xe8, 0xa1,
+		0x3c, 0x6a, 0x98, 0x4d, 0xc6, 0xec, 0xaf, 0x10, 0x15, 0xc6, 0xda, 0x63, 0xbe, 0x89, 0x61, 0xe6,
+		0x7b, 0x1c, 0xb2, 0x1d, 0xc5, 0x92, 0x91, 0xe1, 0xda, 0xfb, 0x24, 0x3e, 0xcf, 0x48, 0x99, 0x8e,
+		0x62, 0xd5, 0xf1, 0xf3, 0xfb, 0xb2, 0x4d, 0xba, 0x9e, 0xcc, 0x24, 0x85, 0xd4, 0xf5, 0x64, 0x26,
+		0x25, 0xa4, 0xaf, 0x27, 0x33, 0x69, 0x61, 0xfc, 0x7a, 0x32, 0x93, 0x11, 0xb2, 0xd7, 0x93, 0x99,
+		0xac, 0x00, 0xa5, 0x9f, 0x4e, 0x42, 0x2e, 0x18, 0xc1, 0xe3, 0x0d, 0x91, 0x4a, 0xd6, 0xb0, 0x18,
+		0xf1, 0x72, 0x8f, 0x1f, 0x18, 0xef, 0x2f, 0x2e, 0xe1, 0xc5, 0xad, 0x9c, 0xa6, 0xe1, 0xb2, 0x44,
+		0x25, 0x71, 0x60, 0x81, 0xcd, 0x0f, 0xd1, 0xf0, 0x24, 0x23, 0xb1, 0x27, 0xf1, 0x1a, 0xa4, 0x5f,
+		0x75, 0x08, 0x76, 0x9a, 0x60, 0x7f, 0xe0, 0x60, 0xec, 0xeb, 0x4d, 0x02, 0x9e, 0xbd, 0xde, 0x94,
+		0xd7, 0xd6, 0xa5, 0xd5, 0xca, 0x8a, 0xc4, 0xc4, 0xc5, 0x63, 0x90, 0xd4, 0x95, 0xd7, 0xf6, 0xc3,
+		0xcb, 0x20, 0x21, 0x89, 0x8b, 0x50, 0xe8, 0x1a, 0x7b, 0xc8, 0xd6, 0xb6, 0x35, 0xd4, 0x92, 0x09,
+		0x57, 0x21, 0xc8, 0x95, 0xf7, 0x4b, 0x57, 0x30, 0xff, 0x88, 0xc3, 0x78, 0x0c, 0x92, 0x38, 0xc5,
+		0x17, 0x5e, 0xac, 0x08, 0xe9, 0x3d, 0x9c, 0x4e, 0x67, 0x21, 0x45, 0xf4, 0x2b, 0x02, 0x30, 0x0d,
+		0x0b, 0x63, 0x62, 0x06, 0x92, 0x4b, 0xeb, 0x12, 0x9e, 0x52, 0x02, 0xe4, 0x28, 0x55, 0x6e, 0x2c,
+		0xd7, 0x97, 0xea, 0x42, 0xbc, 0x74, 0x01, 0xd2, 0x54, 0x69, 0x78, 0xba, 0x79, 0x6a, 0x13, 0xc6,
+		0xd8, 0x23, 0xc3, 0x88, 0xf1, 0xd2, 0xcd, 0xd5, 0x6a, 0x5d, 0x12, 0xe2, 0x7d, 0xc6, 0x52, 0x72,
+		0x20, 0x17, 0x8c, 0xe4, 0xdf, 0x9f, 0xed, 0xfc, 0x57, 0x63, 0x30, 0x11, 0x88, 0xcc, 0x71, 0x48,
+		0xa5, 0xe8, 0xba, 0x79, 0x5b, 0x56, 0x74, 0x4d, 0x71, 0x98, 0x29, 0x01, 0x21, 0x55, 0x30, 0x65,
+		0xd4, 0xa1, 0x7b, 0x9f, 0x26, 0x59, 0x4a, 0x48, 0x97, 0x3e, 0x1f, 0x03, 0xa1, 0x37, 0x34, 0xee,
+		0x69, 0x66, 0xec, 0x8f, 0xb3, 0x99, 0xa5, 0xcf, 0xc5, 0x20, 0x1f, 0x8e, 0x87, 0x7b, 0x9a, 0xf7,
+		0xd8, 0x1f, 0x6b, 0xf3, 0xbe, 0x15, 0x87, 0xc9, 0x50, 0x14, 0x3c, 0x6a, 0xeb, 0x3e, 0x06, 0x53,
+		0x5a, 0x0b, 0x75, 0x2c, 0xd3, 0xc5, 0xe9, 0x77, 0x59, 0x47, 0x7b, 0x48, 0x2f, 0x96, 0x88, 0x93,
+		0x39, 0x7b, 0x70, 0x9c, 0xbd, 0xb8, 0xec, 0xcb, 0xad, 0x60, 0xb1, 0xf2, 0xf4, 0x72, 0xad, 0xbe,
+		0xda, 0x58, 0xdf, 0xa8, 0xaf, 0x2d, 0xbd, 0x2c, 0x6f, 0xae, 0xdd, 0x58, 0x5b, 0x7f, 0x69, 0x4d,
+		0x12, 0xb4, 0x1e, 0xb6, 0xf7, 0x70, 0xda, 0x37, 0x40, 0xe8, 0x6d, 0x94, 0xf8, 0x08, 0x0c, 0x6a,
+		0x96, 0x30, 0x26, 0x4e, 0x43, 0x61, 0x6d, 0x5d, 0x6e, 0x2e, 0xd7, 0xea, 0x72, 0xfd, 0xea, 0xd5,
+		0xfa, 0xd2, 0x46, 0x93, 0x66, 0x4e, 0x3c, 0xee, 0x8d, 0xd0, 0x04, 0x2f, 0x7d, 0x36, 0x01, 0xd3,
+		0x03, 0x5a, 0x22, 0x56, 0xd8, 0x9e, 0x87, 0x6e, 0xc3, 0xce, 0x8c, 0xd2, 0xfa, 0x45, 0x1c, 0x75,
+		0x34, 0x14, 0xdb, 0x65, 0x5b, 0xa4, 0x53, 0x80, 0xb5, 0x64, 0xb8, 0xd8, 0xb9, 0xda, 0x2c, 0x23,
+		0x45, 0x37, 0x42, 0x05, 0x9f, 0x4e, 0x93, 0x52, 0x1f, 0x04, 0xd1, 0x32, 0x1d, 0xcd, 0xd5, 0xf6,
+		0x70, 0x52, 0x9f, 0xa7, 0xaf, 0xf0, 0xc6, 0x28, 0x29, 0x09, 0xbc, 0x64, 0xd9, 0x70, 0x3d, 0x6e,
+		0x03, 0xb5, 0x95, 0x1e, 0x6e, 0xec, 0xfc, 0x13, 0x92, 0xc0, 0x4b, 0x3c, 0xee, 0xc7, 0x20, 0xd7,
+		0x32, 0xbb, 0x38, 0x5a, 0xa4, 0x7c, 0x78, 0xad, 0x89, 0x49, 0x13, 0x94, 0xe6, 0xb1, 0xb0, 0x7d,
+		0x80, 0x9f, 0x37, 0xcb, 0x49, 0x13, 0x94, 0x46, 0x59, 0x9e, 0x82, 0x82, 0xd2, 0x6e, 0xdb, 0x18,
+		0x9c, 0x03, 0xd1, 0x9d, 0x4d, 0xde, 0x23, 0x13, 0xc6, 0xd9, 0xeb, 0x90, 0xe1, 0x7a, 0xc0, 0x8b,
+		0x3d, 0xd6, 0x84, 0x6c, 0xd1, 0xed, 0x7a, 0x1c, 0xa7, 0xd2, 0x0c, 0x5e, 0xf8, 0x18, 0xe4, 0x34,
+		0x47, 0xf6, 0x8f, 0x01, 0xe2, 0x0b, 0xf1, 0x93, 0x19, 0x69, 0x42, 0x73, 0xbc, 0x14, 0x6a, 0xe9,
+		0x8b, 0x71, 0xc8, 0x87, 0x8f, 0x31, 0xc4, 0x1a, 0x64, 0x74, 0x53, 0x55, 0x88, 0x69, 0xd1, 0x33,
+		0xb4, 0x93, 0x11, 0x27, 0x1f, 0x8b, 0x2b, 0x8c, 0x5f, 0xf2, 0x24, 0x67, 0xff, 0x4d, 0x0c, 0x32,
+		0x9c, 0x2c, 0x1e, 0x85, 0xa4, 0xa5, 0xb8, 0x3b, 0x04, 0x2e, 0x55, 0x8d, 0x0b, 0x31, 0x89, 0x3c,
+		0x63, 0xba, 0x63, 0x29, 0x46, 0x31, 0xee, 0xd3, 0xf1, 0x33, 0x1e, 0x57, 0x1d, 0x29, 0x2d, 0xb2,
+		0x6d, 0x32, 0x3b, 0x1d, 0x64, 0xb8, 0x0e, 0x1f, 0x57, 0x46, 0x5f, 0x62, 0x64, 0x7c, 0x9a, 0xe6,
+		0xda, 0x8a, 0xa6, 0x87, 0x78, 0x93, 0x84, 0x57, 0xe0, 0x05, 0x1e, 0x73, 0x19, 0x8e, 0x71, 0xdc,
+		0x16, 0x72, 0x15, 0x75, 0x07, 0xb5, 0x7c, 0xa1, 0x34, 0x49, 0x8f, 0x3c, 0xc2, 0x18, 0x6a, 0xac,
+		0x9c, 0xcb, 0x96, 0xbe, 0x19, 0x83, 0x29, 0xbe, 0xd1, 0x6b, 0x79, 0xca, 0x5a, 0x05, 0x50, 0x0c,
+		0xc3, 0x74, 0x83, 0xea, 0xea, 0x37, 0xe5, 0x3e, 0xb9, 0xc5, 0x8a, 0x27, 0x24, 0x05, 0x00, 0x66,
+		0x3b, 0x00, 0x7e, 0xc9, 0x50, 0xb5, 0xcd, 0xc3, 0x04, 0x3b, 0xa3, 0x22, 0x07, 0x9d, 0x34, 0x35,
+		0x00, 0x94, 0x84, 0x77, 0x84, 0x38, 0x81, 0xb3, 0x85, 0xda, 0x9a, 0xc1, 0x32, 0xcf, 0xf4, 0x81,
+		0x27, 0x70, 0x92, 0x5e, 0x02, 0xa7, 0xfa, 0x67, 0x61, 0x5a, 0x35, 0x3b, 0xbd, 0xcd, 0xad, 0x0a,
+		0x3d, 0xe9, 0x09, 0xe7, 0xc5, 0xd8, 0x2b, 0x67, 0x18, 0x53, 0xdb, 0xd4, 0x15, 0xa3, 0xbd, 0x68,
+		0xda, 0x6d, 0xff, 0xa0, 0x16, 0x47, 0x48, 0x4e, 0xe0, 0xb8, 0xd6, 0xda, 0xfa, 0x3f, 0xb1, 0xd8,
+		0x2f, 0xc4, 0x13, 0xd7, 0x1a, 0xd5, 0x37, 0xe3, 0xb3, 0xd7, 0xa8, 0x60, 0x83, 0x2b, 0x43, 0x42,
+		0xdb, 0x3a, 0x52, 0x71, 0x07, 0xe1, 0xbb, 0x4f, 0xc3, 0x4c, 0xdb, 0x6c, 0x9b, 0x04, 0xe9, 0x2c,
+		0xfe, 0xc5, 0x4e, 0x7a, 0xb3, 0x1e, 0x75, 0x36, 0xf2, 0x58, 0xb8, 0xbc, 0x06, 0xd3, 0x8c, 0x59,
+		0x26, 0x47, 0x4d, 0x74, 0x23, 0x24, 0x1e, 0x98, 0x85, 0x2b, 0xfe, 0xda, 0x77, 0xc8, 0xf2, 0x2d,
+		0x4d, 0x31, 0x51, 0x5c, 0x46, 0xf7, 0x4a, 0x65, 0x09, 0x8e, 0x84, 0xf0, 0xe8, 0x24, 0x45, 0x76,
+		0x04, 0xe2, 0x3f, 0x67, 0x88, 0xd3, 0x01, 0xc4, 0x26, 0x13, 0x2d, 0x2f, 0xc1, 0xe4, 0x61, 0xb0,
+		0xfe, 0x05, 0xc3, 0xca, 0xa1, 0x20, 0xc8, 0x35, 0x28, 0x10, 0x10, 0xb5, 0xeb, 0xb8, 0x66, 0x87,
+		0x78, 0xc0, 0x83, 0x61, 0xfe, 0xe5, 0x77, 0xe8, 0xac, 0xc9, 0x63, 0xb1, 0x25, 0x4f, 0xaa, 0x5c,
+		0x06, 0x72, 0xba, 0x86, 0x4f, 0xbd, 0x22, 0x10, 0xbe, 0xc6, 0x1a, 0xe2, 0xf1, 0x97, 0x6f, 0xc2,
+		0x0c, 0xfe, 0x4d, 0x1c, 0x54, 0xb0, 0x25, 0xd1, 0x29, 0xbb, 0xe2, 0x37, 0x3f, 0x41, 0x27, 0xe6,
+		0xb4, 0x07, 0x10, 0x68, 0x53, 0x60, 0x14, 0xdb, 0xc8, 0x75, 0x91, 0xed, 0xc8, 0x8a, 0x3e, 0xa8,
+		0x79, 0x81, 0x9c, 0x47, 0xf1, 0x33, 0xdf, 0x0b, 0x8f, 0xe2, 0x35, 0x2a, 0x59, 0xd1, 0xf5, 0xf2,
+		0x26, 0x3c, 0x32, 0xc0, 0x2a, 0x46, 0xc0, 0xfc, 0x2c, 0xc3, 0x9c, 0xe9, 0xb3, 0x0c, 0x0c, 0xdb,
+		0x00, 0x4e, 0xf7, 0xc6, 0x72, 0x04, 0xcc, 0x9f, 0x63, 0x98, 0x22, 0x93, 0xe5, 0x43, 0x8a, 0x11,
+		0xaf, 0xc3, 0xd4, 0x1e, 0xb2, 0xb7, 0x4c, 0x87, 0xe5, 0x99, 0x46, 0x80, 0xfb, 0x1c, 0x83, 0x2b,
+		0x30, 0x41, 0x92, 0x78, 0xc2, 0x58, 0x97, 0x21, 0xb3, 0xad, 0xa8, 0x68, 0x04, 0x88, 0x7b, 0x0c,
+		0x62, 0x1c, 0xf3, 0x63, 0xd1, 0x0a, 0xe4, 0xda, 0x26, 0x5b, 0xa3, 0xa2, 0xc5, 0x3f, 0xcf, 0xc4,
+		0x27, 0xb8, 0x0c, 0x83, 0xb0, 0x4c, 0xab, 0xab, 0xe3, 0x05, 0x2c, 0x1a, 0xe2, 0xe7, 0x39, 0x04,
+		0x97, 0x61, 0x10, 0x87, 0x50, 0xeb, 0x17, 0x38, 0x84, 0x13, 0xd0, 0xe7, 0x0b, 0xf8, 0xf8, 0x49,
+		0xdf, 0x37, 0x8d, 0x51, 0x1a, 0xf1, 0x06, 0x43, 0x00, 0x26, 0x82, 0x01, 0xae, 0x40, 0x76, 0xd4,
+		0x81, 0xf8, 0xe5, 0xef, 0xf1, 0xe9, 0xc1, 0x47, 0xe0, 0x1a, 0x14, 0xb8, 0x83, 0xc2, 0xc7, 0xd5,
+		0xd1, 0x10, 0x7f, 0x83, 0x41, 0xe4, 0x03, 0x62, 0xac, 0x1b, 0x2e, 0x72, 0xdc, 0x36, 0x1a, 0x05,
+		0xe4, 0x8b, 0xbc, 0x1b, 0x4c, 0x84, 0xa9, 0x72, 0x0b, 0x19, 0xea, 0xce, 0x68, 0x08, 0xbf, 0xc2,
+		0x55, 0xc9, 0x65, 0x30, 0xc4, 0x12, 0x4c, 0x76, 0x14, 0xdb, 0xd9, 0x51, 0xf4, 0x91, 0x86, 0xe3,
+		0x6f, 0x32, 0x8c, 0x9c, 0x27, 0xc4, 0x34, 0xd2, 0x35, 0x0e, 0x03, 0xf3, 0x26, 0xd7, 0x48, 0xd7,
+		0x08, 0x01, 0x35, 0x60, 0xc6, 0x71, 0x49, 0x52, 0xee, 0x30, 0x68, 0x7f, 0x8b, 0x4f, 0x3d, 0x2a,
+		0xbb, 0x1a, 0x44, 0xbc, 0x02, 0x59, 0x47, 0x7b, 0x6d, 0x24, 0x98, 0x5f, 0xe5, 0x23, 0x4d, 0x04,
+		0xb0, 0xf0, 0xcb, 0x70, 0x6c, 0xe0, 0x32, 0x31, 0x02, 0xd8, 0xdf, 0x66, 0x60, 0x47, 0x07, 0x2c,
+		0x15, 0xcc, 0x25, 0x1c, 0x16, 0xf2, 0xef, 0x70, 0x97, 0x80, 0x7a, 0xb0, 0x1a, 0x78, 0xd7, 0xe0,
+		0x28, 0xdb, 0x87, 0xd3, 0xda, 0xdf, 0xe5, 0x5a, 0xa3, 0xb2, 0x21, 0xad, 0x6d, 0xc0, 0x51, 0x86,
+		0x78, 0xb8, 0x71, 0xfd, 0x7b, 0xdc, 0xb1, 0x52, 0xe9, 0xcd, 0xf0, 0xe8, 0xfe, 0x08, 0xcc, 0x7a,
+		0xea, 0xe4, 0xe1, 0xa9, 0x23, 0xe3, 0x4c, 0x56, 0x34, 0xf2, 0xaf, 0x31, 0x64, 0xee, 0xf1, 0xbd,
+		0xf8, 0xd6, 0x59, 0x55, 0x2c, 0x0c, 0x7e, 0x0b, 0x8a, 0x1c, 0xbc, 0x6b, 0xd8, 0x48, 0x35, 0xdb,
+		0x86, 0xf6, 0x1a, 0x6a, 0x8d, 0x00, 0xfd, 0xeb, 0x3d, 0x43, 0xb5, 0x19, 0x10, 0xc7, 0xc8, 0xcb,
+		0x20, 0x78, 0xb1, 0x8a, 0xac, 0x75, 0x2c, 0xd3, 0x76, 0x23, 0x10, 0xbf, 0xc4, 0x47, 0xca, 0x93,
+		0x5b, 0x26, 0x62, 0xe5, 0x3a, 0xd0, 0x93, 0xea, 0x51, 0x4d, 0xf2, 0xcb, 0x0c, 0x68, 0xd2, 0x97,
+		0x62, 0x8e, 0x43, 0x35, 0x3b, 0x96, 0x62, 0x8f, 0xe2, 0xff, 0xfe, 0x3e, 0x77, 0x1c, 0x4c, 0x84,
+		0x39, 0x0e, 0x1c, 0xd1, 0xe1, 0xd5, 0x7e, 0x04, 0x84, 0xaf, 0x70, 0xc7, 0xc1, 0x65, 0x18, 0x04,
+		0x0f, 0x18, 0x46, 0x80, 0xf8, 0x07, 0x1c, 0x82, 0xcb, 0x60, 0x88, 0x8f, 0xfa, 0x0b, 0xad, 0x8d,
+		0xda, 0x9a, 0xe3, 0xda, 0x34, 0x28, 0x3e, 0x18, 0xea, 0x1f, 0x7e, 0x2f, 0x1c, 0x84, 0x49, 0x01,
+		0x51, 0xec, 0x89, 0x58, 0x9a, 0x96, 0xec, 0x99, 0xa2, 0x1b, 0xf6, 0x1b, 0xdc, 0x13, 0x05, 0xc4,
+		0x70, 0xdb, 0x02, 0x11, 0x22, 0x56, 0xbb, 0x8a, 0x77, 0x0a, 0x23, 0xc0, 0xfd, 0xa3, 0x9e, 0xc6,
+		0x35, 0xb9, 0x2c, 0xc6, 0x0c, 0xc4, 0x3f, 0x5d, 0x63, 0x17, 0xed, 0x8f, 0x64, 0x9d, 0xff, 0xb8,
+		0x27, 0xfe, 0xd9, 0xa4, 0x92, 0xd4, 0x87, 0x14, 0x7a, 0xe2, 0x29, 0x31, 0xea, 0x5e, 0x52, 0xf1,
+		0xe3, 0xef, 0xb2, 0xfe, 0x86, 0xc3, 0xa9, 0xf2, 0x0a, 0x08, 0x8c, 0xe2, 0x07, 0xb0, 0x91, 0x60,
+		0x9f, 0x78, 0xd7, 0xb3, 0xf3, 0x50, 0xcc, 0x53, 0xbe, 0x0a, 0x93, 0xa1, 0x80, 0x27, 0x1a, 0xea,
+		0xcf, 0x33, 0xa8, 0x5c, 0x30, 0xde, 0x29, 0x5f, 0x80, 0x24, 0x0e, 0x5e, 0xa2, 0xc5, 0xff, 0x02,
+		0x13, 0x27, 0xec, 0xe5, 0x0f, 0x43, 0x86, 0x07, 0x2d, 0xd1, 0xa2, 0x7f, 0x91, 0x89, 0x7a, 0x22,
+		0x58, 0x9c, 0x07, 0x2c, 0xd1, 0xe2, 0x7f, 0x89, 0x8b, 0x73, 0x11, 0x2c, 0x3e, 0xba, 0x0a, 0xbf,
+		0xfa, 0x93, 0x49, 0x2a, 0xce, 0x45, 0xca, 0xf8, 0xa4, 0x9c, 0x46, 0x2a, 0xd1, 0xd2, 0x3f, 0xc1,
+		0x2a, 0xe7, 0x12, 0xe5, 0x4b, 0x90, 0x1a, 0x51, 0xe1, 0x7f, 0x99, 0x89, 0x52, 0xfe, 0xf2, 0x12,
+		0x4c, 0x04, 0xa2, 0x93, 0x68, 0xf1, 0xbf, 0xc2, 0xc4, 0x83, 0x52, 0xb8, 0xe9, 0x2c, 0x3a, 0x89,
+		0x06, 0xf8, 0xab, 0xbc, 0xe9, 0x4c, 0x02, 0xab, 0x8d, 0x07, 0x26, 0xd1, 0xd2, 0x9f, 0xe2, 0x5a,
+		0xe7, 0x22, 0xe5, 0x17, 0x20, 0xeb, 0x2d, 0x36, 0xd1, 0xf2, 0x3f, 0xc5, 0xe4, 0x7d, 0x19, 0xac,
+		0x81, 0xae, 0x71, 0x08, 0x88, 0x9f, 0xe6, 0x1a, 0x08, 0x48, 0xe1, 0x69, 0xd4, 0x1b, 0xc0, 0x44,
+		0x23, 0xfd, 0x0c, 0x9f, 0x46, 0x3d, 0xf1, 0x0b, 0x1e, 0x4d, 0xe2, 0xf3, 0xa3, 0x21, 0xfe, 0x1a,
+		0x1f, 0x4d, 0xc2, 0x8f, 0x9b, 0xd1, 0x1b, 0x11, 0x44, 0x63, 0xfc, 0x2c, 0x6f, 0x46, 0x4f, 0x40,
+		0x50, 0x6e, 0x80, 0xd8, 0x1f, 0x0d, 0x44, 0xe3, 0x7d, 0x9a, 0xe1, 0x4d, 0xf5, 0x05, 0x03, 0xe5,
+		0x97, 0xe0, 0xe8, 0xe0, 0x48, 0x20, 0x1a, 0xf5, 0x33, 0xef, 0xf6, 0xec, 0xdd, 0x82, 0x81, 0x40,
+		0x79, 0x03, 0x66, 0x06, 0x45, 0x01, 0xd1, 0xb0, 0x9f, 0x7d, 0x37, 0xec, 0xb8, 0x83, 0x41, 0x40,
+		0xb9, 0x02, 0xe0, 0x2f, 0xc0, 0xd1, 0x58, 0x9f, 0x63, 0x58, 0x01, 0x21, 0x3c, 0x35, 0xd8, 0xfa,
+		0x1b, 0x2d, 0x7f, 0x8f, 0x4f, 0x0d, 0x26, 0x81, 0xa7, 0x06, 0x5f, 0x7a, 0xa3, 0xa5, 0x3f, 0xcf,
+		0xa7, 0x06, 0x17, 0xc1, 0x96, 0x1d, 0x58, 0xdd, 0xa2, 0x11, 0xde, 0xe0, 0x96, 0x1d, 0x90, 0x2a,
+		0xaf, 0xc1, 0x54, 0xdf, 0x82, 0x18, 0x0d, 0xf5, 0x0b, 0x0c, 0x4a, 0xe8, 0x5d, 0x0f, 0x83, 0x8b,
+		0x17, 0x5b, 0x0c, 0xa3, 0xd1, 0x7e, 0xb1, 0x67, 0xf1, 0x62, 0x6b, 0x61, 0xf9, 0x0a, 0x64, 0x8c,
+		0xae, 0xae, 0xe3, 0xc9, 0x23, 0x1e, 0x7c, 0x97, 0xb0, 0xf8, 0xdf, 0x7e, 0xc0, 0xb4, 0xc3, 0x05,
+		0xca, 0x17, 0x20, 0x85, 0x3a, 0x5b, 0xa8, 0x15, 0x25, 0xf9, 0xdd, 0x1f, 0x70, 0x87, 0x89, 0xb9,
+		0xcb, 0x2f, 0x00, 0xd0, 0xd4, 0x08, 0x39, 0x3c, 0x8c, 0x90, 0xfd, 0xef, 0x3f, 0x60, 0x97, 0x77,
+		0x7c, 0x11, 0x1f, 0x80, 0x5e, 0x05, 0x3a, 0x18, 0xe0, 0x7b, 0x61, 0x00, 0x32, 0x22, 0x97, 0x61,
+		0x1c, 0x5f, 0xa9, 0x74, 0x95, 0x76, 0x94, 0xf4, 0xff, 0x60, 0xd2, 0x9c, 0x1f, 0x2b, 0xac, 0x63,
+		0xda, 0xc8, 0x55, 0xda, 0x4e, 0x94, 0xec, 0xff, 0x64, 0xb2, 0x9e, 0x00, 0x16, 0x56, 0x15, 0xc7,
+		0x1d, 0xa5, 0xdf, 0xbf, 0xc7, 0x85, 0xb9, 0x00, 0x6e, 0x34, 0xfe, 0xbd, 0x8b, 0xf6, 0xa3, 0x64,
+		0xbf, 0xcf, 0x1b, 0xcd, 0xf8, 0xcb, 0x1f, 0x86, 0x2c, 0xfe, 0x49, 0x6f, 0xe4, 0x45, 0x08, 0xff,
+		0x2f, 0x26, 0xec, 0x4b, 0xe0, 0x9a, 0x1d, 0xb7, 0xe5, 0x6a, 0xd1, 0xca, 0x7e, 0x87, 0x8d, 0x34,
+		0xe7, 0x2f, 0x57, 0x60, 0xc2, 0x71, 0x5b, 0xad, 0x2e, 0x8b, 0x4f, 0x23, 0xc4, 0x7f, 0xff, 0x07,
+		0x5e, 0xca, 0xc2, 0x93, 0xc1, 0xa3, 0x7d, 0x7b, 0xd7, 0xb5, 0x4c, 0x72, 0xe0, 0x11, 0x85, 0xf0,
+		0x2e, 0x43, 0x08, 0x88, 0x94, 0x97, 0x20, 0x87, 0xfb, 0x62, 0x23, 0x0b, 0x91, 0xd3, 0xa9, 0x08,
+		0x88, 0x3f, 0x60, 0x0a, 0x08, 0x09, 0x55, 0x7f, 0xf4, 0x6b, 0x6f, 0xcd, 0xc5, 0xbe, 0xf1, 0xd6,
+		0x5c, 0xec, 0x5b, 0x6f, 0xcd, 0xc5, 0x3e, 0xf5, 0xed, 0xb9, 0xb1, 0x6f, 0x7c, 0x7b, 0x6e, 0xec,
+		0x77, 0xbe, 0x3d, 0x37, 0x36, 0x38, 0x4b, 0x0c, 0xd7, 0xcc, 0x6b, 0x26, 0xcd, 0x0f, 0xbf, 0x52,
+		0x6a, 0x6b, 0xee, 0x4e, 0x77, 0x6b, 0x51, 0x35, 0x3b, 0x24, 0x8d, 0xeb, 0x67, 0x6b, 0xbd, 0x4d,
+		0x0e, 0xfc, 0x41, 0x0c, 0x8e, 0x51, 0x0c, 0xbf, 0x54, 0x31, 0xf6, 0x87, 0xbc, 0xdb, 0x33, 0x3b,
+		0x30, 0x31, 0x5c, 0xfa, 0x10, 0x24, 0x2a, 0xc6, 0xbe, 0x78, 0x8c, 0xfa, 0x3c, 0xb9, 0x6b, 0xeb,
+		0xec, 0xa6, 0xd8, 0x38, 0x7e, 0xde, 0xb4, 0x75, 0x9c, 0xfb, 0xe6, 0xd7, 0x39, 0xf1, 0x11, 0x0b,
+		0x7d, 0x28, 0x27, 0xbf, 0xff, 0xc6, 0xfc, 0x58, 0x75, 0xb7, 0xb7, 0x87, 0x5f, 0x8d, 0xec, 0x65,
+		0xa6, 0x62, 0xec, 0x93, 0x4e, 0x36, 0x62, 0xaf, 0xa4, 0x70, 0x1d, 0x0e, 0x4f, 0x6c, 0xcf, 0xf5,
+		0x26, 0xb6, 0x5f, 0x42, 0xba, 0x7e, 0xc3, 0x30, 0x6f, 0x1b, 0xf8, 0x3c, 0xdc, 0xd9, 0x4a, 0xd3,
+		0x6b, 0xc7, 0xf0, 0x33, 0x71, 0x98, 0xeb, 0xed, 0x37, 0x1f, 0xf9, 0x61, 0x2f, 0x36, 0x95, 0x21,
+		0x53, 0xe3, 0x06, 0x55, 0xc4, 0x6f, 0xd4, 0xa8, 0xa6, 0xd1, 0x72, 0x48, 0x57, 0x13, 0x12, 0x7f,
+		0xc4, 0x5d, 0x35, 0x14, 0xc3, 0x74, 0xd8, 0x6d, 0x4a, 0xfa, 0x50, 0xfd, 0xb9, 0xd8, 0xe1, 0xc6,
+		0x71, 0x92, 0xd7, 0xc4, 0xbb, 0xf9, 0x4c, 0x64, 0xaa, 0x7f, 0x17, 0xf7, 0xd2, 0xeb, 0x44, 0x28,
+		0xdd, 0x3f, 0xaa, 0x56, 0x7e, 0x36, 0x0e, 0xf3, 0xbd, 0x5a, 0xc1, 0xd3, 0xc9, 0x71, 0x95, 0x8e,
+		0x35, 0x4c, 0x2d, 0x57, 0x20, 0xbb, 0xc1, 0x79, 0x0e, 0xad, 0x97, 0x7b, 0x87, 0xd4, 0x4b, 0xde,
+		0xab, 0x8a, 0x2b, 0xe6, 0xfc, 0x88, 0x8a, 0xf1, 0xfa, 0xf1, 0x40, 0x9a, 0xf9, 0xbf, 0x69, 0x38,
+		0xa6, 0x9a, 0x4e, 0xc7, 0x74, 0x64, 0x6a, 0xfe, 0xf4, 0x81, 0xe9, 0x24, 0x17, 0x2c, 0x8a, 0x3e,
+		0x1c, 0x29, 0xdd, 0x80, 0xe9, 0x65, 0xec, 0x22, 0xf0, 0xd6, 0xc7, 0x3f, 0xd6, 0x19, 0x78, 0xe1,
+		0x74, 0x21, 0x14, 0xe5, 0xb3, 0x63, 0xa5, 0x20, 0xa9, 0xf4, 0xf1, 0x18, 0x08, 0x4d, 0x55, 0xd1,
+		0x15, 0xfb, 0x8f, 0x0a, 0x25, 0x5e, 0x02, 0x20, 0x2f, 0x2a, 0xf9, 0x6f, 0x16, 0xe5, 0xcf, 0x17,
+		0x17, 0x83, 0x9d, 0x5b, 0xa4, 0x35, 0x91, 0xd7, 0x16, 0xb2, 0x84, 0x17, 0xff, 0x3c, 0x7d, 0x0b,
+		0xc0, 0x2f, 0x10, 0x8f, 0xc3, 0x23, 0xcd, 0xa5, 0xca, 0x4a, 0x45, 0x92, 0xe9, 0x0d, 0xf8, 0xb5,
+		0x66, 0xa3, 0xbe, 0xb4, 0x7c, 0x75, 0xb9, 0x5e, 0x13, 0xc6, 0xc4, 0xa3, 0x20, 0x06, 0x0b, 0xbd,
+		0xcb, 0x28, 0x47, 0x60, 0x2a, 0x48, 0xa7, 0xd7, 0xe8, 0xe3, 0x38, 0x3c, 0xd4, 0x3a, 0x96, 0x8e,
+		0xc8, 0x79, 0x9f, 0xac, 0x71, 0xad, 0x45, 0x47, 0x1e, 0xff, 0xea, 0xdf, 0xd2, 0xab, 0xd5, 0xd3,
+		0xbe, 0xb8, 0xa7, 0xf3, 0xf2, 0x0a, 0x4c, 0xe1, 0xcb, 0x5e, 0x56, 0x08, 0x32, 0xc2, 0x3f, 0x63,
+		0x40, 0x72, 0x82, 0xc9, 0x24, 0x7d, 0xb4, 0x4b, 0x90, 0x76, 0x48, 0xef, 0xa3, 0x20, 0xbe, 0xce,
+		0x20, 0x18, 0x7b, 0xd9, 0x80, 0x29, 0x1c, 0xee, 0xe1, 0xac, 0x90, 0xdf, 0x8c, 0x83, 0x93, 0x0b,
+		0xff, 0xe4, 0x4b, 0xe7, 0xc8, 0x79, 0xe6, 0x63, 0xe1, 0x61, 0x19, 0x60, 0x4e, 0x92, 0xc0, 0xb0,
+		0xfd, 0x86, 0x22, 0xc8, 0xf3, 0xfa, 0x58, 0x83, 0x0f, 0xae, 0xec, 0x9f, 0xb2, 0xca, 0xe6, 0x06,
+		0xd9, 0x40, 0xa0, 0xa6, 0x49, 0x86, 0x4a, 0x0b, 0xaa, 0xf5, 0x61, 0x73, 0xfa, 0x95, 0xa7, 0x03,
+		0x4b, 0x12, 0x85, 0x64, 0x7f, 0xce, 0x10, 0xe4, 0x2b, 0xc1, 0x6a, 0xbc, 0xb9, 0xf7, 0xdb, 0x09,
+		0x98, 0x63, 0xcc, 0x5b, 0x8a, 0x83, 0xce, 0xee, 0x3d, 0xb3, 0x85, 0x5c, 0xe5, 0x99, 0xb3, 0xaa,
+		0xa9, 0x71, 0x5f, 0x3d, 0xcd, 0xa6, 0x23, 0x2e, 0x5f, 0x64, 0xe5, 0x83, 0x17, 0xab, 0xd9, 0xe1,
+		0xd3, 0xb8, 0xb4, 0x09, 0xc9, 0x25, 0x53, 0x33, 0xb0, 0xab, 0x6a, 0x21, 0xc3, 0xec, 0xb0, 0xd9,
+		0x43, 0x1f, 0xc4, 0x67, 0x20, 0xad, 0x74, 0xcc, 0xae, 0xe1, 0xd2, 0x99, 0x53, 0x3d, 0xf6, 0xb5,
+		0xfb, 0xf3, 0x63, 0xff, 0xfe, 0xfe, 0x7c, 0x62, 0xd9, 0x70, 0x7f, 0xeb, 0xcb, 0x67, 0x80, 0x41,
+		0x2d, 0x1b, 0xae, 0xc4, 0x18, 0xcb, 0xc9, 0xb7, 0xbf, 0x30, 0x1f, 0x2b, 0xdd, 0x82, 0xf1, 0x1a,
+		0x52, 0x1f, 0x04, 0xb9, 0x86, 0xd4, 0x00, 0x72, 0x0d, 0xa9, 0x3d, 0xc8, 0x97, 0x20, 0xb3, 0x6c,
+		0xb8, 0xf4, 0xb6, 0xfa, 0xd3, 0x90, 0xd0, 0x0c, 0x7a, 0x01, 0xf2, 0xc0, 0xb6, 0x61, 0x2e, 0x2c,
+		0x58, 0x43, 0xaa, 0x27, 0xd8, 0x42, 0x6a, 0x31, 0x16, 0x55, 0x35, 0xe6, 0xaa, 0xd6, 0x7e, 0xe7,
+		0x3f, 0xcf, 0x8d, 0xbd, 0xfe, 0xd6, 0xdc, 0xd8, 0xd0, 0x21, 0x2e, 0x0d, 0x1d, 0x62, 0xa7, 0xb5,
+		0x4b, 0x3d, 0xb2, 0x37, 0xb2, 0x6f, 0x26, 0xe1, 0x04, 0x79, 0x89, 0xc9, 0xee, 0x68, 0x86, 0x7b,
+		0x56, 0xb5, 0xf7, 0x2d, 0x97, 0x84, 0x29, 0xe6, 0x36, 0x1b, 0xd8, 0x29, 0xbf, 0x78, 0x91, 0x16,
+		0x0f, 0x89, 0x41, 0xb6, 0x21, 0xd5, 0xc0, 0x72, 0x58, 0xc5, 0xae, 0xe9, 0x2a, 0x3a, 0x5b, 0x7f,
+		0xe8, 0x03, 0xa6, 0xd2, 0x17, 0x9f, 0xe2, 0x94, 0xaa, 0xf1, 0x77, 0x9e, 0x74, 0xa4, 0x6c, 0xd3,
+		0xfb, 0xe3, 0x09, 0x12, 0x9a, 0x64, 0x30, 0x81, 0x5c, 0x15, 0x9f, 0x81, 0x94, 0xd2, 0xa5, 0x17,
+		0x17, 0x12, 0x38, 0x66, 0x21, 0x0f, 0xa5, 0x1b, 0x30, 0xce, 0x8e, 0x4f, 0xf1, 0xd1, 0xfd, 0x2e,
+		0xda, 0x27, 0xf5, 0xe4, 0x24, 0xfc, 0x53, 0x5c, 0x84, 0x14, 0x69, 0x3c, 0x7b, 0x31, 0xa6, 0xb8,
+		0xd8, 0xd7, 0xfa, 0x45, 0xd2, 0x48, 0x89, 0xb2, 0x95, 0xae, 0x43, 0xa6, 0x66, 0x76, 0x34, 0xc3,
+		0x0c, 0xa3, 0x65, 0x29, 0x1a, 0x69, 0xb3, 0xd5, 0x65, 0x56, 0x21, 0xd1, 0x07, 0x7c, 0xab, 0x92,
+		0xbe, 0x4f, 0xc0, 0x2e, 0x5f, 0xb0, 0xa7, 0xd2, 0x12, 0x8c, 0x13, 0xec, 0x75, 0x0b, 0x3b, 0x7f,
+		0xef, 0xea, 0x66, 0x96, 0xbd, 0x5d, 0xc6, 0xe0, 0xe3, 0x7e, 0x63, 0x45, 0x48, 0xb6, 0x14, 0x57,
+		0x61, 0xfd, 0x26, 0xbf, 0x4b, 0x1f, 0x81, 0x0c, 0x03, 0x71, 0xc4, 0xf3, 0x90, 0x30, 0x2d, 0x87,
+		0x5d, 0x9f, 0x98, 0x1d, 0xd6, 0x95, 0x75, 0xab, 0x9a, 0xc4, 0x36, 0x23, 0x61, 0xe6, 0xaa, 0x34,
+		0xd4, 0x2c, 0x9e, 0x0f, 0x98, 0x45, 0x60, 0xc8, 0x03, 0x3f, 0xe9, 0x90, 0xf6, 0x99, 0x83, 0x67,
+		0x2c, 0x6f, 0xc4, 0x61, 0x2e, 0x50, 0xba, 0x87, 0x6c, 0x47, 0x33, 0x0d, 0x6a, 0x51, 0xcc, 0x5a,
+		0xc4, 0x40, 0x23, 0x59, 0xf9, 0x10, 0x73, 0xf9, 0x30, 0x24, 0x2a, 0x96, 0x85, 0x5f, 0xab, 0x23,
+		0xcf, 0xaa, 0x49, 0xed, 0x25, 0x29, 0x79, 0xcf, 0xb8, 0xcc, 0x31, 0xb7, 0xdd, 0xdb, 0x8a, 0xed,
+		0xbd, 0x72, 0xc7, 0x9f, 0x4b, 0x97, 0x21, 0xbb, 0x64, 0x1a, 0x0e, 0x32, 0x9c, 0x2e, 0x89, 0x6c,
+		0xb6, 0x74, 0x53, 0xdd, 0x65, 0x08, 0xf4, 0x01, 0x2b, 0x5c, 0xb1, 0x2c, 0x22, 0x99, 0x94, 0xf0,
+		0x4f, 0x3a, 0x67, 0xab, 0xcd, 0xa1, 0x2a, 0xba, 0x7c, 0x78, 0x15, 0xb1, 0x4e, 0x7a, 0x3a, 0xfa,
+		0xc3, 0x18, 0x3c, 0xda, 0x3f, 0xa1, 0x76, 0xd1, 0xbe, 0x73, 0xd8, 0xf9, 0x74, 0x0b, 0xb2, 0x0d,
+		0xf2, 0xde, 0xfb, 0x0d, 0xb4, 0x2f, 0xce, 0xc2, 0x38, 0x6a, 0x9d, 0xbf, 0x70, 0xe1, 0x99, 0xcb,
+		0xd4, 0xda, 0x5f, 0x1c, 0x93, 0x38, 0x41, 0x9c, 0x83, 0xac, 0x83, 0x54, 0xeb, 0xfc, 0x85, 0x8b,
+		0xbb, 0xcf, 0x50, 0xf3, 0x7a, 0x71, 0x4c, 0xf2, 0x49, 0xe5, 0x0c, 0xee, 0xf5, 0xdb, 0x6f, 0xcc,
+		0xc7, 0xaa, 0x29, 0x48, 0x38, 0xdd, 0xce, 0x7b, 0x6a, 0x23, 0x9f, 0x4d, 0xc1, 0x42, 0x50, 0x92,
+		0xc4, 0x7f, 0x7b, 0x8a, 0xae, 0xb5, 0x14, 0xff, 0x8b, 0x05, 0x42, 0x40, 0x07, 0x84, 0x63, 0xc8,
+		0x4a, 0x71, 0xa0, 0x26, 0x4b, 0xbf, 0x1e, 0x83, 0xdc, 0x4d, 0x8e, 0x8c, 0x3f, 0x71, 0x70, 0x05,
+		0xc0, 0xab, 0x89, 0x4f, 0x9b, 0xe3, 0x8b, 0xbd, 0x75, 0x2d, 0x7a, 0x32, 0x52, 0x80, 0x5d, 0xbc,
+		0x44, 0x0c, 0xd1, 0x32, 0x1d, 0xf6, 0x1a, 0x56, 0x84, 0xa8, 0xc7, 0x8c, 0x2f, 0xc5, 0x11, 0x0f,
+		0x27, 0xef, 0x99, 0x2e, 0xbe, 0x25, 0x60, 0x99, 0xb7, 0xd9, 0xcb, 0xad, 0x09, 0x49, 0x20, 0x25,
+		0x37, 0x49, 0x41, 0x03, 0xd3, 0x71, 0xa3, 0xb3, 0x1e, 0x0a, 0x0e, 0xd6, 0x95, 0x56, 0xcb, 0x46,
+		0x8e, 0xc3, 0x9c, 0x18, 0x7f, 0xc4, 0xef, 0x7e, 0x59, 0xdd, 0x2d, 0x99, 0x7b, 0x0c, 0xfc, 0xf6,
+		0xdc, 0x80, 0xf9, 0xcf, 0xed, 0x83, 0x79, 0x80, 0xb4, 0xd5, 0xdd, 0xc2, 0xd6, 0xf2, 0x18, 0xe4,
+		0x06, 0x34, 0x66, 0x62, 0xcf, 0x6f, 0x07, 0xf9, 0xdc, 0x02, 0xeb, 0x81, 0x6c, 0xd9, 0x9a, 0x69,
+		0x6b, 0xee, 0x3e, 0xb9, 0x03, 0x95, 0x90, 0x04, 0x5e, 0xd0, 0x60, 0xf4, 0xd2, 0x2e, 0x14, 0x9a,
+		0x24, 0x88, 0xf3, 0x5b, 0x7e, 0xc1, 0x6f, 0x5f, 0x2c, 0xba, 0x7d, 0x43, 0x5b, 0x16, 0xef, 0x6b,
+		0x59, 0xf5, 0xa3, 0x43, 0xad, 0xf3, 0xd2, 0xe1, 0xad, 0x33, 0xbc, 0xda, 0xfd, 0xde, 0x31, 0x78,
+		0xb4, 0xb7, 0x30, 0xe4, 0xbe, 0x46, 0x35, 0xcc, 0xa8, 0x3d, 0xda, 0xec, 0xc1, 0x8b, 0xea, 0x6c,
+		0x84, 0x1b, 0x9d, 0x8d, 0x9c, 0x42, 0xa5, 0xcb, 0x30, 0x89, 0x2f, 0x33, 0x36, 0x91, 0xfb, 0x22,
+		0x52, 0x5a, 0xc8, 0x0e, 0xaf, 0xba, 0x93, 0x7c, 0xd5, 0x15, 0x21, 0x49, 0x96, 0x56, 0xba, 0xea,
+		0x90, 0xdf, 0xa5, 0x1d, 0x48, 0x62, 0x51, 0x7f, 0x45, 0x66, 0x12, 0xe4, 0x01, 0x53, 0xb7, 0xf6,
+		0x5d, 0xe4, 0xf0, 0x44, 0x01, 0x79, 0x10, 0x9f, 0xe3, 0xeb, 0x6a, 0xe2, 0xe0, 0x75, 0x95, 0x19,
+		0x22, 0x5b, 0x5d, 0x75, 0x18, 0xaf, 0x62, 0x57, 0xbc, 0x5c, 0xf3, 0x1a, 0x12, 0xf3, 0x1b, 0x22,
+		0xae, 0x42, 0xc1, 0x52, 0x6c, 0x97, 0xbc, 0x42, 0xb2, 0x43, 0x7a, 0xc1, 0x6c, 0x7d, 0xbe, 0x7f,
+		0xe6, 0x85, 0x3a, 0xcb, 0x6a, 0x99, 0xb4, 0x82, 0xc4, 0xd2, 0x7f, 0x49, 0x42, 0x9a, 0x29, 0xe3,
+		0xc3, 0x30, 0xce, 0xd4, 0xca, 0xac, 0xf3, 0xc4, 0x62, 0xff, 0xc2, 0xb4, 0xe8, 0x2d, 0x20, 0x0c,
+		0x8f, 0xcb, 0x88, 0x4f, 0x42, 0x46, 0xdd, 0x51, 0x34, 0x43, 0xd6, 0x5a, 0x2c, 0x20, 0x9c, 0x78,
+		0xeb, 0xfe, 0xfc, 0xf8, 0x12, 0xa6, 0x2d, 0xd7, 0xa4, 0x71, 0x52, 0xb8, 0xdc, 0xc2, 0x91, 0xc0,
+		0x0e, 0xd2, 0xda, 0x3b, 0x2e, 0x9b, 0x61, 0xec, 0x09, 0x7f, 0x6b, 0x05, 0x1b, 0x04, 0x7b, 0xc1,
+		0x70, 0xb6, 0x2f, 0xc2, 0xf7, 0xb6, 0xd0, 0xd5, 0x0c, 0xae, 0xf8, 0x53, 0xbf, 0x3b, 0x1f, 0x93,
+		0x88, 0x84, 0xb8, 0x04, 0x93, 0xba, 0xe2, 0xb8, 0x32, 0x59, 0xc1, 0x70, 0xf5, 0x29, 0x02, 0x71,
+		0xac, 0x5f, 0x21, 0x4c, 0xb1, 0xac, 0xe9, 0x13, 0x58, 0x8a, 0x92, 0x5a, 0xf8, 0xfd, 0x27, 0x02,
+		0x82, 0xef, 0x70, 0x6a, 0x2e, 0x8d, 0xad, 0xd2, 0x44, 0xef, 0x79, 0x4c, 0x5f, 0x22, 0x64, 0x12,
+		0x61, 0x1d, 0x87, 0x2c, 0x79, 0xa5, 0x89, 0xb0, 0xd0, 0xcb, 0xb7, 0x19, 0x4c, 0x20, 0x85, 0x4f,
+		0x41, 0xc1, 0xf7, 0x8f, 0x94, 0x25, 0x43, 0x51, 0x7c, 0x32, 0x61, 0x3c, 0x07, 0x33, 0x06, 0xba,
+		0xe3, 0xca, 0x3e, 0x99, 0x72, 0x67, 0x09, 0xb7, 0x88, 0xcb, 0x6e, 0x86, 0x25, 0x9e, 0x80, 0xbc,
+		0xca, 0x95, 0x4f, 0x79, 0x81, 0xf0, 0x4e, 0x7a, 0x54, 0xc2, 0x76, 0x0c, 0x32, 0x8a, 0x65, 0x51,
+		0x86, 0x09, 0xe6, 0x1f, 0x2d, 0x8b, 0x14, 0x9d, 0x86, 0x29, 0xd2, 0x47, 0x1b, 0x39, 0x5d, 0xdd,
+		0x65, 0x20, 0x39, 0xc2, 0x53, 0xc0, 0x05, 0x12, 0xa5, 0x13, 0xde, 0xc7, 0x61, 0x12, 0xed, 0x69,
+		0x2d, 0x64, 0xa8, 0x88, 0xf2, 0x4d, 0x12, 0xbe, 0x1c, 0x27, 0x12, 0xa6, 0x53, 0xe0, 0xf9, 0x3d,
+		0x99, 0xfb, 0xe4, 0x3c, 0xc5, 0xe3, 0xf4, 0x0a, 0x25, 0x97, 0x8a, 0x90, 0xac, 0x29, 0xae, 0x82,
+		0x03, 0x0c, 0xf7, 0x0e, 0x5d, 0x68, 0x72, 0x12, 0xfe, 0x59, 0x7a, 0x3b, 0x0e, 0xc9, 0x9b, 0xa6,
+		0x8b, 0xc4, 0x67, 0x03, 0x01, 0x60, 0x7e, 0x90, 0x3d, 0x37, 0xb5, 0xb6, 0x81, 0x5a, 0xab, 0x4e,
+		0x3b, 0xf0, 0xfd, 0x01, 0xdf, 0x9c, 0xe2, 0x21, 0x73, 0x9a, 0x81, 0x94, 0x6d, 0x76, 0x8d, 0x16,
+		0xbf, 0xb7, 0x4a, 0x1e, 0xc4, 0x3a, 0x64, 0x3c, 0x2b, 0x49, 0x46, 0x59, 0x49, 0x01, 0x5b, 0x09,
+		0xb6, 0x61, 0x46, 0x90, 0xc6, 0xb7, 0x98, 0xb1, 0x54, 0x21, 0xeb, 0x39, 0xaf, 0x62, 0xea, 0x10,
+		0x06, 0xeb, 0x8b, 0xe1, 0xc5, 0xc4, 0x1b, 0x7b, 0x4f, 0x79, 0xd4, 0xe2, 0x04, 0xaf, 0x80, 0x69,
+		0x2f, 0x64, 0x56, 0xec, 0x5b, 0x08, 0xe3, 0xa4, 0x5f, 0xbe, 0x59, 0xd1, 0xef, 0x21, 0x3c, 0x8a,
+		0xaf, 0x21, 0xb5, 0x0d, 0xc5, 0xed, 0xda, 0x88, 0x59, 0x9e, 0x4f, 0xc0, 0x6f, 0xa9, 0xa4, 0xa9,
+		0x25, 0x07, 0xf4, 0x16, 0x1b, 0xac, 0xb7, 0xf8, 0x30, 0xbd, 0x25, 0x1e, 0x5c, 0x6f, 0x15, 0x00,
+		0xaf, 0x31, 0x0e, 0x7b, 0x45, 0x7d, 0x40, 0xc4, 0x40, 0x9b, 0xd8, 0xd4, 0xda, 0x6c, 0xa2, 0x06,
+		0x84, 0x4a, 0xff, 0x29, 0x06, 0x59, 0xaf, 0x5c, 0xac, 0xc0, 0x24, 0x6f, 0x97, 0xbc, 0xad, 0x2b,
+		0x6d, 0x66, 0x3b, 0x27, 0x86, 0x36, 0xee, 0xaa, 0xae, 0xb4, 0xa5, 0x09, 0xd6, 0x1e, 0xfc, 0x30,
+		0x78, 0x1c, 0xe2, 0x43, 0xc6, 0x21, 0x34, 0xf0, 0x89, 0x07, 0x1b, 0xf8, 0xd0, 0x10, 0x25, 0x7b,
+		0x87, 0xe8, 0x4b, 0x71, 0xb2, 0x99, 0xb1, 0x4c, 0x47, 0xd1, 0xdf, 0x8f, 0x19, 0x71, 0x1c, 0xb2,
+		0x96, 0xa9, 0xcb, 0xb4, 0x84, 0xde, 0xe7, 0xce, 0x58, 0xa6, 0x2e, 0xf5, 0x0d, 0x7b, 0xea, 0x21,
+		0x4d, 0x97, 0xf4, 0x43, 0xd0, 0xda, 0x78, 0xaf, 0xd6, 0x6c, 0xc8, 0x51, 0x55, 0xb0, 0xb5, 0xec,
+		0x1c, 0xd6, 0x01, 0xfe, 0x55, 0x8c, 0xf5, 0xaf, 0xbd, 0xb4, 0xd9, 0x94, 0x53, 0x4a, 0xef, 0x78,
+		0x12, 0xd4, 0xf5, 0x17, 0xe3, 0xc3, 0x24, 0xa8, 0xd9, 0x49, 0x8c, 0xaf, 0xf4, 0xd7, 0x63, 0x00,
+		0x2b, 0x58, 0xb3, 0xa4, 0xbf, 0x78, 0x15, 0x72, 0x48, 0x13, 0xe4, 0x50, 0xcd, 0x73, 0xc3, 0x06,
+		0x8d, 0xd5, 0x9f, 0x73, 0x82, 0xed, 0x5e, 0x82, 0x49, 0xdf, 0x18, 0x1d, 0xc4, 0x1b, 0x33, 0x77,
+		0x40, 0x54, 0xdd, 0x44, 0xae, 0x94, 0xdb, 0x0b, 0x3c, 0x95, 0x7e, 0x33, 0x06, 0x59, 0xd2, 0x26,
+		0xfc, 0x82, 0x6d, 0x68, 0x0c, 0x63, 0x0f, 0x3e, 0x86, 0x27, 0x00, 0x28, 0x0c, 0x3e, 0x94, 0x65,
+		0x96, 0x95, 0x25, 0x14, 0x7c, 0xd4, 0x2a, 0x5e, 0xf4, 0x14, 0x9e, 0x38, 0x58, 0xe1, 0x3c, 0xea,
+		0x66, 0x6a, 0x7f, 0x04, 0xc6, 0xc9, 0x27, 0x9d, 0xee, 0x38, 0x2c, 0x90, 0xc6, 0xdf, 0x71, 0xd8,
+		0xb8, 0xe3, 0x94, 0x5e, 0x85, 0xf1, 0x8d, 0x3b, 0x34, 0x37, 0x72, 0x1c, 0xb2, 0xb6, 0x69, 0xb2,
+		0x35, 0x99, 0xc6, 0x42, 0x19, 0x4c, 0x20, 0x4b, 0x10, 0xcf, 0x07, 0xc4, 0xfd, 0x7c, 0x80, 0x9f,
+		0xd0, 0x48, 0x8c, 0x94, 0xd0, 0x38, 0xfd, 0xdb, 0x31, 0x98, 0x08, 0xf8, 0x07, 0xf1, 0x19, 0x38,
+		0x52, 0x5d, 0x59, 0x5f, 0xba, 0x21, 0x2f, 0xd7, 0xe4, 0xab, 0x2b, 0x95, 0x6b, 0xfe, 0x1b, 0x4b,
+		0xb3, 0x47, 0xef, 0xde, 0x5b, 0x10, 0x03, 0xbc, 0x9b, 0x06, 0xc9, 0xd3, 0x8b, 0x67, 0x61, 0x26,
+		0x2c, 0x52, 0xa9, 0x36, 0xf1, 0xeb, 0x4b, 0xb1, 0xd9, 0x23, 0x77, 0xef, 0x2d, 0x4c, 0x05, 0x24,
+		0x2a, 0x5b, 0x0e, 0x32, 0xdc, 0x7e, 0x81, 0xa5, 0xf5, 0xd5, 0xd5, 0xe5, 0x0d, 0x21, 0xde, 0x27,
+		0xc0, 0x1c, 0xf6, 0x29, 0x98, 0x0a, 0x0b, 0xac, 0x2d, 0xaf, 0x08, 0x89, 0x59, 0xf1, 0xee, 0xbd,
+		0x85, 0x7c, 0x80, 0x7b, 0x4d, 0xd3, 0x67, 0x33, 0x9f, 0xfc, 0xc5, 0xb9, 0xb1, 0x5f, 0xf9, 0xa5,
+		0xb9, 0x18, 0xee, 0xd9, 0x64, 0xc8, 0x47, 0x88, 0x1f, 0x84, 0x47, 0x9a, 0xcb, 0xd7, 0xd6, 0xea,
+		0x35, 0x79, 0xb5, 0x79, 0x8d, 0x67, 0xba, 0x79, 0xef, 0x0a, 0x77, 0xef, 0x2d, 0x4c, 0xb0, 0x2e,
+		0x0d, 0xe3, 0x6e, 0x48, 0xf5, 0x9b, 0xeb, 0x1b, 0x75, 0x21, 0x46, 0xb9, 0x1b, 0x36, 0xda, 0x33,
+		0x5d, 0xfa, 0xcd, 0xb7, 0x73, 0x70, 0x6c, 0x00, 0xb7, 0xd7, 0xb1, 0xa9, 0xbb, 0xf7, 0x16, 0x26,
+		0x1b, 0x36, 0xa2, 0xf3, 0x87, 0x48, 0x2c, 0x42, 0xb1, 0x5f, 0x62, 0xbd, 0xb1, 0xde, 0xac, 0xac,
+		0x08, 0x0b, 0xb3, 0xc2, 0xdd, 0x7b, 0x0b, 0x39, 0xee, 0x0c, 0x31, 0xbf, 0xdf, 0xb3, 0xf7, 0x72,
+		0xc7, 0xf3, 0xe3, 0x1f, 0x81, 0x13, 0x8e, 0xab, 0xec, 0x6a, 0x46, 0xdb, 0xcb, 0xda, 0xb2, 0x67,
+		0xb6, 0xe5, 0x39, 0xa1, 0x6b, 0x1f, 0xeb, 0x6a, 0x2d, 0x4e, 0xe4, 0x7f, 0x23, 0x52, 0xb8, 0x43,
+		0x4f, 0x2c, 0x67, 0x23, 0x0e, 0xf5, 0xa2, 0xb7, 0x4e, 0xc3, 0xd3, 0xc3, 0xb3, 0x11, 0x49, 0xe8,
+		0xd9, 0x03, 0x37, 0x77, 0xa5, 0x4f, 0xc5, 0x20, 0xff, 0xa2, 0xe6, 0xb8, 0xa6, 0xad, 0xa9, 0x8a,
+		0x4e, 0xde, 0x53, 0xba, 0x38, 0xaa, 0x6f, 0xed, 0x99, 0xea, 0x57, 0x21, 0xbd, 0xa7, 0xe8, 0xd4,
+		0xa9, 0xd1, 0x57, 0xc1, 0x0e, 0xd4, 0xa2, 0xef, 0xe1, 0x38, 0x0e, 0x95, 0x2e, 0x7d, 0x2d, 0x01,
+		0x05, 0x32, 0x27, 0x1c, 0xfa, 0xe5, 0x2e, 0xbc, 0xd5, 0x6a, 0x40, 0xd2, 0x56, 0x5c, 0x96, 0x3b,
+		0xac, 0x7e, 0x88, 0xa5, 0x83, 0x9f, 0x8c, 0x4e, 0xea, 0x2e, 0xf6, 0x67, 0x8c, 0x09, 0x92, 0xf8,
+		0x12, 0x64, 0x3a, 0xca, 0x1d, 0x99, 0xa0, 0xc6, 0x1f, 0x02, 0xea, 0x78, 0x47, 0xb9, 0x83, 0xdb,
+		0x2a, 0xb6, 0xa0, 0x80, 0x81, 0xd5, 0x1d, 0xc5, 0x68, 0x23, 0x8a, 0x9f, 0x78, 0x08, 0xf8, 0x93,
+		0x1d, 0xe5, 0xce, 0x12, 0xc1, 0x24, 0xb5, 0x7c, 0x26, 0x06, 0x47, 0xa9, 0x7a, 0x65, 0xd5, 0xd3,
+		0x15, 0xad, 0x8d, 0xbc, 0x38, 0x53, 0x55, 0x47, 0xaf, 0xe9, 0x9d, 0xfb, 0xf3, 0x4f, 0xed, 0x2b,
+		0x1d, 0xbd, 0x5c, 0x1a, 0x8c, 0xf8, 0x41, 0xb3, 0xa3, 0xb9, 0xa8, 0x63, 0xb9, 0xfb, 0xa5, 0x9e,
+		0x46, 0xcd, 0x50, 0x81, 0xf0, 0x68, 0x95, 0x33, 0x9f, 0xfe, 0xc2, 0xfc, 0x18, 0x39, 0x09, 0xf8,
+		0xcd, 0x18, 0x80, 0x5f, 0x28, 0xaa, 0x20, 0xf4, 0x40, 0x3b, 0xcc, 0xc6, 0x16, 0x23, 0x6c, 0xa5,
+		0xc7, 0x1e, 0x68, 0x08, 0xf1, 0x8d, 0xfb, 0xf3, 0x31, 0xa9, 0xa0, 0xf6, 0x98, 0x4a, 0x1d, 0x26,
+		0xba, 0x56, 0x4b, 0x71, 0x91, 0x4c, 0xb6, 0x9b, 0xf1, 0x43, 0x84, 0x23, 0x40, 0x05, 0x71, 0x51,
+		0xa0, 0x13, 0xbf, 0x1a, 0x83, 0x89, 0x5a, 0xe0, 0x38, 0xb2, 0x08, 0xe3, 0x1d, 0xd3, 0xd0, 0x76,
+		0xd9, 0x04, 0xc9, 0x4a, 0xfc, 0x11, 0xe7, 0x66, 0xe9, 0xbb, 0xa4, 0xee, 0x3e, 0xcf, 0xcd, 0xf2,
+		0x67, 0x2c, 0x75, 0x1b, 0x6d, 0x39, 0x1a, 0x37, 0x07, 0x89, 0x3f, 0xe2, 0x4d, 0x96, 0x83, 0xd4,
+		0x2e, 0x4e, 0x2a, 0xc9, 0xaa, 0x69, 0xb8, 0x8a, 0xea, 0xb2, 0xb7, 0x12, 0x0b, 0x9c, 0xbe, 0x44,
+		0xc9, 0x18, 0xa4, 0x85, 0x5c, 0x45, 0xd3, 0x9d, 0x22, 0x3d, 0xb2, 0xe3, 0x8f, 0x81, 0xe6, 0xfe,
+		0xef, 0x4c, 0x30, 0x99, 0xb6, 0x04, 0x82, 0x69, 0x21, 0x3b, 0x14, 0xfc, 0xd2, 0x49, 0x54, 0xfc,
+		0xad, 0x2f, 0x9f, 0x99, 0x61, 0x63, 0xc9, 0xc2, 0x5f, 0x7a, 0xed, 0x56, 0x2a, 0x70, 0x09, 0x46,
+		0x16, 0x5f, 0x06, 0xc1, 0xdb, 0x83, 0xca, 0x56, 0x77, 0xcb, 0x4f, 0xc0, 0xcd, 0xf4, 0xe9, 0xb5,
+		0x62, 0xec, 0x57, 0x8b, 0x5f, 0xf7, 0xa1, 0xfd, 0xac, 0x17, 0x4e, 0x79, 0x15, 0x3c, 0x9c, 0x06,
+		0x81, 0xc1, 0xc1, 0xec, 0xab, 0x8a, 0xa6, 0xf3, 0x57, 0xe4, 0x25, 0xf6, 0x24, 0x56, 0x20, 0xed,
+		0xb8, 0x8a, 0xdb, 0x75, 0xd8, 0xa7, 0xef, 0x4e, 0x45, 0x18, 0x48, 0xd5, 0x34, 0x5a, 0x4d, 0x22,
+		0x20, 0x31, 0x41, 0x71, 0x03, 0xd2, 0xae, 0xb9, 0x8b, 0x0c, 0xa6, 0xab, 0x43, 0xcd, 0xbf, 0x01,
+		0x87, 0x67, 0x14, 0x4b, 0x6c, 0x83, 0xd0, 0x42, 0x3a, 0x6a, 0xd3, 0x08, 0x6e, 0x47, 0xc1, 0x1b,
+		0x9d, 0xf4, 0x43, 0x98, 0xdf, 0x05, 0x0f, 0xb5, 0x49, 0x40, 0x45, 0x29, 0x7c, 0x2e, 0x4e, 0x3f,
+		0x17, 0x79, 0x3a, 0x42, 0x0d, 0x01, 0x3b, 0xe5, 0x49, 0x90, 0x00, 0x08, 0x36, 0xb5, 0xae, 0xb1,
+		0x65, 0x1a, 0xe4, 0xb5, 0x56, 0xb6, 0x89, 0xc8, 0x90, 0xb0, 0xac, 0xe0, 0xd1, 0x5f, 0x24, 0x64,
+		0xf1, 0x06, 0xe4, 0x7d, 0x56, 0x32, 0x93, 0xb2, 0x87, 0x98, 0x49, 0x93, 0x9e, 0x2c, 0x2e, 0x15,
+		0xd7, 0x01, 0xfc, 0x69, 0x4a, 0xd2, 0x1a, 0x13, 0xe7, 0x4f, 0x8d, 0x3c, 0xe5, 0xf9, 0x2e, 0xd1,
+		0x87, 0x10, 0xff, 0x34, 0x1c, 0x67, 0xf9, 0x65, 0x2f, 0x9a, 0xc6, 0xf5, 0xf1, 0x01, 0x99, 0x78,
+		0x08, 0x03, 0x52, 0xa4, 0x69, 0x6a, 0x6f, 0x91, 0xc2, 0x06, 0x46, 0x47, 0x46, 0x87, 0x69, 0x5a,
+		0x39, 0x73, 0x97, 0xac, 0xd2, 0xdc, 0x43, 0xa8, 0x74, 0x8a, 0x00, 0xaf, 0x10, 0x5c, 0x56, 0xdb,
+		0xa7, 0x63, 0x70, 0x14, 0x2f, 0x28, 0xc1, 0xca, 0x64, 0x12, 0x3d, 0x14, 0x27, 0x1f, 0xdc, 0xd3,
+		0x0f, 0x46, 0x1c, 0xee, 0xe9, 0xa7, 0x3b, 0xca, 0x9d, 0x40, 0xb3, 0x24, 0xcc, 0x5d, 0xce, 0x7d,
+		0xf2, 0x0b, 0xf3, 0x63, 0xcc, 0xf1, 0x8c, 0x95, 0x1a, 0xe4, 0xe4, 0x81, 0xf9, 0x0c, 0xe4, 0x88,
+		0x17, 0x21, 0xab, 0xf0, 0x07, 0x92, 0x0f, 0x3a, 0xc8, 0xe7, 0xf8, 0xac, 0xd4, 0x95, 0xbd, 0xfe,
+		0x1f, 0x17, 0x62, 0xa5, 0x5f, 0x8a, 0x41, 0xba, 0x76, 0xb3, 0xa1, 0x68, 0xb6, 0x58, 0x87, 0x29,
+		0x6f, 0x82, 0x8c, 0xec, 0xc8, 0xfc, 0x99, 0xca, 0xe8, 0x18, 0x66, 0x70, 0x32, 0xe0, 0x40, 0x98,
+		0xde, 0x34, 0x41, 0x4f, 0xc7, 0x57, 0x60, 0x9c, 0xb6, 0x92, 0x7c, 0x9f, 0xc6, 0xc2, 0x3f, 0xd8,
+		0x41, 0xcb, 0x13, 0x51, 0xd3, 0x95, 0x88, 0x79, 0xf9, 0x61, 0x2c, 0x59, 0xfa, 0xc3, 0x18, 0x40,
+		0xed, 0xe6, 0xcd, 0x0d, 0x5b, 0xb3, 0x74, 0xe4, 0x3e, 0xac, 0x8e, 0xaf, 0xc0, 0x11, 0xbf, 0xe3,
+		0x8e, 0xad, 0x8e, 0xdc, 0xf9, 0x69, 0x7f, 0xeb, 0x69, 0xab, 0x03, 0xd1, 0x5a, 0x8e, 0xeb, 0xa1,
+		0x25, 0x46, 0x46, 0xab, 0x39, 0xee, 0x60, 0x6d, 0xbe, 0x02, 0x13, 0x7e, 0xf7, 0x1d, 0xf1, 0x06,
+		0x64, 0x5c, 0xf6, 0x9b, 0x29, 0xf5, 0x54, 0xa4, 0x52, 0xb9, 0x34, 0x53, 0xac, 0x07, 0x50, 0xfa,
+		0xe5, 0x38, 0x40, 0x8d, 0xaa, 0x06, 0x7b, 0x91, 0x3f, 0x51, 0x46, 0x85, 0xd7, 0x2b, 0xe6, 0x49,
+		0x1e, 0x46, 0xbc, 0xc8, 0xb0, 0x70, 0x56, 0x39, 0xec, 0x23, 0x8b, 0xf4, 0x3d, 0x91, 0xc9, 0xbd,
+		0xa0, 0x67, 0xeb, 0x19, 0x83, 0xbb, 0x71, 0xfc, 0x1d, 0x0e, 0xe6, 0xc1, 0xff, 0xc4, 0x2a, 0xec,
+		0x25, 0x18, 0x47, 0x86, 0x6b, 0x6b, 0x44, 0x63, 0xd8, 0x32, 0x2e, 0x45, 0x58, 0xc6, 0x80, 0x2e,
+		0x91, 0x4f, 0x5d, 0xf1, 0xa3, 0x0e, 0x86, 0xd6, 0xa3, 0x8c, 0xff, 0x10, 0x87, 0xe2, 0x30, 0x49,
+		0x9c, 0xb8, 0x55, 0x6d, 0x44, 0x08, 0x72, 0x28, 0xdf, 0x9a, 0xe7, 0x64, 0xb6, 0x9e, 0xae, 0x02,
+		0x8e, 0x54, 0xb1, 0x19, 0x62, 0xd6, 0x43, 0x87, 0xa6, 0x79, 0x5f, 0x18, 0x17, 0x8b, 0x08, 0x0a,
+		0x9a, 0xa1, 0xb9, 0x9a, 0xa2, 0xcb, 0x5b, 0x8a, 0xae, 0x18, 0xea, 0x83, 0xec, 0x32, 0xfa, 0xa3,
+		0x9c, 0x3c, 0x03, 0xad, 0x52, 0x4c, 0xf1, 0x26, 0x8c, 0x73, 0xf8, 0xe4, 0x43, 0x80, 0xe7, 0x60,
+		0x81, 0x70, 0xf5, 0xdf, 0xc5, 0x61, 0x4a, 0x42, 0xad, 0x1f, 0x2e, 0xb5, 0xfe, 0x08, 0x00, 0x9d,
+		0x9e, 0xd8, 0x79, 0x16, 0x93, 0x0f, 0x61, 0xba, 0x67, 0x29, 0x5e, 0xcd, 0x71, 0x03, 0xba, 0xfd,
+		0x66, 0x1c, 0x72, 0x41, 0xdd, 0xfe, 0x10, 0x2c, 0x26, 0x62, 0xc3, 0x77, 0x0a, 0xf4, 0xfc, 0xe1,
+		0x5c, 0x84, 0x53, 0xe8, 0x33, 0xbe, 0x83, 0xbd, 0xc1, 0x4f, 0x66, 0x21, 0xdd, 0x50, 0x6c, 0xa5,
+		0xe3, 0x88, 0xd7, 0xfb, 0x42, 0x64, 0x9e, 0x7f, 0xed, 0xfb, 0x22, 0x3b, 0x4b, 0xf7, 0x50, 0xcb,
+		0xfb, 0xf4, 0x80, 0x08, 0xf9, 0x09, 0xc8, 0xe3, 0x90, 0x2c, 0x70, 0x55, 0x23, 0x4e, 0x0e, 0xa0,
+		0xf1, 0xb6, 0xdf, 0x3f, 0x27, 0xc4, 0x9f, 0x73, 0xc1, 0x6c, 0xbe, 0xdb, 0xc3, 0x3c, 0xd0, 0x51,
+		0xee, 0xd4, 0x29, 0x45, 0x3c, 0x03, 0xe2, 0x8e, 0x97, 0xce, 0x91, 0x7d, 0x4d, 0x60, 0xbe, 0x29,
+		0xbf, 0x84, 0xb3, 0xe3, 0xac, 0x2f, 0x8e, 0x9b, 0xe9, 0xf5, 0x3f, 0xba, 0xa7, 0xcc, 0x62, 0x4a,
+		0x0d, 0x13, 0xc4, 0x1f, 0x83, 0xe9, 0x8e, 0x66, 0xf4, 0x65, 0x18, 0xe8, 0x7e, 0x67, 0xe5, 0x70,
+		0x06, 0xfb, 0xce, 0xfd, 0xf9, 0x59, 0x16, 0x7b, 0xf6, 0x43, 0x96, 0xa4, 0xa9, 0x8e, 0x66, 0x84,
+		0x77, 0xf9, 0xe2, 0x8f, 0xc7, 0x82, 0x96, 0x41, 0xda, 0xb9, 0xad, 0xa8, 0xae, 0x69, 0xd3, 0x4f,
+		0x89, 0x57, 0xd7, 0x0e, 0xdd, 0x80, 0x47, 0x69, 0x03, 0x06, 0x82, 0x96, 0xa4, 0xe9, 0xd0, 0x92,
+		0x78, 0x95, 0x50, 0xc5, 0x7d, 0x10, 0x71, 0x7b, 0x7b, 0xd6, 0x50, 0xf2, 0xb5, 0xa5, 0xea, 0x8d,
+		0x43, 0x37, 0xe0, 0x98, 0xaf, 0x81, 0x30, 0x62, 0x49, 0x12, 0x3a, 0x9a, 0x11, 0xda, 0x6d, 0x88,
+		0x16, 0xcc, 0xf7, 0x33, 0xca, 0x6d, 0x1b, 0x7f, 0xb5, 0xc3, 0x42, 0xb6, 0x66, 0xb6, 0xc8, 0x9e,
+		0x2c, 0x59, 0x3d, 0xfd, 0xce, 0xfd, 0xf9, 0x27, 0x87, 0x21, 0x87, 0x04, 0x4a, 0xd2, 0xf1, 0xde,
+		0x6a, 0xae, 0xe1, 0xe2, 0x06, 0x29, 0x15, 0x7f, 0x3e, 0x06, 0xf3, 0x3d, 0xd2, 0x8e, 0xae, 0x38,
+		0x3b, 0xfc, 0xab, 0xb8, 0x1a, 0xb2, 0xe9, 0x47, 0xd8, 0xab, 0xb7, 0x0e, 0xdd, 0xf5, 0x27, 0x07,
+		0xea, 0xbe, 0x17, 0xbe, 0x24, 0x3d, 0x1a, 0x1a, 0x85, 0x26, 0x2e, 0x5f, 0xf5, 0x8a, 0xc5, 0x8f,
+		0xe3, 0xab, 0x64, 0x66, 0x67, 0xcb, 0x71, 0x4d, 0x03, 0xc9, 0xdb, 0x8a, 0xae, 0x6f, 0x29, 0xea,
+		0xae, 0xdf, 0x65, 0xb6, 0xf5, 0x7b, 0xe1, 0x9d, 0xfb, 0xf3, 0x8f, 0xd3, 0x0a, 0x0f, 0xe2, 0x2e,
+		0x0d, 0xf5, 0x2d, 0xb3, 0x9e, 0xd8, 0x55, 0x26, 0xe5, 0xe7, 0x54, 0xfe, 0x1c, 0x7c, 0x80, 0x24,
+		0x03, 0xf0, 0x17, 0x77, 0xd9, 0xe6, 0x09, 0xa9, 0xa6, 0xdd, 0xe2, 0x97, 0x9d, 0xe8, 0xe7, 0x60,
+		0x5b, 0x64, 0x43, 0x98, 0xa9, 0x9e, 0x7d, 0xe7, 0xfe, 0xfc, 0xd3, 0xbc, 0x29, 0xd1, 0x52, 0x25,
+		0x69, 0x81, 0xb3, 0xd1, 0x9d, 0x16, 0x61, 0xa2, 0xb7, 0xa5, 0xe8, 0xa7, 0x64, 0x5b, 0x01, 0x0f,
+		0xff, 0x66, 0x0c, 0x44, 0x3f, 0x24, 0x91, 0x90, 0x63, 0x99, 0x86, 0x43, 0xf6, 0xdb, 0xbe, 0x53,
+		0x63, 0x5e, 0x29, 0x32, 0x6c, 0xf6, 0x04, 0xf8, 0x7e, 0x3b, 0xb0, 0x70, 0x5c, 0xf6, 0xe3, 0x80,
+		0x38, 0xf3, 0x71, 0x03, 0xee, 0x36, 0x2f, 0xe2, 0xdb, 0xc4, 0xdc, 0x7d, 0xf6, 0x2e, 0xf5, 0x63,
+		0xa5, 0x6f, 0xc5, 0xe0, 0x58, 0x9f, 0xb7, 0xf5, 0xda, 0x8c, 0x40, 0xb4, 0x03, 0x85, 0xec, 0xa3,
+		0xa4, 0xb4, 0xed, 0x0f, 0xea, 0xc3, 0xa7, 0xec, 0xde, 0x82, 0xf7, 0x2c, 0xa2, 0xa1, 0x57, 0x9f,
+		0xff, 0x75, 0x0c, 0x66, 0x82, 0x8d, 0xf1, 0x7a, 0xb7, 0x09, 0xb9, 0x60, 0x5b, 0x58, 0xbf, 0x9e,
+		0x3e, 0x44, 0xbf, 0x58, 0x97, 0x42, 0x30, 0xe2, 0x2d, 0x7f, 0xb5, 0xa3, 0x49, 0xf7, 0xe7, 0x0f,
+		0xab, 0x29, 0xde, 0xc2, 0xde, 0x55, 0x2f, 0x49, 0x86, 0xec, 0x13, 0x71, 0x48, 0x36, 0x4c, 0x53,
+		0x17, 0xff, 0x0c, 0x4c, 0x19, 0xa6, 0x4b, 0xe6, 0x2c, 0x6a, 0xc9, 0x2c, 0xaf, 0x46, 0x23, 0x87,
+		0x8f, 0x1e, 0x4e, 0x81, 0xdf, 0xbd, 0x3f, 0xdf, 0x0f, 0xd5, 0xa3, 0xd5, 0x82, 0x61, 0xba, 0x55,
+		0x52, 0xbe, 0x41, 0x8a, 0x45, 0x1b, 0x26, 0xc3, 0x55, 0xd3, 0x48, 0x63, 0xf5, 0xd0, 0x55, 0x4f,
+		0x1e, 0x54, 0x6d, 0x6e, 0x2b, 0x50, 0x27, 0xbd, 0x22, 0xfa, 0x7d, 0x3c, 0xaa, 0x9f, 0x8b, 0xc1,
+		0xf4, 0x46, 0xff, 0xa4, 0x14, 0xf3, 0x10, 0x67, 0x87, 0xae, 0x49, 0x29, 0xae, 0xb5, 0xf0, 0x09,
+		0xbc, 0x79, 0xdb, 0x60, 0x37, 0xb6, 0xb2, 0x12, 0x7d, 0x20, 0x4b, 0xbb, 0xd9, 0xea, 0xea, 0x08,
+		0x7f, 0xc9, 0x97, 0xdc, 0xa7, 0xa7, 0x09, 0xe0, 0x49, 0x4a, 0xad, 0x50, 0x22, 0x3e, 0x00, 0xf7,
+		0xbd, 0x18, 0xcd, 0xff, 0xfa, 0x04, 0xff, 0x8a, 0x7e, 0x2a, 0x70, 0x45, 0x9f, 0x19, 0xdd, 0x9b,
+		0x31, 0x98, 0x09, 0xb9, 0x74, 0x0e, 0x39, 0x70, 0x9f, 0x15, 0x3b, 0xf4, 0x3e, 0xab, 0x02, 0x05,
+		0xd6, 0xf2, 0x91, 0x03, 0xbd, 0x3c, 0x13, 0xe0, 0x5b, 0xfc, 0xcc, 0x27, 0xb9, 0x13, 0xf8, 0x7f,
+		0x71, 0x00, 0xa2, 0xc3, 0xf5, 0xed, 0x6d, 0x64, 0xf7, 0xa9, 0xf0, 0x1c, 0xa4, 0x1d, 0xa4, 0xeb,
+		0xc8, 0x8e, 0xac, 0x82, 0xf1, 0x89, 0x06, 0xe4, 0xa8, 0x3b, 0x65, 0x96, 0x41, 0xb7, 0x82, 0x07,
+		0xf8, 0xa7, 0x73, 0xd8, 0x68, 0xde, 0xfc, 0xdd, 0xf9, 0x93, 0x23, 0x18, 0x0d, 0x16, 0x70, 0xa4,
+		0x09, 0x52, 0x01, 0x33, 0xc5, 0x4b, 0x50, 0x1c, 0xec, 0xc7, 0xd9, 0x95, 0xa3, 0xa4, 0x74, 0x64,
+		0x80, 0x03, 0x5f, 0x6e, 0x89, 0x17, 0xf0, 0xa9, 0x35, 0xfe, 0x67, 0x57, 0xa9, 0xd1, 0x3c, 0x28,
+		0xe5, 0xc6, 0xff, 0x78, 0x08, 0xdd, 0xb1, 0x34, 0x1a, 0x40, 0x1e, 0xea, 0x76, 0x45, 0x40, 0x2e,
+		0x30, 0x00, 0x6f, 0xc7, 0xe0, 0x71, 0xcf, 0x5a, 0x58, 0x4a, 0x8f, 0x3a, 0x89, 0x86, 0xd2, 0x75,
+		0x90, 0x77, 0x37, 0x05, 0xdf, 0x9d, 0xd4, 0x5c, 0x9d, 0xdf, 0xd7, 0xa7, 0x0f, 0x23, 0xbc, 0xad,
+		0x35, 0xd0, 0xe8, 0x12, 0x87, 0x36, 0x3a, 0xf2, 0x99, 0xe6, 0xae, 0x83, 0x78, 0xbe, 0x82, 0x3d,
+		0x95, 0x4f, 0x07, 0xa3, 0xf1, 0xaf, 0x7f, 0xf9, 0xcc, 0x2c, 0xc3, 0x6b, 0x9b, 0x7b, 0x01, 0x25,
+		0x1a, 0x2e, 0x32, 0xdc, 0xd3, 0x5f, 0x89, 0x01, 0xf8, 0x07, 0x03, 0xf8, 0xb0, 0xbb, 0xba, 0xbe,
+		0x56, 0x93, 0x9b, 0x1b, 0x95, 0x8d, 0xcd, 0x66, 0xf8, 0x05, 0x30, 0x7e, 0x34, 0xee, 0x58, 0x48,
+		0x25, 0xdf, 0x5f, 0x16, 0x9f, 0x84, 0x99, 0x30, 0x37, 0x7e, 0xc2, 0x5f, 0x0b, 0x9f, 0xcd, 0xdd,
+		0xbd, 0xb7, 0x90, 0xa1, 0x19, 0x01, 0x84, 0x2f, 0x16, 0x1e, 0xe9, 0xe7, 0xc3, 0x2f, 0x8f, 0xc5,
+		0x67, 0x27, 0xef, 0xde, 0x5b, 0xc8, 0x7a, 0xa9, 0x03, 0xb1, 0x04, 0x62, 0x90, 0x93, 0xe1, 0x25,
+		0x66, 0xe1, 0xee, 0xbd, 0x85, 0x34, 0x75, 0x77, 0xb3, 0x49, 0x7c, 0x00, 0x5e, 0x7d, 0x79, 0xe8,
+		0xe1, 0xf7, 0x0b, 0x01, 0xa3, 0xd5, 0x3e, 0xa6, 0x77, 0x71, 0xb0, 0xac, 0x19, 0xea, 0x59, 0xea,
+		0xf5, 0x35, 0x77, 0xff, 0x0c, 0xf3, 0xf8, 0x67, 0xa8, 0x77, 0x39, 0x7b, 0x87, 0x1f, 0x6d, 0x87,
+		0x0f, 0xc1, 0xff, 0xff, 0x00, 0x22, 0x84, 0x57, 0x84, 0x46, 0x6f, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1864,6 +2115,13 @@ func (this *CommissionRates) Equal(that interface{}) bool {
 	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
 		return false
 	}
+	if that1.LiquidCommissionRate == nil {
+		if this.LiquidCommissionRate != nil {
+			return false
+		}
+	} else if !this.LiquidCommissionRate.Equal(*that1.LiquidCommissionRate) {
+		return false
+	}
 	return true
 }
 func (this *Commission) Equal(that interface{}) bool {
@@ -2035,6 +2293,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
 		return false
 	}
+	if !this.MinValidatorBond.Equal(that1.MinValidatorBond) {
+		return false
+	}
+	if this.MinValidatorBondGracePeriod != that1.MinValidatorBondGracePeriod {
+		return false
+	}
+	if !this.ValidatorBondSlashMultiplier.Equal(that1.ValidatorBondSlashMultiplier) {
+		return false
+	}
+	if this.TombstoneFallbackValidator != that1.TombstoneFallbackValidator {
+		return false
+	}
+	if this.TokenizeShareRecordVotingEnabled != that1.TokenizeShareRecordVotingEnabled {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2122,6 +2395,9 @@ func (this *TokenizeShareRecord) Equal(that interface{}) bool {
 	if this.Validator != that1.Validator {
 		return false
 	}
+	if this.Denom != that1.Denom {
+		return false
+	}
 	return true
 }
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
@@ -2191,6 +2467,18 @@ func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LiquidCommissionRate != nil {
+		{
+			size := m.LiquidCommissionRate.Size()
+			i -= size
+			if _, err := m.LiquidCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.MaxChangeRate.Size()
 		i -= size
@@ -2343,6 +2631,18 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxLiquidShareRatio != nil {
+		{
+			size := m.MaxLiquidShareRatio.Size()
+			i -= size
+			if _, err := m.MaxLiquidShareRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size := m.TotalLiquidShares.Size()
 		i -= size
@@ -2938,6 +3238,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TokenizeShareRecordVotingEnabled {
+		i--
+		if m.TokenizeShareRecordVotingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TombstoneFallbackValidator) > 0 {
+		i -= len(m.TombstoneFallbackValidator)
+		copy(dAtA[i:], m.TombstoneFallbackValidator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.TombstoneFallbackValidator)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.ValidatorBondSlashMultiplier.Size()
+		i -= size
+		if _, err := m.ValidatorBondSlashMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinValidatorBondGracePeriod != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MinValidatorBondGracePeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinValidatorBond.Size()
+		i -= size
+		if _, err := m.MinValidatorBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.ValidatorBondFactor.Size()
 		i -= size
@@ -3187,6 +3529,13 @@ func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -3216,90 +3565,257 @@ func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorBondAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorBondAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBondAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShareOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *ShareOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShareOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err15 != nil {
+		return 0, err15
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.TokenizeShareRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
+	if len(m.ShareTokens) > 0 {
+		for iNdEx := len(m.ShareTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiquidStakingPauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiquidStakingPauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiquidStakingPauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.LiquidCommissionRate != nil {
+		l = m.LiquidCommissionRate.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
 
 func (m *Validator) Size() (n int) {
 	if m == nil {
@@ -3338,6 +3854,10 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.TotalLiquidShares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxLiquidShareRatio != nil {
+		l = m.MaxLiquidShareRatio.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -3557,6 +4077,20 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.ValidatorBondFactor.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinValidatorBond.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MinValidatorBondGracePeriod != 0 {
+		n += 1 + sovStaking(uint64(m.MinValidatorBondGracePeriod))
+	}
+	l = m.ValidatorBondSlashMultiplier.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.TombstoneFallbackValidator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.TokenizeShareRecordVotingEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -3637,6 +4171,80 @@ func (m *TokenizeShareRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorBondAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ShareOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.ShareTokens) > 0 {
+		for _, e := range m.ShareTokens {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.TokenizeShareRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.TokenizeShareRecordId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorLiquidStakingPauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -3912,6 +4520,42 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LiquidCommissionRate = &v
+			if err := m.LiquidCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4649,11 +5293,47 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidShareRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxLiquidShareRatio = &v
+			if err := m.MaxLiquidShareRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStaking
@@ -6235,6 +6915,145 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidatorBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorBondGracePeriod", wireType)
+			}
+			m.MinValidatorBondGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidatorBondGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondSlashMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondSlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstoneFallbackValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TombstoneFallbackValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecordVotingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenizeShareRecordVotingEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6868,6 +7687,538 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBondAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBondAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBondAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareTokens = append(m.ShareTokens, types3.Coin{})
+			if err := m.ShareTokens[len(m.ShareTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecordId", wireType)
+			}
+			m.TokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLiquidStakingPauseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiquidStakingPauseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiquidStakingPauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
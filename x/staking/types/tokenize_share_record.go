@@ -6,13 +6,55 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// MaxTokenizeShareRecordPrunesPerBlock is the maximum number of tokenize share
+// records inspected for pruning in a single block
+const MaxTokenizeShareRecordPrunesPerBlock = 100
+
+// MaxTombstoneRedelegationsPerBlock is the maximum number of tokenize share
+// records redelegated to the tombstone fallback validator in a single block
+const MaxTombstoneRedelegationsPerBlock = 100
+
+// TokenizeShareRecordNFTClassId is the id of the staking-owned NFT class whose
+// tokens represent the ownership of tokenize share records
+const TokenizeShareRecordNFTClassId = "tokenize-share-record"
+
 func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
 	return authtypes.NewModuleAddress(r.ModuleAccount)
 }
 
+// GetShareTokenDenom returns the denom of the record's share tokens. The denom is
+// derived from the validator and id of the record when the record is created and
+// stays the same when the record's delegation is redelegated.
 func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	if r.Denom != "" {
+		return r.Denom
+	}
 	return fmt.Sprintf("%s/%s", strings.ToLower(r.Validator), strconv.Itoa(int(r.Id)))
 }
+
+// ValidatorAddressFromShareDenom returns the operator address of the validator
+// a share token denom was created for. The record of the denom holds the
+// validator its shares are currently delegated to.
+func ValidatorAddressFromShareDenom(denom string) (string, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return "", sdkerrors.ErrInvalidCoins.Wrapf("invalid share token denom: %s", denom)
+	}
+	if _, err := strconv.ParseUint(parts[1], 10, 64); err != nil {
+		return "", sdkerrors.ErrInvalidCoins.Wrapf("invalid share token denom: %s", denom)
+	}
+	if _, err := sdk.ValAddressFromBech32(parts[0]); err != nil {
+		return "", sdkerrors.ErrInvalidCoins.Wrapf("invalid share token denom: %s", denom)
+	}
+	return parts[0], nil
+}
+
+// GetNFTId returns the id of the NFT representing the ownership of the record,
+// which is the record's share token denom
+func (r TokenizeShareRecord) GetNFTId() string {
+	return r.GetShareTokenDenom()
+}
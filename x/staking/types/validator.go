@@ -306,11 +306,29 @@ func (v Validator) InvalidExRate() bool {
 	return v.Tokens.IsZero() && v.DelegatorShares.IsPositive()
 }
 
+// ExceedsMaxLiquidShareRatio returns true if adding the liquid shares to the
+// validator would exceed the max liquid share ratio set by its operator
+func (v Validator) ExceedsMaxLiquidShareRatio(liquidShares sdk.Dec) bool {
+	if v.MaxLiquidShareRatio == nil {
+		return false
+	}
+	return v.TotalLiquidShares.Add(liquidShares).GT(v.DelegatorShares.Mul(*v.MaxLiquidShareRatio))
+}
+
 // calculate the token worth of provided shares
 func (v Validator) TokensFromShares(shares sdk.Dec) sdk.Dec {
 	return (shares.MulInt(v.Tokens)).Quo(v.DelegatorShares)
 }
 
+// ValidatorBondTokens returns the tokens backing the validator bond shares of the
+// validator, which the minimum validator bond is measured in
+func (v Validator) ValidatorBondTokens() sdk.Dec {
+	if v.DelegatorShares.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return v.TokensFromShares(v.TotalValidatorBondShares)
+}
+
 // calculate the token worth of provided shares, truncated
 func (v Validator) TokensFromSharesTruncated(shares sdk.Dec) sdk.Dec {
 	return (shares.MulInt(v.Tokens)).QuoTruncate(v.DelegatorShares)
@@ -85,6 +85,16 @@ func TestShareTokens(t *testing.T) {
 	assert.True(sdk.DecEq(t, sdk.NewDec(5), validator.TokensFromShares(sdk.NewDec(10))))
 }
 
+func TestValidatorBondTokens(t *testing.T) {
+	validator := mkValidator(50, sdk.NewDec(100))
+	validator.TotalValidatorBondShares = sdk.NewDec(20)
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), validator.ValidatorBondTokens()))
+
+	// a validator without delegator shares has no validator bond tokens
+	validator = mkValidator(0, sdk.ZeroDec())
+	assert.True(sdk.DecEq(t, sdk.ZeroDec(), validator.ValidatorBondTokens()))
+}
+
 func TestRemoveTokens(t *testing.T) {
 	validator := mkValidator(100, sdk.NewDec(100))
 